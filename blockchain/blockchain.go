@@ -10,12 +10,15 @@ import (
 	"github.com/0xPolygon/polygon-edge/blockchain/storage"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/0xPolygon/polygon-edge/types/buildroot"
 
 	"github.com/hashicorp/go-hclog"
 	lru "github.com/hashicorp/golang-lru"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -26,6 +29,8 @@ const (
 	defaultCacheSize int = 100
 )
 
+var tracer = tracing.Tracer("blockchain")
+
 var (
 	ErrNoBlock              = errors.New("no block data passed in")
 	ErrParentNotFound       = errors.New("parent block not found")
@@ -796,12 +801,18 @@ func (b *Blockchain) executeBlockTransactions(block *types.Block) (*BlockResult,
 // It doesn't do any kind of verification, only commits the block to the DB
 // This function is a copy of WriteBlock but with a full block which does not
 // require to compute again the Receipts.
-func (b *Blockchain) WriteFullBlock(fblock *types.FullBlock, source string) error {
+func (b *Blockchain) WriteFullBlock(fblock *types.FullBlock, source string) (err error) {
+	block := fblock.Block
+
+	span := startWriteBlockSpan(block, source)
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
 	b.writeLock.Lock()
 	defer b.writeLock.Unlock()
 
-	block := fblock.Block
-
 	if block.Number() <= b.Header().Number {
 		b.logger.Info("block already inserted", "block", block.Number(), "source", source)
 
@@ -863,7 +874,13 @@ func (b *Blockchain) WriteFullBlock(fblock *types.FullBlock, source string) erro
 
 // WriteBlock writes a single block to the local blockchain.
 // It doesn't do any kind of verification, only commits the block to the DB
-func (b *Blockchain) WriteBlock(block *types.Block, source string) error {
+func (b *Blockchain) WriteBlock(block *types.Block, source string) (err error) {
+	span := startWriteBlockSpan(block, source)
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
 	b.writeLock.Lock()
 	defer b.writeLock.Unlock()
 
@@ -932,6 +949,20 @@ func (b *Blockchain) WriteBlock(block *types.Block, source string) error {
 	return nil
 }
 
+// startWriteBlockSpan starts the span of the block insertion as a child of the span of its height,
+// linked to the spans of its transactions received by the node
+func startWriteBlockSpan(block *types.Block, source string) trace.Span {
+	_, span := tracer.Start(tracing.BlockContext(block.Number()), "Blockchain.WriteBlock",
+		trace.WithAttributes(
+			tracing.BlockNumberKey.Int64(int64(block.Number())),
+			attribute.String("block.source", source),
+			attribute.Int("block.txs", len(block.Transactions)),
+		),
+		trace.WithLinks(tracing.TxLinks(block.Transactions...)...))
+
+	return span
+}
+
 // GetCachedReceipts retrieves cached receipts for given headerHash
 func (b *Blockchain) GetCachedReceipts(headerHash types.Hash) ([]*types.Receipt, error) {
 	receipts, found := b.receiptsCache.Get(headerHash)
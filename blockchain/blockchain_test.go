@@ -1521,3 +1521,131 @@ func TestBlockchain_WriteFullBlock(t *testing.T) {
 	require.NotNil(t, db[hex.EncodeToHex(getKey(storage.CANONICAL, common.EncodeUint64ToBytes(header.Number)))])
 	require.NotNil(t, db[hex.EncodeToHex(getKey(storage.RECEIPTS, header.Hash.Bytes()))])
 }
+
+func TestRewindStorage(t *testing.T) {
+	t.Parallel()
+
+	db, err := memory.NewMemoryStorage(nil)
+	require.NoError(t, err)
+
+	headers := make([]*types.Header, 6)
+	txs := make([]*types.Transaction, len(headers))
+	batchWriter := storage.NewBatchWriter(db)
+
+	for i := range headers {
+		headers[i] = &types.Header{Number: uint64(i), ExtraData: []byte{}}
+		if i > 0 {
+			headers[i].ParentHash = headers[i-1].Hash
+		}
+
+		headers[i].ComputeHash()
+
+		txs[i] = &types.Transaction{Nonce: uint64(i), Value: big.NewInt(1)}
+		txs[i].ComputeHash(uint64(i))
+
+		batchWriter.PutCanonicalHeader(headers[i], big.NewInt(int64(i)))
+		batchWriter.PutBody(headers[i].Hash, &types.Body{Transactions: []*types.Transaction{txs[i]}})
+		batchWriter.PutTxLookup(txs[i].Hash, headers[i].Hash)
+	}
+
+	batchWriter.PutForks([]types.Hash{headers[1].Hash, headers[4].Hash})
+	require.NoError(t, batchWriter.WriteBatch())
+
+	_, err = RewindStorage(db, 6)
+	require.ErrorContains(t, err, "above the current head")
+
+	head, err := RewindStorage(db, 2)
+	require.NoError(t, err)
+	assert.Equal(t, headers[2].Hash, head.Hash)
+
+	headHash, ok := db.ReadHeadHash()
+	require.True(t, ok)
+	assert.Equal(t, headers[2].Hash, headHash)
+
+	headNumber, ok := db.ReadHeadNumber()
+	require.True(t, ok)
+	assert.Equal(t, uint64(2), headNumber)
+
+	for i, header := range headers {
+		canonical, ok := db.ReadCanonicalHash(uint64(i))
+		lookup, lookupOk := db.ReadTxLookup(txs[i].Hash)
+
+		if i <= 2 {
+			assert.True(t, ok)
+			assert.Equal(t, header.Hash, canonical)
+			assert.True(t, lookupOk)
+			assert.Equal(t, header.Hash, lookup)
+		} else {
+			assert.False(t, ok)
+			assert.False(t, lookupOk)
+		}
+
+		// headers are kept as a side chain
+		_, err := db.ReadHeader(header.Hash)
+		assert.NoError(t, err)
+	}
+
+	forks, err := db.ReadForks()
+	require.NoError(t, err)
+	assert.Equal(t, []types.Hash{headers[1].Hash}, forks)
+}
+
+type headRewinderVerifier struct {
+	MockVerifier
+
+	rewoundTo *types.Header
+}
+
+func (v *headRewinderVerifier) RewindHead(header *types.Header) error {
+	v.rewoundTo = header
+
+	return nil
+}
+
+func TestBlockchain_SetHead(t *testing.T) {
+	t.Parallel()
+
+	headers := NewTestHeaders(6)
+	b := NewTestBlockchain(t, headers)
+
+	verifier := &headRewinderVerifier{}
+	b.SetConsensus(verifier)
+
+	sub := b.SubscribeEvents()
+	defer b.UnsubscribeEvents(sub)
+
+	_, err := b.SetHead(6)
+	require.ErrorContains(t, err, "above the current head")
+
+	_, err = b.SetHead(2)
+	require.NoError(t, err)
+
+	assert.Equal(t, headers[2].Hash, b.Header().Hash)
+	assert.Equal(t, headers[2].Hash, verifier.rewoundTo.Hash)
+
+	for i, header := range headers {
+		canonical, ok := b.GetHeaderByNumber(uint64(i))
+		if i <= 2 {
+			require.True(t, ok)
+			assert.Equal(t, header.Hash, canonical.Hash)
+		} else {
+			assert.False(t, ok)
+		}
+	}
+
+	evnt := sub.GetEvent()
+	require.NotNil(t, evnt)
+	assert.Equal(t, EventReorg, evnt.Type)
+	require.Len(t, evnt.NewChain, 1)
+	assert.Equal(t, headers[2].Hash, evnt.NewChain[0].Hash)
+	require.Len(t, evnt.OldChain, 3)
+
+	for i, header := range evnt.OldChain {
+		assert.Equal(t, headers[5-i].Hash, header.Hash)
+	}
+
+	// rewinding to the current head is a no-op
+	txs, err := b.SetHead(2)
+	require.NoError(t, err)
+	assert.Empty(t, txs)
+}
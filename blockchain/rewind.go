@@ -0,0 +1,149 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// RewindStorage resets the head of the canonical chain in the given storage to the block with the given number.
+// Canonical hashes and transaction lookups of the blocks above it are removed in a single batch,
+// while their headers, bodies and receipts are kept as an orphaned side chain.
+// It returns the new head header
+func RewindStorage(db storage.Storage, to uint64) (*types.Header, error) {
+	headNumber, ok := db.ReadHeadNumber()
+	if !ok {
+		return nil, errors.New("head not found")
+	}
+
+	if to > headNumber {
+		return nil, fmt.Errorf("block %d is above the current head %d", to, headNumber)
+	}
+
+	hash, ok := db.ReadCanonicalHash(to)
+	if !ok {
+		return nil, fmt.Errorf("canonical hash of block %d not found", to)
+	}
+
+	header, err := db.ReadHeader(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header of block %d: %w", to, err)
+	}
+
+	header.Hash = hash
+
+	batchWriter := storage.NewBatchWriter(db)
+
+	for n := headNumber; n > to; n-- {
+		if blockHash, ok := db.ReadCanonicalHash(n); ok {
+			if body, err := db.ReadBody(blockHash); err == nil {
+				for _, tx := range body.Transactions {
+					batchWriter.DeleteTxLookup(tx.Hash)
+				}
+			}
+		}
+
+		batchWriter.DeleteCanonicalHash(n)
+	}
+
+	// drop the forks built on top of the removed part of the chain
+	forks, err := db.ReadForks()
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, fmt.Errorf("failed to read forks: %w", err)
+	}
+
+	newForks := make([]types.Hash, 0, len(forks))
+
+	for _, fork := range forks {
+		if forkHeader, err := db.ReadHeader(fork); err == nil && forkHeader.Number <= to {
+			newForks = append(newForks, fork)
+		}
+	}
+
+	batchWriter.PutForks(newForks)
+	batchWriter.PutHeadHash(hash)
+	batchWriter.PutHeadNumber(to)
+
+	if err := batchWriter.WriteBatch(); err != nil {
+		return nil, fmt.Errorf("failed to write the rewound head: %w", err)
+	}
+
+	return header, nil
+}
+
+// HeadRewinder is implemented by the consensus engines which keep their own state
+// derived from the canonical chain and need to roll it back when the head is rewound
+type HeadRewinder interface {
+	// RewindHead rolls back the consensus state to the given header,
+	// which has just become the new head of the canonical chain
+	RewindHead(header *types.Header) error
+}
+
+// SetHead rewinds the canonical chain to the block with the given number.
+// The consensus engine rolls back its own state (if it implements HeadRewinder)
+// and the subscribers are notified with a reorg event which holds the removed headers.
+// It returns the transactions of the removed blocks, so they can be put back into the transaction pool
+func (b *Blockchain) SetHead(number uint64) ([]*types.Transaction, error) {
+	b.writeLock.Lock()
+	defer b.writeLock.Unlock()
+
+	oldHead := b.Header()
+	if number > oldHead.Number {
+		return nil, fmt.Errorf("block %d is above the current head %d", number, oldHead.Number)
+	}
+
+	if number == oldHead.Number {
+		return nil, nil
+	}
+
+	removed := make([]*types.Header, 0, oldHead.Number-number)
+	droppedTxs := []*types.Transaction{}
+
+	for n := oldHead.Number; n > number; n-- {
+		header, ok := b.GetHeaderByNumber(n)
+		if !ok {
+			return nil, fmt.Errorf("header of block %d not found", n)
+		}
+
+		removed = append(removed, header)
+
+		if body, ok := b.readBody(header.Hash); ok {
+			droppedTxs = append(droppedTxs, body.Transactions...)
+		}
+	}
+
+	newHead, err := RewindStorage(b.db, number)
+	if err != nil {
+		return nil, err
+	}
+
+	newTD, ok := b.readTotalDifficulty(newHead.Hash)
+	if !ok {
+		return nil, fmt.Errorf("total difficulty of block %d not found", number)
+	}
+
+	b.setCurrentHeader(newHead, newTD)
+
+	b.logger.Info("head rewound", "old", oldHead.Number, "new", number, "hash", newHead.Hash)
+
+	if rewinder, ok := b.consensus.(HeadRewinder); ok {
+		if err := rewinder.RewindHead(newHead); err != nil {
+			return nil, fmt.Errorf("head rewound to block %d, but consensus state rewind failed: %w", number, err)
+		}
+	}
+
+	evnt := &Event{Source: "setHead", Type: EventReorg}
+
+	for _, header := range removed {
+		evnt.AddOldHeader(header)
+	}
+
+	evnt.AddNewHeader(newHead)
+	evnt.SetDifficulty(newTD)
+
+	b.dispatchEvent(evnt)
+
+	return droppedTxs, nil
+}
@@ -63,6 +63,16 @@ func (b *BatchWriter) PutCanonicalHash(n uint64, hash types.Hash) {
 	b.putWithPrefix(CANONICAL, common.EncodeUint64ToBytes(n), hash.Bytes())
 }
 
+// DeleteCanonicalHash removes the canonical hash of the given number
+func (b *BatchWriter) DeleteCanonicalHash(n uint64) {
+	b.deleteWithPrefix(CANONICAL, common.EncodeUint64ToBytes(n))
+}
+
+// DeleteTxLookup removes the block hash lookup of the given transaction
+func (b *BatchWriter) DeleteTxLookup(hash types.Hash) {
+	b.deleteWithPrefix(TX_LOOKUP_PREFIX, hash.Bytes())
+}
+
 func (b *BatchWriter) PutTotalDifficulty(hash types.Hash, diff *big.Int) {
 	b.putWithPrefix(DIFFICULTY, hash.Bytes(), diff.Bytes())
 }
@@ -91,6 +101,12 @@ func (b *BatchWriter) putWithPrefix(p, k, data []byte) {
 	b.batch.Put(fullKey, data)
 }
 
+func (b *BatchWriter) deleteWithPrefix(p, k []byte) {
+	fullKey := append(append(make([]byte, 0, len(p)+len(k)), p...), k...)
+
+	b.batch.Delete(fullKey)
+}
+
 func (b *BatchWriter) WriteBatch() error {
 	return b.batch.Write()
 }
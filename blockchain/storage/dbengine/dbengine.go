@@ -0,0 +1,100 @@
+package dbengine
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/leveldb"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/pebble"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+)
+
+// Engine is the key-value database engine backing the node data directory
+type Engine string
+
+const (
+	// LevelDB is the goleveldb engine
+	LevelDB Engine = "leveldb"
+
+	// Pebble is the cockroachdb pebble engine
+	Pebble Engine = "pebble"
+
+	// Default is the engine used when none is specified
+	Default = LevelDB
+)
+
+// Engines returns all the supported database engines
+func Engines() []Engine {
+	return []Engine{LevelDB, Pebble}
+}
+
+// Validate checks if the given database engine is supported
+func Validate(engine string) error {
+	for _, e := range Engines() {
+		if Engine(engine) == e {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown db engine %q, supported engines are %v", engine, Engines())
+}
+
+// Detect returns the engine of the database at the given path, false if there is no database
+func Detect(path string) (Engine, bool) {
+	// pebble keeps its options in the OPTIONS-<file number> files, which goleveldb doesn't write
+	if matches, _ := filepath.Glob(filepath.Join(path, "OPTIONS-*")); len(matches) > 0 {
+		return Pebble, true
+	}
+
+	if _, err := os.Stat(filepath.Join(path, "CURRENT")); err == nil {
+		return LevelDB, true
+	}
+
+	return "", false
+}
+
+// checkEngine returns an error if the database at the given path is stored with another engine,
+// opening it would create a new database of the given engine next to it
+func checkEngine(engine Engine, path string) error {
+	if detected, ok := Detect(path); ok && detected != engine {
+		return fmt.Errorf("the database at %s is stored with the %s db engine, not %s", path, detected, engine)
+	}
+
+	return nil
+}
+
+// OpenBlockchainStorage opens the blockchain storage of the given engine at the given path
+func OpenBlockchainStorage(engine Engine, path string, logger hclog.Logger) (storage.Storage, error) {
+	if err := checkEngine(engine, path); err != nil {
+		return nil, err
+	}
+
+	switch engine {
+	case LevelDB:
+		return leveldb.NewLevelDBStorage(path, logger)
+	case Pebble:
+		return pebble.NewPebbleStorage(path, logger)
+	default:
+		return nil, fmt.Errorf("unknown db engine %q", engine)
+	}
+}
+
+// OpenStateStorage opens the trie storage of the given engine at the given path
+func OpenStateStorage(engine Engine, path string, logger hclog.Logger) (itrie.Storage, error) {
+	if err := checkEngine(engine, path); err != nil {
+		return nil, err
+	}
+
+	switch engine {
+	case LevelDB:
+		return itrie.NewLevelDBStorage(path, logger)
+	case Pebble:
+		return itrie.NewPebbleStorage(path, logger)
+	default:
+		return nil, fmt.Errorf("unknown db engine %q", engine)
+	}
+}
@@ -0,0 +1,170 @@
+package dbengine
+
+import (
+	"fmt"
+
+	pebbledb "github.com/cockroachdb/pebble"
+	"github.com/syndtr/goleveldb/leveldb"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/pebble"
+)
+
+// migrateBatchSize is the number of keys written to the destination in a single batch
+const migrateBatchSize = 10_000
+
+// rawDB is a raw key-value database which can be iterated and written to in batches
+type rawDB interface {
+	// ForEach iterates over all the key-value pairs in the key order
+	ForEach(fn func(k, v []byte) error) error
+	// WriteBatch writes the given key-value pairs atomically
+	WriteBatch(kvs [][2][]byte) error
+	Close() error
+}
+
+// Migrate copies all the key-value pairs from the database of the source engine at srcPath
+// to the database of the destination engine at dstPath. It returns the number of copied keys.
+// Both databases must not be in use by a running node
+func Migrate(srcEngine Engine, srcPath string, dstEngine Engine, dstPath string) (uint64, error) {
+	src, err := openRawDB(srcEngine, srcPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open source database: %w", err)
+	}
+	defer src.Close()
+
+	dst, err := openRawDB(dstEngine, dstPath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open destination database: %w", err)
+	}
+	defer dst.Close()
+
+	var (
+		count uint64
+		batch = make([][2][]byte, 0, migrateBatchSize)
+	)
+
+	err = src.ForEach(func(k, v []byte) error {
+		// iterator buffers are reused, so the pairs need to be copied
+		batch = append(batch, [2][]byte{append([]byte{}, k...), append([]byte{}, v...)})
+		if len(batch) < migrateBatchSize {
+			return nil
+		}
+
+		if err := dst.WriteBatch(batch); err != nil {
+			return err
+		}
+
+		count += uint64(len(batch))
+		batch = batch[:0]
+
+		return nil
+	})
+	if err != nil {
+		return count, err
+	}
+
+	if err := dst.WriteBatch(batch); err != nil {
+		return count, err
+	}
+
+	return count + uint64(len(batch)), nil
+}
+
+// ForEach iterates over all the key-value pairs of the database of the given engine at the given path,
+// in the key order. The key and value slices are only valid until fn returns
+func ForEach(engine Engine, path string, fn func(k, v []byte) error) error {
+	db, err := openRawDB(engine, path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return db.ForEach(fn)
+}
+
+func openRawDB(engine Engine, path string) (rawDB, error) {
+	if err := checkEngine(engine, path); err != nil {
+		return nil, err
+	}
+
+	switch engine {
+	case LevelDB:
+		db, err := leveldb.OpenFile(path, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rawLevelDB{db}, nil
+	case Pebble:
+		db, err := pebble.OpenDB(path)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rawPebble{db}, nil
+	default:
+		return nil, fmt.Errorf("unknown db engine %q", engine)
+	}
+}
+
+type rawLevelDB struct {
+	db *leveldb.DB
+}
+
+func (r *rawLevelDB) ForEach(fn func(k, v []byte) error) error {
+	it := r.db.NewIterator(nil, nil)
+	defer it.Release()
+
+	for it.Next() {
+		if err := fn(it.Key(), it.Value()); err != nil {
+			return err
+		}
+	}
+
+	return it.Error()
+}
+
+func (r *rawLevelDB) WriteBatch(kvs [][2][]byte) error {
+	batch := new(leveldb.Batch)
+	for _, kv := range kvs {
+		batch.Put(kv[0], kv[1])
+	}
+
+	return r.db.Write(batch, nil)
+}
+
+func (r *rawLevelDB) Close() error {
+	return r.db.Close()
+}
+
+type rawPebble struct {
+	db *pebbledb.DB
+}
+
+func (r *rawPebble) ForEach(fn func(k, v []byte) error) error {
+	it := r.db.NewIter(nil)
+
+	for it.First(); it.Valid(); it.Next() {
+		if err := fn(it.Key(), it.Value()); err != nil {
+			_ = it.Close()
+
+			return err
+		}
+	}
+
+	return it.Close()
+}
+
+func (r *rawPebble) WriteBatch(kvs [][2][]byte) error {
+	batch := r.db.NewBatch()
+	for _, kv := range kvs {
+		if err := batch.Set(kv[0], kv[1], nil); err != nil {
+			return err
+		}
+	}
+
+	return batch.Commit(pebbledb.Sync)
+}
+
+func (r *rawPebble) Close() error {
+	return r.db.Close()
+}
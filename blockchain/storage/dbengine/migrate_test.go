@@ -0,0 +1,111 @@
+package dbengine
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, Validate("leveldb"))
+	assert.NoError(t, Validate("pebble"))
+	assert.Error(t, Validate("rocksdb"))
+}
+
+func TestDetect(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	_, ok := Detect(filepath.Join(dir, "missing"))
+	assert.False(t, ok)
+
+	for _, engine := range Engines() {
+		path := filepath.Join(dir, string(engine))
+
+		db, err := OpenBlockchainStorage(engine, path, hclog.NewNullLogger())
+		require.NoError(t, err)
+		require.NoError(t, db.Close())
+
+		detected, ok := Detect(path)
+		require.True(t, ok)
+		assert.Equal(t, engine, detected)
+
+		// the database isn't opened with another engine
+		for _, other := range Engines() {
+			if other != engine {
+				_, err = OpenStateStorage(other, path, hclog.NewNullLogger())
+				assert.ErrorContains(t, err, "is stored with the "+string(engine)+" db engine")
+
+				_, err = Migrate(engine, path, other, path)
+				assert.Error(t, err)
+			}
+		}
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		from Engine
+		to   Engine
+	}{
+		{LevelDB, Pebble},
+		{Pebble, LevelDB},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(string(c.from)+"->"+string(c.to), func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			srcPath, dstPath := filepath.Join(dir, "src"), filepath.Join(dir, "dst")
+
+			header := &types.Header{Number: 5, ExtraData: []byte{}}
+			header.ComputeHash()
+
+			src, err := OpenBlockchainStorage(c.from, srcPath, hclog.NewNullLogger())
+			require.NoError(t, err)
+
+			batch := storage.NewBatchWriter(src)
+			batch.PutHeader(header)
+			batch.PutCanonicalHash(header.Number, header.Hash)
+			batch.PutHeadHash(header.Hash)
+			batch.PutHeadNumber(header.Number)
+			require.NoError(t, batch.WriteBatch())
+			require.NoError(t, src.Close())
+
+			count, err := Migrate(c.from, srcPath, c.to, dstPath)
+			require.NoError(t, err)
+			assert.Equal(t, uint64(4), count)
+
+			dst, err := OpenBlockchainStorage(c.to, dstPath, hclog.NewNullLogger())
+			require.NoError(t, err)
+
+			defer dst.Close()
+
+			headHash, ok := dst.ReadHeadHash()
+			require.True(t, ok)
+			assert.Equal(t, header.Hash, headHash)
+
+			canonical, ok := dst.ReadCanonicalHash(header.Number)
+			require.True(t, ok)
+			assert.Equal(t, header.Hash, canonical)
+
+			readHeader, err := dst.ReadHeader(header.Hash)
+			require.NoError(t, err)
+			assert.Equal(t, header.Number, readHeader.Number)
+		})
+	}
+}
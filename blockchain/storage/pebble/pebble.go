@@ -0,0 +1,93 @@
+package pebble
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/cockroachdb/pebble"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	DefaultCache   = int64(256)
+	DefaultHandles = int(256)
+)
+
+// Factory creates a pebble storage
+func Factory(config map[string]interface{}, logger hclog.Logger) (storage.Storage, error) {
+	path, ok := config["path"]
+	if !ok {
+		return nil, fmt.Errorf("path not found")
+	}
+
+	pathStr, ok := path.(string)
+	if !ok {
+		return nil, fmt.Errorf("path is not a string")
+	}
+
+	return NewPebbleStorage(pathStr, logger)
+}
+
+// NewPebbleStorage creates the new storage reference with pebble default options
+func NewPebbleStorage(path string, logger hclog.Logger) (storage.Storage, error) {
+	db, err := OpenDB(path)
+	if err != nil {
+		return nil, err
+	}
+
+	kv := &pebbleKV{db}
+
+	return storage.NewKeyValueStorage(logger.Named("pebble"), kv), nil
+}
+
+// OpenDB opens (or creates) the pebble database at the given path with the default options
+func OpenDB(path string) (*pebble.DB, error) {
+	cache := pebble.NewCache(DefaultCache / 2 * 1024 * 1024)
+	defer cache.Unref()
+
+	return pebble.Open(path, &pebble.Options{
+		Cache:        cache,
+		MaxOpenFiles: DefaultHandles,
+		MemTableSize: int(DefaultCache / 4 * 1024 * 1024),
+	})
+}
+
+// pebbleKV is the pebble implementation of the kv storage
+type pebbleKV struct {
+	db *pebble.DB
+}
+
+// Set sets the key-value pair in pebble storage. The write isn't synced, the same as the leveldb storage
+func (p *pebbleKV) Set(k []byte, v []byte) error {
+	return p.db.Set(k, v, pebble.NoSync)
+}
+
+// Get retrieves the key-value pair in pebble storage
+func (p *pebbleKV) Get(k []byte) ([]byte, bool, error) {
+	data, closer, err := p.db.Get(k)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	defer closer.Close()
+
+	// the returned slice is only valid until the closer is closed
+	res := make([]byte, len(data))
+	copy(res, data)
+
+	return res, true, nil
+}
+
+// Close closes the pebble storage instance
+func (p *pebbleKV) Close() error {
+	return p.db.Close()
+}
+
+func (p *pebbleKV) NewBatch() storage.Batch {
+	return NewBatchPebble(p.db)
+}
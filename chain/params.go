@@ -32,6 +32,10 @@ type Params struct {
 	BurnContract map[uint64]types.Address `json:"burnContract"`
 	// Destination address to initialize default burn contract with
 	BurnContractDestinationAddress types.Address `json:"burnContractDestinationAddress,omitempty"`
+
+	// TxPriorityAddresses are the senders whose transactions are included first
+	// when the priority transaction ordering policy is active
+	TxPriorityAddresses []types.Address `json:"txPriorityAddresses,omitempty"`
 }
 
 type AddressListConfig struct {
@@ -83,6 +87,7 @@ const (
 	Constantinople      = "constantinople"
 	Petersburg          = "petersburg"
 	Istanbul            = "istanbul"
+	Berlin              = "berlin"
 	London              = "london"
 	EIP150              = "EIP150"
 	EIP158              = "EIP158"
@@ -121,6 +126,7 @@ func (f *Forks) At(block uint64) ForksInTime {
 		Constantinople:      f.IsActive(Constantinople, block),
 		Petersburg:          f.IsActive(Petersburg, block),
 		Istanbul:            f.IsActive(Istanbul, block),
+		Berlin:              f.IsActive(Berlin, block),
 		London:              f.IsActive(London, block),
 		EIP150:              f.IsActive(EIP150, block),
 		EIP158:              f.IsActive(EIP158, block),
@@ -174,6 +180,7 @@ type ForksInTime struct {
 	Constantinople,
 	Petersburg,
 	Istanbul,
+	Berlin,
 	London,
 	EIP150,
 	EIP158,
@@ -198,3 +205,21 @@ var AllForksEnabled = &Forks{
 	TxHashWithType:      NewFork(0),
 	LondonFix:           NewFork(0),
 }
+
+// optInForks are the supported forks which aren't in AllForksEnabled, so they are active only
+// if the genesis schedules them. Berlin has the access lists of EIP-2929 but not the access list
+// transactions of EIP-2930
+var optInForks = map[string]struct{}{
+	Berlin: {},
+}
+
+// IsForkAvailable checks if the fork is supported by current edge version
+func IsForkAvailable(name string) bool {
+	if _, ok := (*AllForksEnabled)[name]; ok {
+		return true
+	}
+
+	_, ok := optInForks[name]
+
+	return ok
+}
@@ -128,3 +128,12 @@ func TestParams_CalculateBurnContract(t *testing.T) {
 		})
 	}
 }
+
+func TestIsForkAvailable(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, IsForkAvailable(London))
+	require.False(t, AllForksEnabled.IsActive(Berlin, 0))
+	require.True(t, IsForkAvailable(Berlin))
+	require.False(t, IsForkAvailable("shanghai"))
+}
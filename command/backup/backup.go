@@ -15,7 +15,7 @@ func GetCommand() *cobra.Command {
 		Run:     runCommand,
 	}
 
-	helper.RegisterGRPCAddressFlag(backupCmd)
+	helper.RegisterGRPCEndpointFlags(backupCmd)
 
 	setFlags(backupCmd)
 	helper.SetRequiredFlags(backupCmd, params.getRequiredFlags())
@@ -54,7 +54,7 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
-	if err := params.createBackup(helper.GetGRPCAddress(cmd)); err != nil {
+	if err := params.createBackup(helper.GetGRPCEndpoint(cmd)); err != nil {
 		outputter.SetError(err)
 
 		return
@@ -68,9 +68,9 @@ func (p *backupParams) getRequiredFlags() []string {
 	}
 }
 
-func (p *backupParams) createBackup(grpcAddress string) error {
+func (p *backupParams) createBackup(grpcEndpoint helper.GRPCEndpoint) error {
 	connection, err := helper.GetGRPCConnection(
-		grpcAddress,
+		grpcEndpoint,
 	)
 	if err != nil {
 		return err
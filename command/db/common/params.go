@@ -0,0 +1,90 @@
+package common
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/dbengine"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+)
+
+const (
+	DataDirFlag  = "data-dir"
+	DBEngineFlag = "db-engine"
+)
+
+// Subdirectories of the node data directory holding the databases
+const (
+	BlockchainDir = "blockchain"
+	TrieDir       = "trie"
+	ConsensusDir  = "consensus"
+)
+
+// DatabaseDirs are the data directory subdirectories holding the key-value databases
+var DatabaseDirs = []string{BlockchainDir, TrieDir}
+
+// DataDirParams are the params of the commands operating on the databases of a stopped node
+type DataDirParams struct {
+	DataDir  string
+	DBEngine string
+}
+
+// RegisterFlags registers the data directory and db engine flags
+func (p *DataDirParams) RegisterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&p.DataDir,
+		DataDirFlag,
+		"",
+		"the data directory of the node",
+	)
+
+	cmd.Flags().StringVar(
+		&p.DBEngine,
+		DBEngineFlag,
+		"",
+		fmt.Sprintf("the db engine the data directory is stored with (%s, %s), detected from the data directory if not set",
+			dbengine.LevelDB, dbengine.Pebble),
+	)
+
+	_ = cmd.MarkFlagRequired(DataDirFlag)
+}
+
+// ValidateFlags checks that the data directory holds the databases and the db engine,
+// which is detected from the databases if it isn't set
+func (p *DataDirParams) ValidateFlags() error {
+	for _, dir := range DatabaseDirs {
+		if _, err := os.Stat(filepath.Join(p.DataDir, dir)); err != nil {
+			return fmt.Errorf("invalid data directory: %w", err)
+		}
+	}
+
+	if p.DBEngine == "" {
+		p.DBEngine = string(dbengine.Default)
+
+		if engine, ok := dbengine.Detect(p.Path(BlockchainDir)); ok {
+			p.DBEngine = string(engine)
+		}
+	}
+
+	return dbengine.Validate(p.DBEngine)
+}
+
+// Path returns the path of the given data directory subdirectory
+func (p *DataDirParams) Path(dir string) string {
+	return filepath.Join(p.DataDir, dir)
+}
+
+// OpenBlockchain opens the blockchain storage of the data directory
+func (p *DataDirParams) OpenBlockchain() (storage.Storage, error) {
+	return dbengine.OpenBlockchainStorage(dbengine.Engine(p.DBEngine), p.Path(BlockchainDir), hclog.NewNullLogger())
+}
+
+// OpenState opens the trie storage of the data directory
+func (p *DataDirParams) OpenState() (itrie.Storage, error) {
+	return dbengine.OpenStateStorage(dbengine.Engine(p.DBEngine), p.Path(TrieDir), hclog.NewNullLogger())
+}
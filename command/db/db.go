@@ -0,0 +1,34 @@
+package db
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command/db/inspect"
+	"github.com/0xPolygon/polygon-edge/command/db/migrate"
+	"github.com/0xPolygon/polygon-edge/command/db/rewind"
+	"github.com/0xPolygon/polygon-edge/command/db/verify"
+)
+
+func GetCommand() *cobra.Command {
+	dbCmd := &cobra.Command{
+		Use:   "db",
+		Short: "Top level command for offline inspection and maintenance of the node databases. Only accepts subcommands.",
+	}
+
+	registerSubcommands(dbCmd)
+
+	return dbCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// db migrate
+		migrate.GetCommand(),
+		// db inspect
+		inspect.GetCommand(),
+		// db verify
+		verify.GetCommand(),
+		// db rewind
+		rewind.GetCommand(),
+	)
+}
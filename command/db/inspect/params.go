@@ -0,0 +1,106 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/dbengine"
+	"github.com/0xPolygon/polygon-edge/command"
+	dbcommon "github.com/0xPolygon/polygon-edge/command/db/common"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	params = &inspectParams{}
+)
+
+// bucket is a group of keys sharing a prefix
+type bucket struct {
+	name   string
+	prefix []byte
+}
+
+var (
+	blockchainBuckets = []bucket{
+		{"canonical", storage.CANONICAL},
+		{"headers", storage.HEADER},
+		{"bodies", storage.BODY},
+		{"receipts", storage.RECEIPTS},
+		{"txLookups", storage.TX_LOOKUP_PREFIX},
+		{"difficulty", storage.DIFFICULTY},
+		{"head", storage.HEAD},
+		{"forks", storage.FORK},
+		{"snapshots", storage.SNAPSHOTS},
+	}
+
+	trieBuckets = []bucket{
+		{"code", []byte("code")},
+	}
+)
+
+const (
+	otherBucket    = "other"
+	trieNodeBucket = "nodes"
+)
+
+type inspectParams struct {
+	dbcommon.DataDirParams
+
+	databases []*databaseStats
+}
+
+func (p *inspectParams) inspect() error {
+	p.databases = make([]*databaseStats, 0, len(dbcommon.DatabaseDirs))
+
+	for _, dir := range dbcommon.DatabaseDirs {
+		buckets, classify := blockchainBuckets, classifyKey
+		if dir == dbcommon.TrieDir {
+			buckets, classify = trieBuckets, classifyTrieKey
+		}
+
+		stats := newDatabaseStats(dir, buckets)
+
+		err := dbengine.ForEach(dbengine.Engine(p.DBEngine), p.Path(dir), func(k, v []byte) error {
+			stats.add(classify(buckets, k), k, v)
+
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to inspect %s: %w", dir, err)
+		}
+
+		p.databases = append(p.databases, stats)
+	}
+
+	return nil
+}
+
+// classifyKey returns the name of the bucket the key belongs to
+func classifyKey(buckets []bucket, k []byte) string {
+	for _, b := range buckets {
+		if bytes.HasPrefix(k, b.prefix) {
+			return b.name
+		}
+	}
+
+	return otherBucket
+}
+
+// classifyTrieKey returns the name of the trie bucket the key belongs to,
+// trie nodes are stored under their hash
+func classifyTrieKey(buckets []bucket, k []byte) string {
+	if len(k) == types.HashLength {
+		return trieNodeBucket
+	}
+
+	return classifyKey(buckets, k)
+}
+
+func (p *inspectParams) getResult() command.CommandResult {
+	return &InspectResult{
+		DataDir:   p.DataDir,
+		DBEngine:  p.DBEngine,
+		Databases: p.databases,
+	}
+}
@@ -0,0 +1,111 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type bucketStats struct {
+	Name string `json:"name"`
+	Keys uint64 `json:"keys"`
+	Size uint64 `json:"size"`
+}
+
+type databaseStats struct {
+	Name    string         `json:"name"`
+	Keys    uint64         `json:"keys"`
+	Size    uint64         `json:"size"`
+	Buckets []*bucketStats `json:"buckets"`
+
+	index map[string]*bucketStats
+}
+
+func newDatabaseStats(name string, buckets []bucket) *databaseStats {
+	stats := &databaseStats{
+		Name:  name,
+		index: map[string]*bucketStats{},
+	}
+
+	for _, b := range buckets {
+		stats.bucket(b.name)
+	}
+
+	return stats
+}
+
+// bucket returns the stats of the bucket with the given name, creating them if needed
+func (d *databaseStats) bucket(name string) *bucketStats {
+	b, ok := d.index[name]
+	if !ok {
+		b = &bucketStats{Name: name}
+		d.index[name] = b
+		d.Buckets = append(d.Buckets, b)
+	}
+
+	return b
+}
+
+func (d *databaseStats) add(bucketName string, k, v []byte) {
+	size := uint64(len(k) + len(v))
+
+	b := d.bucket(bucketName)
+	b.Keys++
+	b.Size += size
+
+	d.Keys++
+	d.Size += size
+}
+
+type InspectResult struct {
+	DataDir   string           `json:"dataDir"`
+	DBEngine  string           `json:"dbEngine"`
+	Databases []*databaseStats `json:"databases"`
+}
+
+func (r *InspectResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DB INSPECT]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Data directory|%s", r.DataDir),
+		fmt.Sprintf("DB engine|%s", r.DBEngine),
+	}))
+
+	for _, db := range r.Databases {
+		buffer.WriteString(fmt.Sprintf("\n\n[%s]\n", db.Name))
+
+		rows := make([]string, 0, len(db.Buckets)+2)
+		rows = append(rows, "Bucket|Keys|Size")
+
+		for _, b := range db.Buckets {
+			rows = append(rows, fmt.Sprintf("%s|%d|%s", b.Name, b.Keys, formatSize(b.Size)))
+		}
+
+		rows = append(rows, fmt.Sprintf("total|%d|%s", db.Keys, formatSize(db.Size)))
+
+		buffer.WriteString(helper.FormatList(rows))
+	}
+
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
+
+// formatSize formats the given number of bytes in a human readable form
+func formatSize(size uint64) string {
+	const unit = 1024
+
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := uint64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.2f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
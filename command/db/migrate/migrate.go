@@ -0,0 +1,69 @@
+package migrate
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/dbengine"
+	"github.com/0xPolygon/polygon-edge/command"
+	dbcommon "github.com/0xPolygon/polygon-edge/command/db/common"
+)
+
+func GetCommand() *cobra.Command {
+	migrateCmd := &cobra.Command{
+		Use: "migrate",
+		Short: "Converts the blockchain and state databases of a data directory to another db engine. " +
+			"The node must be stopped",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(migrateCmd)
+
+	return migrateCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dbcommon.DataDirFlag,
+		"",
+		"the data directory of the node",
+	)
+
+	cmd.Flags().StringVar(
+		&params.from,
+		fromFlag,
+		string(dbengine.LevelDB),
+		fmt.Sprintf("the db engine the data directory is currently stored with (%s, %s)",
+			dbengine.LevelDB, dbengine.Pebble),
+	)
+
+	cmd.Flags().StringVar(
+		&params.to,
+		toFlag,
+		string(dbengine.Pebble),
+		fmt.Sprintf("the db engine to convert the data directory to (%s, %s)",
+			dbengine.LevelDB, dbengine.Pebble),
+	)
+
+	_ = cmd.MarkFlagRequired(dbcommon.DataDirFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.migrate(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
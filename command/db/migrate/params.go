@@ -0,0 +1,145 @@
+package migrate
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/dbengine"
+	"github.com/0xPolygon/polygon-edge/command"
+	dbcommon "github.com/0xPolygon/polygon-edge/command/db/common"
+)
+
+const (
+	fromFlag = "from"
+	toFlag   = "to"
+)
+
+var (
+	params = &migrateParams{}
+)
+
+var (
+	errSameEngine = errors.New("source and destination db engines must differ")
+)
+
+type migrateParams struct {
+	dataDir string
+	from    string
+	to      string
+
+	migrated []*migratedDB
+}
+
+func (p *migrateParams) validateFlags() error {
+	if err := dbengine.Validate(p.from); err != nil {
+		return err
+	}
+
+	if err := dbengine.Validate(p.to); err != nil {
+		return err
+	}
+
+	if p.from == p.to {
+		return errSameEngine
+	}
+
+	for _, dir := range dbcommon.DatabaseDirs {
+		if _, err := os.Stat(filepath.Join(p.dataDir, dir)); err != nil {
+			return fmt.Errorf("invalid data directory: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// migrate converts each database of the data directory to the destination engine.
+// All the databases are converted into temporary directories first and they replace
+// the original ones, which are kept as backups, only once every conversion succeeded,
+// so the data directory never ends up with the databases on different engines
+func (p *migrateParams) migrate() error {
+	dbs := make([]*migratedDB, 0, len(dbcommon.DatabaseDirs))
+	tmpPaths := make([]string, 0, len(dbcommon.DatabaseDirs))
+
+	for _, dir := range dbcommon.DatabaseDirs {
+		var (
+			path       = filepath.Join(p.dataDir, dir)
+			tmpPath    = fmt.Sprintf("%s.%s.tmp", path, p.to)
+			backupPath = fmt.Sprintf("%s.%s.bak", path, p.from)
+		)
+
+		for _, target := range []string{tmpPath, backupPath} {
+			if _, err := os.Stat(target); err == nil {
+				return fmt.Errorf("%s already exists, remove it and try again", target)
+			}
+		}
+
+		dbs = append(dbs, &migratedDB{Name: dir, Backup: backupPath})
+		tmpPaths = append(tmpPaths, tmpPath)
+	}
+
+	removeTmp := func() {
+		for _, tmpPath := range tmpPaths {
+			_ = os.RemoveAll(tmpPath)
+		}
+	}
+
+	for i, db := range dbs {
+		count, err := dbengine.Migrate(
+			dbengine.Engine(p.from), filepath.Join(p.dataDir, db.Name), dbengine.Engine(p.to), tmpPaths[i])
+		if err != nil {
+			removeTmp()
+
+			return fmt.Errorf("failed to migrate %s: %w", db.Name, err)
+		}
+
+		db.Keys = count
+	}
+
+	for i, db := range dbs {
+		if err := p.swap(db, tmpPaths[i]); err != nil {
+			// put back the databases which are already swapped
+			for _, swapped := range dbs[:i] {
+				path := filepath.Join(p.dataDir, swapped.Name)
+
+				_ = os.RemoveAll(path)
+				_ = os.Rename(swapped.Backup, path)
+			}
+
+			removeTmp()
+
+			return err
+		}
+	}
+
+	p.migrated = dbs
+
+	return nil
+}
+
+// swap backs up the original database and moves the migrated one in its place
+func (p *migrateParams) swap(db *migratedDB, tmpPath string) error {
+	path := filepath.Join(p.dataDir, db.Name)
+
+	if err := os.Rename(path, db.Backup); err != nil {
+		return fmt.Errorf("failed to back up %s: %w", db.Name, err)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Rename(db.Backup, path)
+
+		return fmt.Errorf("failed to move the migrated %s in place: %w", db.Name, err)
+	}
+
+	return nil
+}
+
+func (p *migrateParams) getResult() command.CommandResult {
+	return &MigrateResult{
+		DataDir:   p.dataDir,
+		From:      p.from,
+		To:        p.to,
+		Databases: p.migrated,
+	}
+}
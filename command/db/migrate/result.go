@@ -0,0 +1,47 @@
+package migrate
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type migratedDB struct {
+	Name   string `json:"name"`
+	Keys   uint64 `json:"keys"`
+	Backup string `json:"backup"`
+}
+
+type MigrateResult struct {
+	DataDir   string        `json:"dataDir"`
+	From      string        `json:"from"`
+	To        string        `json:"to"`
+	Databases []*migratedDB `json:"databases"`
+}
+
+func (r *MigrateResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DB MIGRATE]\n")
+	buffer.WriteString("Migrated the data directory successfully:\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Data directory|%s", r.DataDir),
+		fmt.Sprintf("From|%s", r.From),
+		fmt.Sprintf("To|%s", r.To),
+	}))
+
+	for _, db := range r.Databases {
+		buffer.WriteString(fmt.Sprintf("\n\n[%s]\n", db.Name))
+		buffer.WriteString(helper.FormatKV([]string{
+			fmt.Sprintf("Keys|%d", db.Keys),
+			fmt.Sprintf("Backup|%s", db.Backup),
+		}))
+	}
+
+	buffer.WriteString("\n\nStart the node with --db-engine ")
+	buffer.WriteString(r.To)
+	buffer.WriteString(". The backups can be removed once the node runs fine.\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,103 @@
+package rewind
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/command"
+	dbcommon "github.com/0xPolygon/polygon-edge/command/db/common"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	toFlag = "to"
+)
+
+var (
+	params = &rewindParams{}
+)
+
+type rewindParams struct {
+	dbcommon.DataDirParams
+
+	to uint64
+
+	oldHeadNumber    uint64
+	newHead          *types.Header
+	consensusRewound bool
+}
+
+// rewind resets the head of the chain to the target block.
+// The consensus state is rolled back first, since a consensus state behind the chain head
+// is caught up on the next start, while one ahead of it is not
+func (p *rewindParams) rewind() error {
+	db, err := p.OpenBlockchain()
+	if err != nil {
+		return fmt.Errorf("failed to open blockchain storage: %w", err)
+	}
+	defer db.Close()
+
+	headNumber, ok := db.ReadHeadNumber()
+	if !ok {
+		return errors.New("head not found, the blockchain database is empty or corrupted")
+	}
+
+	if p.to > headNumber {
+		return fmt.Errorf("block %d is above the current head %d", p.to, headNumber)
+	}
+
+	hash, ok := db.ReadCanonicalHash(p.to)
+	if !ok {
+		return fmt.Errorf("canonical hash of block %d not found", p.to)
+	}
+
+	header, err := db.ReadHeader(hash)
+	if err != nil {
+		return fmt.Errorf("failed to read header of block %d: %w", p.to, err)
+	}
+
+	// the node can't resume from a block without its state
+	if err := p.verifyState(header.StateRoot); err != nil {
+		return fmt.Errorf("state of block %d is not available, rewind to a later block: %w", p.to, err)
+	}
+
+	if err := polybft.RewindState(p.Path(dbcommon.ConsensusDir), header); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to rewind consensus state: %w", err)
+		}
+	} else {
+		p.consensusRewound = true
+	}
+
+	if p.newHead, err = blockchain.RewindStorage(db, p.to); err != nil {
+		return err
+	}
+
+	p.oldHeadNumber = headNumber
+
+	return nil
+}
+
+func (p *rewindParams) verifyState(stateRoot types.Hash) error {
+	stateStorage, err := p.OpenState()
+	if err != nil {
+		return err
+	}
+	defer stateStorage.Close()
+
+	return itrie.VerifyTrie(stateRoot, stateStorage)
+}
+
+func (p *rewindParams) getResult() command.CommandResult {
+	return &RewindResult{
+		DataDir:          p.DataDir,
+		OldHeadNumber:    p.oldHeadNumber,
+		HeadNumber:       p.newHead.Number,
+		HeadHash:         p.newHead.Hash.String(),
+		ConsensusRewound: p.consensusRewound,
+	}
+}
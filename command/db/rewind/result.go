@@ -0,0 +1,33 @@
+package rewind
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type RewindResult struct {
+	DataDir          string `json:"dataDir"`
+	OldHeadNumber    uint64 `json:"oldHeadNumber"`
+	HeadNumber       uint64 `json:"headNumber"`
+	HeadHash         string `json:"headHash"`
+	ConsensusRewound bool   `json:"consensusRewound"`
+}
+
+func (r *RewindResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DB REWIND]\n")
+	buffer.WriteString("Rewound the head successfully:\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Data directory|%s", r.DataDir),
+		fmt.Sprintf("Previous head|%d", r.OldHeadNumber),
+		fmt.Sprintf("Head|%d", r.HeadNumber),
+		fmt.Sprintf("Head hash|%s", r.HeadHash),
+		fmt.Sprintf("Consensus state rewound|%t", r.ConsensusRewound),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
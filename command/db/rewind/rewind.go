@@ -0,0 +1,51 @@
+package rewind
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+)
+
+func GetCommand() *cobra.Command {
+	rewindCmd := &cobra.Command{
+		Use: "rewind",
+		Short: "Resets the blockchain head and the consensus state to an earlier block. " +
+			"The node must be stopped",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(rewindCmd)
+
+	return rewindCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	params.RegisterFlags(cmd)
+
+	cmd.Flags().Uint64Var(
+		&params.to,
+		toFlag,
+		0,
+		"the block number to rewind the head to",
+	)
+
+	_ = cmd.MarkFlagRequired(toFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.ValidateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.rewind(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
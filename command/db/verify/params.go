@@ -0,0 +1,220 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/command"
+	dbcommon "github.com/0xPolygon/polygon-edge/command/db/common"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	fromFlag = "from"
+
+	// maxReportedIssues is the maximum number of issues listed in the output
+	maxReportedIssues = 100
+)
+
+var (
+	params = &verifyParams{}
+)
+
+var (
+	errHeadNotFound = errors.New("head not found, the blockchain database is empty or corrupted")
+)
+
+type verifyParams struct {
+	dbcommon.DataDirParams
+
+	from uint64
+
+	head    *types.Header
+	blocks  uint64
+	txs     uint64
+	issues  []string
+	dropped int
+}
+
+func (p *verifyParams) verify() error {
+	db, err := p.OpenBlockchain()
+	if err != nil {
+		return fmt.Errorf("failed to open blockchain storage: %w", err)
+	}
+	defer db.Close()
+
+	stateStorage, err := p.OpenState()
+	if err != nil {
+		return fmt.Errorf("failed to open state storage: %w", err)
+	}
+	defer stateStorage.Close()
+
+	if err := p.verifyHead(db); err != nil {
+		return err
+	}
+
+	p.verifyChain(db)
+
+	if err := itrie.VerifyTrie(p.head.StateRoot, stateStorage); err != nil {
+		p.addIssue("state trie of head block %d (root %s) is incomplete: %v", p.head.Number, p.head.StateRoot, err)
+	}
+
+	if len(p.issues) > 0 {
+		return p.issuesError()
+	}
+
+	return nil
+}
+
+// verifyHead checks that the head hash and number point at the same canonical block
+func (p *verifyParams) verifyHead(db storage.Storage) error {
+	headHash, ok := db.ReadHeadHash()
+	if !ok {
+		return errHeadNotFound
+	}
+
+	headNumber, ok := db.ReadHeadNumber()
+	if !ok {
+		return errHeadNotFound
+	}
+
+	head, err := db.ReadHeader(headHash)
+	if err != nil {
+		return fmt.Errorf("failed to read head header %s: %w", headHash, err)
+	}
+
+	head.Hash = headHash
+	p.head = head
+
+	if head.Number != headNumber {
+		p.addIssue("head number %d does not match the number %d of head block %s", headNumber, head.Number, headHash)
+	}
+
+	if canonical, ok := db.ReadCanonicalHash(head.Number); !ok || canonical != headHash {
+		p.addIssue("head block %s is not the canonical block %d", headHash, head.Number)
+	}
+
+	if p.from > head.Number {
+		return fmt.Errorf("from block %d is above the head block %d", p.from, head.Number)
+	}
+
+	return nil
+}
+
+// verifyChain checks the canonical blocks from the given block up to the head
+func (p *verifyParams) verifyChain(db storage.Storage) {
+	var parentHash types.Hash
+
+	for n := p.from; n <= p.head.Number; n++ {
+		p.blocks++
+
+		hash, ok := db.ReadCanonicalHash(n)
+		if !ok {
+			p.addIssue("canonical hash of block %d not found", n)
+
+			parentHash = types.ZeroHash
+
+			continue
+		}
+
+		header, err := db.ReadHeader(hash)
+		if err != nil {
+			p.addIssue("header of block %d (%s) not found: %v", n, hash, err)
+
+			parentHash = hash
+
+			continue
+		}
+
+		if header.Number != n {
+			p.addIssue("canonical block %d (%s) has number %d", n, hash, header.Number)
+		}
+
+		header.ComputeHash()
+
+		if header.Hash != hash {
+			p.addIssue("header of block %d hashes to %s instead of %s", n, header.Hash, hash)
+		}
+
+		if n > p.from && parentHash != types.ZeroHash && header.ParentHash != parentHash {
+			p.addIssue("block %d (%s) does not link to its canonical parent %s", n, hash, parentHash)
+		}
+
+		parentHash = hash
+
+		// genesis block is stored without a body
+		if n > 0 {
+			p.verifyBody(db, n, hash)
+		}
+	}
+}
+
+// verifyBody checks the body, receipts and transaction lookups of the given block
+func (p *verifyParams) verifyBody(db storage.Storage, n uint64, hash types.Hash) {
+	body, err := db.ReadBody(hash)
+	if err != nil {
+		p.addIssue("body of block %d (%s) not found: %v", n, hash, err)
+
+		return
+	}
+
+	receipts, err := db.ReadReceipts(hash)
+	if err != nil {
+		p.addIssue("receipts of block %d (%s) not found: %v", n, hash, err)
+	} else if len(receipts) != len(body.Transactions) {
+		p.addIssue("block %d (%s) has %d transactions but %d receipts", n, hash, len(body.Transactions), len(receipts))
+	}
+
+	for _, tx := range body.Transactions {
+		p.txs++
+
+		if lookup, ok := db.ReadTxLookup(tx.Hash); !ok {
+			p.addIssue("lookup of transaction %s in block %d not found", tx.Hash, n)
+		} else if lookup != hash {
+			p.addIssue("lookup of transaction %s in block %d points at block %s", tx.Hash, n, lookup)
+		}
+	}
+}
+
+func (p *verifyParams) addIssue(format string, args ...interface{}) {
+	if len(p.issues) >= maxReportedIssues {
+		p.dropped++
+
+		return
+	}
+
+	p.issues = append(p.issues, fmt.Sprintf(format, args...))
+}
+
+func (p *verifyParams) issuesError() error {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("verification of blocks %d-%d failed:\n", p.from, p.head.Number))
+
+	for _, issue := range p.issues {
+		sb.WriteString("- ")
+		sb.WriteString(issue)
+		sb.WriteString("\n")
+	}
+
+	if p.dropped > 0 {
+		sb.WriteString(fmt.Sprintf("... and %d more issues\n", p.dropped))
+	}
+
+	return errors.New(sb.String())
+}
+
+func (p *verifyParams) getResult() command.CommandResult {
+	return &VerifyResult{
+		DataDir:      p.DataDir,
+		From:         p.from,
+		HeadNumber:   p.head.Number,
+		HeadHash:     p.head.Hash.String(),
+		StateRoot:    p.head.StateRoot.String(),
+		Blocks:       p.blocks,
+		Transactions: p.txs,
+	}
+}
@@ -0,0 +1,36 @@
+package verify
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type VerifyResult struct {
+	DataDir      string `json:"dataDir"`
+	From         uint64 `json:"from"`
+	HeadNumber   uint64 `json:"headNumber"`
+	HeadHash     string `json:"headHash"`
+	StateRoot    string `json:"stateRoot"`
+	Blocks       uint64 `json:"blocks"`
+	Transactions uint64 `json:"transactions"`
+}
+
+func (r *VerifyResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DB VERIFY]\n")
+	buffer.WriteString("The data directory is consistent:\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Data directory|%s", r.DataDir),
+		fmt.Sprintf("Verified blocks|%d-%d", r.From, r.HeadNumber),
+		fmt.Sprintf("Head hash|%s", r.HeadHash),
+		fmt.Sprintf("Head state root|%s", r.StateRoot),
+		fmt.Sprintf("Blocks|%d", r.Blocks),
+		fmt.Sprintf("Transactions|%d", r.Transactions),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,28 @@
+package debug
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command/debug/profiles"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+func GetCommand() *cobra.Command {
+	debugCmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Top level command for debugging a running node. Only accepts subcommands.",
+	}
+
+	helper.RegisterGRPCEndpointFlags(debugCmd)
+
+	registerSubcommands(debugCmd)
+
+	return debugCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// debug profiles
+		profiles.GetCommand(),
+	)
+}
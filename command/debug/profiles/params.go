@@ -0,0 +1,129 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	empty "google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+const (
+	fetchFlag = "fetch"
+	outFlag   = "out"
+)
+
+var (
+	params = &profilesParams{}
+)
+
+type profilesParams struct {
+	fetch string
+	out   string
+}
+
+func (p *profilesParams) listCaptures(grpcEndpoint helper.GRPCEndpoint) (*ProfilesListResult, error) {
+	client, err := helper.GetSystemClientConnection(grpcEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ProfilesList(context.Background(), &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return newProfilesListResult(resp.Captures), nil
+}
+
+// fetchCapture writes the profiles of the capture to a new directory of the output directory
+func (p *profilesParams) fetchCapture(grpcEndpoint helper.GRPCEndpoint) (*ProfilesFetchResult, error) {
+	client, err := helper.GetSystemClientConnection(grpcEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ProfilesList(context.Background(), &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	var capture *proto.ProfileCapture
+
+	for _, c := range resp.Captures {
+		if c.Name == p.fetch {
+			capture = c
+
+			break
+		}
+	}
+
+	if capture == nil {
+		return nil, fmt.Errorf("capture %s not found", p.fetch)
+	}
+
+	dir := filepath.Join(p.out, capture.Name)
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, err
+	}
+
+	result := &ProfilesFetchResult{Capture: capture.Name}
+
+	for _, file := range capture.Files {
+		path := filepath.Join(dir, file.Name)
+
+		if err := fetchProfile(client, capture.Name, file.Name, path); err != nil {
+			return nil, fmt.Errorf("failed to fetch %s: %w", file.Name, err)
+		}
+
+		result.Files = append(result.Files, path)
+	}
+
+	return result, nil
+}
+
+// fetchProfile writes a profile of the capture to a new file, the file is removed if the fetch fails
+func fetchProfile(client proto.SystemClient, capture, name, path string) error {
+	stream, err := client.ProfilesGet(context.Background(), &proto.ProfilesGetRequest{
+		Capture: capture,
+		File:    name,
+	})
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0640)
+	if err != nil {
+		return err
+	}
+
+	if err := writeChunks(stream, file); err != nil {
+		_ = file.Close()
+		_ = os.Remove(path)
+
+		return err
+	}
+
+	return file.Close()
+}
+
+func writeChunks(stream proto.System_ProfilesGetClient, w io.Writer) error {
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if _, err := w.Write(chunk.Data); err != nil {
+			return err
+		}
+	}
+}
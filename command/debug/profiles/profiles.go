@@ -0,0 +1,66 @@
+package profiles
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+func GetCommand() *cobra.Command {
+	profilesCmd := &cobra.Command{
+		Use: "profiles",
+		Short: "Lists the profiles the node captured when the block production was slow, " +
+			"or fetches the profiles of a capture",
+		Run: runCommand,
+	}
+
+	setFlags(profilesCmd)
+
+	return profilesCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.fetch,
+		fetchFlag,
+		"",
+		"the name of the capture whose profiles are fetched, the captures are listed if it's not set",
+	)
+
+	cmd.Flags().StringVar(
+		&params.out,
+		outFlag,
+		".",
+		"the directory the profiles of the capture are fetched to, in a directory named after the capture",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	grpcEndpoint := helper.GetGRPCEndpoint(cmd)
+
+	if params.fetch == "" {
+		result, err := params.listCaptures(grpcEndpoint)
+		if err != nil {
+			outputter.SetError(err)
+
+			return
+		}
+
+		outputter.SetCommandResult(result)
+
+		return
+	}
+
+	result, err := params.fetchCapture(grpcEndpoint)
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(result)
+}
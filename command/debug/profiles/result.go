@@ -0,0 +1,94 @@
+package profiles
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+)
+
+type ProfileCapture struct {
+	Name   string    `json:"name"`
+	Reason string    `json:"reason"`
+	Height uint64    `json:"height"`
+	Time   time.Time `json:"time"`
+	Files  []string  `json:"files"`
+}
+
+type ProfilesListResult struct {
+	Captures []ProfileCapture `json:"captures"`
+}
+
+func newProfilesListResult(captures []*proto.ProfileCapture) *ProfilesListResult {
+	result := &ProfilesListResult{
+		Captures: make([]ProfileCapture, len(captures)),
+	}
+
+	for i, c := range captures {
+		files := make([]string, len(c.Files))
+		for j, f := range c.Files {
+			files[j] = fmt.Sprintf("%s (%d bytes)", f.Name, f.Size)
+		}
+
+		result.Captures[i] = ProfileCapture{
+			Name:   c.Name,
+			Reason: c.Reason,
+			Height: c.Height,
+			Time:   time.Unix(c.Time, 0).UTC(),
+			Files:  files,
+		}
+	}
+
+	return result
+}
+
+func (r *ProfilesListResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[PROFILE CAPTURES]\n")
+
+	if len(r.Captures) == 0 {
+		buffer.WriteString("No captures found\n")
+
+		return buffer.String()
+	}
+
+	for i, c := range r.Captures {
+		if i > 0 {
+			buffer.WriteString("\n\n")
+		}
+
+		buffer.WriteString(helper.FormatKV([]string{
+			fmt.Sprintf("Name|%s", c.Name),
+			fmt.Sprintf("Reason|%s", c.Reason),
+			fmt.Sprintf("Height|%d", c.Height),
+			fmt.Sprintf("Time|%s", c.Time.Format(time.RFC3339)),
+			fmt.Sprintf("Files|%s", strings.Join(c.Files, ", ")),
+		}))
+	}
+
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
+
+type ProfilesFetchResult struct {
+	Capture string   `json:"capture"`
+	Files   []string `json:"files"`
+}
+
+func (r *ProfilesFetchResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[PROFILES FETCHED]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Capture|%s", r.Capture),
+		fmt.Sprintf("Files|%s", strings.Join(r.Files, ", ")),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -42,6 +42,13 @@ const (
 	JSONOutputFlag  = "json"
 	GRPCAddressFlag = "grpc-address"
 	JSONRPCFlag     = "jsonrpc"
+
+	// the flags of the commands which connect to the admin services of a node
+	DataDirFlag     = "data-dir"
+	IPCPathFlag     = "ipc-path"
+	GRPCTLSCertFlag = "grpc-tls-cert"
+	GRPCTLSKeyFlag  = "grpc-tls-key"
+	GRPCTLSCAFlag   = "grpc-tls-ca"
 )
 
 // GRPCAddressFlagLEGACY Legacy flag that needs to be present to preserve backwards
@@ -0,0 +1,129 @@
+package devnet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/devnet"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+)
+
+// GetCommand returns the devnet command
+func GetCommand() *cobra.Command {
+	devnetCmd := &cobra.Command{
+		Use: "devnet",
+		Short: "Starts a local network of validators on loopback ports and waits for the first epoch. " +
+			"The network is stopped with Ctrl+C",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(devnetCmd)
+
+	return devnetCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().IntVar(
+		&params.validators,
+		validatorsFlag,
+		devnet.DefaultValidators,
+		fmt.Sprintf("the number of validators, at least %d", devnet.MinValidators),
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.chainID,
+		chainIDFlag,
+		command.DefaultChainID,
+		"the ID of the chain",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.epochSize,
+		epochSizeFlag,
+		devnet.DefaultEpochSize,
+		"the epoch size for the chain",
+	)
+
+	cmd.Flags().DurationVar(
+		&params.blockTime,
+		blockTimeFlag,
+		devnet.DefaultBlockTime,
+		"the predefined period which determines block creation frequency",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.blockGasLimit,
+		blockGasLimitFlag,
+		devnet.DefaultBlockGasLimit,
+		"the maximum amount of gas used by all transactions in a block",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.premine,
+		premineFlag,
+		[]string{},
+		fmt.Sprintf(
+			"the premined accounts and balances (format: <address>[:<balance>]). Default premined balance: %d",
+			command.DefaultPremineBalance,
+		),
+	)
+
+	cmd.Flags().StringVar(
+		&params.dir,
+		dirFlag,
+		"",
+		"the directory of the secrets, the genesis and the logs of the nodes, "+
+			"a temporary directory removed on exit is used by default",
+	)
+
+	cmd.Flags().StringVar(
+		&params.logLevel,
+		command.LogLevelFlag,
+		devnet.DefaultLogLevel,
+		"the log level of the nodes",
+	)
+
+	cmd.Flags().DurationVar(
+		&params.timeout,
+		timeoutFlag,
+		defaultStartTimeout,
+		"the time the network has to reach the end of the first epoch",
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	_, _ = outputter.Write([]byte("Starting the devnet...\n"))
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), params.timeout)
+	defer cancel()
+
+	network, err := devnet.Start(ctx, params.getConfig())
+	if err != nil {
+		outputter.SetError(fmt.Errorf("failed to start the devnet: %w", err))
+
+		return
+	}
+
+	outputter.WriteCommandResult(newDevnetResult(network))
+
+	<-common.GetTerminationSignalCh()
+
+	if err := network.Stop(); err != nil {
+		outputter.SetError(fmt.Errorf("failed to stop the devnet: %w", err))
+
+		return
+	}
+
+	outputter.SetCommandResult(&StopResult{Dir: network.Dir(), Removed: params.dir == ""})
+}
@@ -0,0 +1,57 @@
+package devnet
+
+import (
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/devnet"
+)
+
+const (
+	validatorsFlag    = "validators"
+	chainIDFlag       = "chain-id"
+	epochSizeFlag     = "epoch-size"
+	blockTimeFlag     = "block-time"
+	blockGasLimitFlag = "block-gas-limit"
+	premineFlag       = "premine"
+	dirFlag           = "dir"
+	timeoutFlag       = "timeout"
+
+	defaultStartTimeout = 2 * time.Minute
+)
+
+var (
+	params = &devnetParams{}
+)
+
+type devnetParams struct {
+	validators    int
+	chainID       uint64
+	epochSize     uint64
+	blockTime     time.Duration
+	blockGasLimit uint64
+	premine       []string
+	dir           string
+	logLevel      string
+	timeout       time.Duration
+}
+
+func (p *devnetParams) validateFlags() error {
+	if p.validators < devnet.MinValidators {
+		return devnet.ErrNotEnoughValidators
+	}
+
+	return nil
+}
+
+func (p *devnetParams) getConfig() devnet.Config {
+	return devnet.Config{
+		Dir:           p.dir,
+		Validators:    p.validators,
+		ChainID:       p.chainID,
+		EpochSize:     p.epochSize,
+		BlockTime:     p.blockTime,
+		BlockGasLimit: p.blockGasLimit,
+		Premine:       p.premine,
+		LogLevel:      p.logLevel,
+	}
+}
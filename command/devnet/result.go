@@ -0,0 +1,94 @@
+package devnet
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/devnet"
+)
+
+type NodeResult struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+	JSONRPC string `json:"jsonrpc"`
+	IPC     string `json:"ipc"`
+	LibP2P  string `json:"libp2p"`
+	LogPath string `json:"log_path"`
+}
+
+type Result struct {
+	ChainID     uint64        `json:"chain_id"`
+	Dir         string        `json:"dir"`
+	GenesisPath string        `json:"genesis_path"`
+	Nodes       []*NodeResult `json:"nodes"`
+}
+
+func newDevnetResult(network *devnet.Devnet) *Result {
+	result := &Result{
+		ChainID:     network.ChainID(),
+		Dir:         network.Dir(),
+		GenesisPath: network.GenesisPath(),
+		Nodes:       make([]*NodeResult, len(network.Nodes())),
+	}
+
+	for i, node := range network.Nodes() {
+		result.Nodes[i] = &NodeResult{
+			Name:    node.Name,
+			Address: node.Address.String(),
+			JSONRPC: node.JSONRPCURL(),
+			IPC:     node.IPCPath(),
+			LibP2P:  node.LibP2PAddr,
+			LogPath: node.LogPath(),
+		}
+	}
+
+	return result
+}
+
+func (r *Result) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DEVNET RUNNING]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Chain ID|%d", r.ChainID),
+		fmt.Sprintf("Directory|%s", r.Dir),
+		fmt.Sprintf("Genesis|%s", r.GenesisPath),
+	}))
+	buffer.WriteString("\n")
+
+	for _, node := range r.Nodes {
+		buffer.WriteString(fmt.Sprintf("\n[%s]\n", node.Name))
+		buffer.WriteString(helper.FormatKV([]string{
+			fmt.Sprintf("Validator|%s", node.Address),
+			fmt.Sprintf("JSON-RPC|%s", node.JSONRPC),
+			fmt.Sprintf("IPC|%s", node.IPC),
+			fmt.Sprintf("LibP2P|%s", node.LibP2P),
+			fmt.Sprintf("Log|%s", node.LogPath),
+		}))
+		buffer.WriteString("\n")
+	}
+
+	buffer.WriteString("\nPress Ctrl+C to stop the devnet\n")
+
+	return buffer.String()
+}
+
+type StopResult struct {
+	Dir     string `json:"dir"`
+	Removed bool   `json:"removed"`
+}
+
+func (r *StopResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DEVNET STOPPED]\n")
+
+	if r.Removed {
+		buffer.WriteString(fmt.Sprintf("Removed the devnet directory %s\n", r.Dir))
+	} else {
+		buffer.WriteString(fmt.Sprintf("The secrets, the genesis and the logs are kept in %s\n", r.Dir))
+	}
+
+	return buffer.String()
+}
@@ -0,0 +1,139 @@
+package genesis
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+var (
+	forkParams = &genesisForkParams{}
+)
+
+// GetForkCommand returns the command generating the genesis of a new network
+// which starts from the state of an existing chain at a given block
+func GetForkCommand() *cobra.Command {
+	forkCmd := &cobra.Command{
+		Use: "fork",
+		Short: "Generates the genesis of a new network starting from the state of an existing chain. " +
+			"The node of the existing chain must be stopped",
+		PreRunE: runForkPreRun,
+		Run:     runForkCommand,
+	}
+
+	setForkFlags(forkCmd)
+	helper.SetRequiredFlags(forkCmd, forkParams.getRequiredFlags())
+
+	return forkCmd
+}
+
+func setForkFlags(cmd *cobra.Command) {
+	forkParams.RegisterFlags(cmd)
+
+	cmd.Flags().StringVar(
+		&forkParams.sourceChainPath,
+		sourceChainFlag,
+		"",
+		"the genesis file of the existing chain, its parameters are kept in the new genesis",
+	)
+
+	cmd.Flags().Int64Var(
+		&forkParams.block,
+		blockFlag,
+		headBlock,
+		"the block of the existing chain whose state the new network starts from (default is the head)",
+	)
+
+	cmd.Flags().StringVar(
+		&forkParams.genesisPath,
+		dirFlag,
+		fmt.Sprintf("./%s", command.DefaultGenesisFileName),
+		"the path of the new genesis file",
+	)
+
+	cmd.Flags().StringVar(
+		&forkParams.trieDir,
+		trieDirFlag,
+		defaultForkTrieDir,
+		"the directory the state of the new network is written to, "+
+			"it must be copied to the trie directory of every node of the new network",
+	)
+
+	cmd.Flags().Uint64Var(
+		&forkParams.chainID,
+		chainIDFlag,
+		0,
+		"the ID of the new chain",
+	)
+
+	cmd.Flags().StringVar(
+		&forkParams.name,
+		nameFlag,
+		"",
+		"the name of the new chain (default is the name of the existing chain)",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&forkParams.premine,
+		premineFlag,
+		[]string{},
+		fmt.Sprintf(
+			"the accounts whose balances are overridden in the new network (format: <address>[:<balance>]). "+
+				"Default balance: %d",
+			command.DefaultPremineBalance,
+		),
+	)
+
+	cmd.Flags().StringArrayVar(
+		&forkParams.bootnodes,
+		command.BootnodeFlag,
+		[]string{},
+		"multiAddr URL for p2p discovery bootstrap, the new validators are used if not set. "+
+			"This flag can be used multiple times",
+	)
+
+	cmd.Flags().StringVar(
+		&forkParams.validatorsPath,
+		command.ValidatorRootFlag,
+		command.DefaultValidatorRoot,
+		"root path containing the secrets of the new validators",
+	)
+
+	cmd.Flags().StringVar(
+		&forkParams.validatorsPrefixPath,
+		command.ValidatorPrefixFlag,
+		command.DefaultValidatorPrefix,
+		"folder prefix names for the secrets of the new validators",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&forkParams.validators,
+		command.ValidatorFlag,
+		[]string{},
+		"the new validators (format: <P2P multi address>:<ECDSA address>:<public BLS key>:<BLS signature>)",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(command.ValidatorFlag, command.ValidatorRootFlag)
+	cmd.MarkFlagsMutuallyExclusive(command.ValidatorFlag, command.ValidatorPrefixFlag)
+}
+
+func runForkPreRun(_ *cobra.Command, _ []string) error {
+	return forkParams.validateFlags()
+}
+
+func runForkCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	result, err := forkParams.fork(outputter)
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(result)
+}
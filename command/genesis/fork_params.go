@@ -0,0 +1,373 @@
+package genesis
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/dbengine"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/command"
+	dbcommon "github.com/0xPolygon/polygon-edge/command/db/common"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/server"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	sourceChainFlag = "source-chain"
+	blockFlag       = "block"
+	trieDirFlag     = "trie-dir"
+
+	defaultForkTrieDir = "./fork-trie"
+
+	// headBlock is the block flag value which forks the head of the existing chain
+	headBlock = -1
+)
+
+var (
+	errNotPolyBFTChain  = errors.New("the existing chain doesn't use the polybft consensus")
+	errForkChainIDReuse = errors.New("the chain ID of the new network must differ from the existing chain")
+)
+
+type genesisForkParams struct {
+	dbcommon.DataDirParams
+
+	sourceChainPath string
+	block           int64
+	genesisPath     string
+	trieDir         string
+
+	chainID   uint64
+	name      string
+	premine   []string
+	bootnodes []string
+
+	validatorsPath       string
+	validatorsPrefixPath string
+	validators           []string
+
+	premineInfos []*helper.PremineInfo
+}
+
+func (p *genesisForkParams) getRequiredFlags() []string {
+	return []string{
+		sourceChainFlag,
+		chainIDFlag,
+	}
+}
+
+func (p *genesisForkParams) validateFlags() error {
+	if err := p.DataDirParams.ValidateFlags(); err != nil {
+		return err
+	}
+
+	if p.chainID == 0 {
+		return errors.New("the chain ID of the new network must be greater than 0")
+	}
+
+	// the state is written to a new directory, so it can't be mixed with another state
+	if _, err := os.Stat(p.trieDir); err == nil {
+		return fmt.Errorf("the trie directory %s already exists", p.trieDir)
+	}
+
+	if err := verifyGenesisExistence(p.genesisPath); err != nil {
+		return errors.New(err.GetMessage())
+	}
+
+	p.premineInfos = make([]*helper.PremineInfo, 0, len(p.premine))
+
+	for _, premine := range p.premine {
+		premineInfo, err := helper.ParsePremineInfo(premine)
+		if err != nil {
+			return fmt.Errorf("invalid premine balance amount provided: %w", err)
+		}
+
+		p.premineInfos = append(p.premineInfos, premineInfo)
+	}
+
+	return nil
+}
+
+// fork copies the state of the existing chain at the given block, resets the Hydra system contracts in it
+// and writes the genesis of the new network starting from that state.
+// The system contracts are initialized again with the new validator set by the genesis of the new network,
+// while the balances, contracts and storage of all the other accounts are kept.
+func (p *genesisForkParams) fork(o command.OutputFormatter) (*GenesisForkResult, error) {
+	sourceChain, err := chain.ImportFromFile(p.sourceChainPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the genesis of the existing chain: %w", err)
+	}
+
+	if _, ok := sourceChain.Params.Engine[string(server.PolyBFTConsensus)]; !ok {
+		return nil, errNotPolyBFTChain
+	}
+
+	if sourceChain.Params.ChainID == int64(p.chainID) {
+		return nil, errForkChainIDReuse
+	}
+
+	polyBFTConfig, err := polybft.GetPolyBFTConfig(sourceChain)
+	if err != nil {
+		return nil, err
+	}
+
+	if !polyBFTConfig.NativeTokenConfig.IsMintable {
+		for _, premine := range p.premineInfos {
+			if premine.Address != types.ZeroAddress {
+				return nil, errNoPremineAllowed
+			}
+		}
+	}
+
+	validatorParams := &genesisParams{
+		genesisPath:          p.genesisPath,
+		validatorsPath:       p.validatorsPath,
+		validatorsPrefixPath: p.validatorsPrefixPath,
+		validators:           p.validators,
+	}
+
+	initialValidators, err := validatorParams.getValidatorAccounts()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve the new validators: %w", err)
+	}
+
+	if len(initialValidators) == 0 {
+		return nil, errNoGenesisValidators
+	}
+
+	if _, err := o.Write([]byte("[GENESIS VALIDATORS]\n")); err != nil {
+		return nil, err
+	}
+
+	for _, v := range initialValidators {
+		if _, err := o.Write([]byte(fmt.Sprintf("%v\n", v))); err != nil {
+			return nil, err
+		}
+	}
+
+	header, err := p.readHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	stateRoot, err := p.forkState(header.StateRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	polyBFTConfig.InitialValidatorSet = initialValidators
+	// use 1st account as governance address
+	polyBFTConfig.Governance = initialValidators[0].Address
+	polyBFTConfig.InitialTrieRoot = stateRoot
+
+	forkChain, err := p.forkChainConfig(sourceChain, polyBFTConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := helper.WriteGenesisConfigToDisk(forkChain, p.genesisPath); err != nil {
+		return nil, err
+	}
+
+	return &GenesisForkResult{
+		GenesisPath: p.genesisPath,
+		TrieDir:     p.trieDir,
+		Block:       header.Number,
+		ChainID:     p.chainID,
+		StateRoot:   stateRoot.String(),
+	}, nil
+}
+
+// readHeader reads the header of the forked block, the head if the block isn't set
+func (p *genesisForkParams) readHeader() (*types.Header, error) {
+	db, err := p.OpenBlockchain()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open blockchain storage: %w", err)
+	}
+	defer db.Close()
+
+	headNumber, ok := db.ReadHeadNumber()
+	if !ok {
+		return nil, errors.New("head not found, the blockchain database is empty or corrupted")
+	}
+
+	// the genesis can be forked as well, so the head is selected by a negative block
+	number := headNumber
+	if p.block >= 0 {
+		number = uint64(p.block)
+	}
+
+	if number > headNumber {
+		return nil, fmt.Errorf("block %d is above the current head %d", number, headNumber)
+	}
+
+	hash, ok := db.ReadCanonicalHash(number)
+	if !ok {
+		return nil, fmt.Errorf("canonical hash of block %d not found", number)
+	}
+
+	header, err := db.ReadHeader(hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header of block %d: %w", number, err)
+	}
+
+	return header, nil
+}
+
+// forkState copies the state at the given root to the trie directory, deletes the Hydra system contracts
+// and the contracts they created from it and overrides the premined balances. It returns the root of the new state
+func (p *genesisForkParams) forkState(root types.Hash) (types.Hash, error) {
+	source, err := p.OpenState()
+	if err != nil {
+		return types.ZeroHash, fmt.Errorf("failed to open trie storage: %w", err)
+	}
+	defer source.Close()
+
+	target, err := dbengine.OpenStateStorage(dbengine.Engine(p.DBEngine), p.trieDir, hclog.NewNullLogger())
+	if err != nil {
+		return types.ZeroHash, fmt.Errorf("failed to open the new trie storage: %w", err)
+	}
+	defer target.Close()
+
+	if err := itrie.CopyTrie(root.Bytes(), source, target, nil, false); err != nil {
+		return types.ZeroHash, fmt.Errorf("failed to copy the state of the existing chain: %w", err)
+	}
+
+	forkState := itrie.NewState(target)
+
+	snapshot, err := forkState.NewSnapshotAt(root)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+
+	txn := state.NewTxn(snapshot)
+
+	// the system contracts are deployed and initialized again in the genesis of the new network.
+	// The contracts they created, e.g. the vesting managers, are removed as well,
+	// since they are created again at the same addresses and their positions are reset
+	for _, contract := range getGenesisContracts() {
+		for nonce := uint64(0); nonce < txn.GetNonce(contract.address); nonce++ {
+			txn.Suicide(crypto.CreateAddress(contract.address, nonce))
+		}
+
+		txn.Suicide(contract.address)
+	}
+
+	for _, premine := range p.premineInfos {
+		txn.SetBalance(premine.Address, premine.Amount)
+	}
+
+	objs, err := txn.Commit(false)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+
+	_, newRoot, err := snapshot.Commit(objs)
+	if err != nil {
+		return types.ZeroHash, err
+	}
+
+	stateRoot := types.BytesToHash(newRoot)
+
+	checkedRoot, err := itrie.HashChecker(stateRoot.Bytes(), target)
+	if err != nil {
+		return types.ZeroHash, fmt.Errorf("failed to verify the new state: %w", err)
+	}
+
+	if checkedRoot != stateRoot {
+		return types.ZeroHash, fmt.Errorf("invalid new state root, expected %s, got %s", stateRoot, checkedRoot)
+	}
+
+	return stateRoot, nil
+}
+
+// forkChainConfig creates the chain configuration of the new network. The parameters of the existing chain
+// are kept, while the chain ID, the validators and the genesis allocations are replaced
+func (p *genesisForkParams) forkChainConfig(
+	sourceChain *chain.Chain,
+	polyBFTConfig polybft.PolyBFTConfig,
+) (*chain.Chain, error) {
+	totalStake := big.NewInt(0)
+	for _, validator := range polyBFTConfig.InitialValidatorSet {
+		totalStake.Add(totalStake, validator.Stake)
+	}
+
+	allocs, err := deployContracts(totalStake)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorMetadata := make([]*validator.ValidatorMetadata, len(polyBFTConfig.InitialValidatorSet))
+	bootnodes := p.bootnodes
+
+	for i, v := range polyBFTConfig.InitialValidatorSet {
+		// the validators stake their premined balance in the genesis. The balance is added to the account,
+		// so an existing allocation and the balance, code and storage of the copied state are kept
+		if alloc, ok := allocs[v.Address]; ok {
+			balance := new(big.Int).Set(v.Stake)
+			if alloc.Balance != nil {
+				balance.Add(balance, alloc.Balance)
+			}
+
+			alloc.Balance = balance
+		} else {
+			allocs[v.Address] = &chain.GenesisAccount{
+				Balance: v.Stake,
+			}
+		}
+
+		metadata, err := v.ToValidatorMetadata(command.DefaultNumerator, polybft.DefaultDenominator)
+		if err != nil {
+			return nil, err
+		}
+
+		validatorMetadata[i] = metadata
+
+		// set the new validators as boot nodes if boot nodes not provided via CLI
+		if len(p.bootnodes) == 0 {
+			bootnodes = append(bootnodes, v.MultiAddr)
+		}
+	}
+
+	extraData, err := GenerateExtraDataPolyBft(validatorMetadata)
+	if err != nil {
+		return nil, err
+	}
+
+	name := p.name
+	if name == "" {
+		name = sourceChain.Name
+	}
+
+	params := *sourceChain.Params
+	params.ChainID = int64(p.chainID)
+	params.Engine = map[string]interface{}{
+		string(server.PolyBFTConsensus): polyBFTConfig,
+	}
+
+	return &chain.Chain{
+		Name:   name,
+		Params: &params,
+		Genesis: &chain.Genesis{
+			GasLimit:           sourceChain.Genesis.GasLimit,
+			Difficulty:         0,
+			Alloc:              allocs,
+			ExtraData:          extraData,
+			GasUsed:            command.DefaultGenesisGasUsed,
+			Mixhash:            polybft.HydragonMixDigest,
+			BaseFee:            sourceChain.Genesis.BaseFee,
+			BaseFeeEM:          sourceChain.Genesis.BaseFeeEM,
+			BaseFeeChangeDenom: sourceChain.Genesis.BaseFeeChangeDenom,
+		},
+		Bootnodes: bootnodes,
+	}, nil
+}
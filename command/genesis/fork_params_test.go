@@ -0,0 +1,152 @@
+package genesis
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/dbengine"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/command"
+	dbcommon "github.com/0xPolygon/polygon-edge/command/db/common"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func Test_forkState(t *testing.T) {
+	t.Parallel()
+
+	var (
+		dataDir     = t.TempDir()
+		trieDir     = filepath.Join(t.TempDir(), "fork-trie")
+		holder      = types.StringToAddress("0x1")
+		overridden  = types.StringToAddress("0x2")
+		balance     = big.NewInt(1000)
+		overriding  = big.NewInt(5)
+		sourceChain = newTestForkChainConfig(t, validator.NewTestValidators(t, 4).GetParamValidators())
+		forkChain   = newTestForkChainConfig(t, validator.NewTestValidators(t, 2).GetParamValidators())
+	)
+
+	sourceChain.Genesis.Alloc[holder] = &chain.GenesisAccount{Balance: balance}
+	sourceChain.Genesis.Alloc[overridden] = &chain.GenesisAccount{Balance: balance}
+
+	// the existing chain, its system contracts are initialized with the old validators
+	sourceRoot := writeTestForkGenesis(t, filepath.Join(dataDir, dbcommon.TrieDir), sourceChain, types.ZeroHash)
+
+	p := &genesisForkParams{
+		DataDirParams: dbcommon.DataDirParams{DataDir: dataDir, DBEngine: string(dbengine.Default)},
+		trieDir:       trieDir,
+		premineInfos:  []*helper.PremineInfo{{Address: overridden, Amount: overriding}},
+	}
+
+	forkRoot, err := p.forkState(sourceRoot)
+	require.NoError(t, err)
+	require.NotEqual(t, sourceRoot, forkRoot)
+
+	// the system contracts are initialized again with the new validators
+	genesisRoot := writeTestForkGenesis(t, trieDir, forkChain, forkRoot)
+
+	storage, err := dbengine.OpenStateStorage(dbengine.Default, trieDir, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	defer storage.Close()
+
+	snapshot, err := itrie.NewState(storage).NewSnapshotAt(genesisRoot)
+	require.NoError(t, err)
+
+	txn := state.NewTxn(snapshot)
+	require.Equal(t, balance, txn.GetBalance(holder))
+	require.Equal(t, overriding, txn.GetBalance(overridden))
+	require.Equal(t, forkChain.Genesis.Alloc[contracts.HydraStakingContract].Balance,
+		txn.GetBalance(contracts.HydraStakingContract))
+}
+
+func Test_forkState_ResetRequired(t *testing.T) {
+	t.Parallel()
+
+	dataDir := t.TempDir()
+	trieDir := filepath.Join(dataDir, dbcommon.TrieDir)
+	sourceRoot := writeTestForkGenesis(t, trieDir,
+		newTestForkChainConfig(t, validator.NewTestValidators(t, 4).GetParamValidators()), types.ZeroHash)
+
+	// without the reset, the system contracts can't be initialized again
+	forkChain := newTestForkChainConfig(t, validator.NewTestValidators(t, 2).GetParamValidators())
+
+	storage, err := dbengine.OpenStateStorage(dbengine.Default, trieDir, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	defer storage.Close()
+
+	executor := state.NewExecutor(forkChain.Params, itrie.NewState(storage), hclog.NewNullLogger())
+	executor.GenesisPostHook = polybft.GenesisPostHookFactory(forkChain, polybft.ConsensusName)
+
+	_, err = executor.WriteGenesis(forkChain.Genesis.Alloc, sourceRoot)
+	require.Error(t, err)
+}
+
+func newTestForkChainConfig(t *testing.T, validators []*validator.GenesisValidator) *chain.Chain {
+	t.Helper()
+
+	totalStake := big.NewInt(0)
+	for _, v := range validators {
+		v.Stake = command.DefaultStake
+		totalStake.Add(totalStake, v.Stake)
+	}
+
+	alloc, err := deployContracts(totalStake)
+	require.NoError(t, err)
+
+	for _, v := range validators {
+		alloc[v.Address] = &chain.GenesisAccount{Balance: v.Stake}
+	}
+
+	var prices [310]*big.Int
+	for i := range prices {
+		prices[i] = big.NewInt(int64(i + 1))
+	}
+
+	return &chain.Chain{
+		Params: &chain.Params{
+			// the test validators sign their registration for the chain ID 1
+			ChainID: 1,
+			Forks:   chain.AllForksEnabled,
+			Engine: map[string]interface{}{
+				polybft.ConsensusName: &polybft.PolyBFTConfig{
+					InitialValidatorSet: validators,
+					EpochSize:           10,
+					NativeTokenConfig:   &polybft.TokenConfig{Name: "Test", Symbol: "TEST", Decimals: 18, IsMintable: true},
+					MaxValidatorSetSize: 150,
+					Governance:          validators[0].Address,
+					InitialPrices:       prices,
+					ProxyContractsAdmin: types.StringToAddress("0xadmin"),
+				},
+			},
+		},
+		Genesis: &chain.Genesis{Alloc: alloc},
+	}
+}
+
+func writeTestForkGenesis(t *testing.T, trieDir string, config *chain.Chain, initialRoot types.Hash) types.Hash {
+	t.Helper()
+
+	storage, err := dbengine.OpenStateStorage(dbengine.Default, trieDir, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	defer storage.Close()
+
+	executor := state.NewExecutor(config.Params, itrie.NewState(storage), hclog.NewNullLogger())
+	executor.GenesisPostHook = polybft.GenesisPostHookFactory(config, polybft.ConsensusName)
+
+	root, err := executor.WriteGenesis(config.Genesis.Alloc, initialRoot)
+	require.NoError(t, err)
+
+	return root
+}
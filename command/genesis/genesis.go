@@ -7,7 +7,6 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/command"
 	"github.com/0xPolygon/polygon-edge/command/genesis/predeploy"
-	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/consensus/ibft"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/validators"
@@ -27,12 +26,22 @@ func GetCommand() *cobra.Command {
 	genesisCmd.AddCommand(
 		// genesis predeploy
 		predeploy.GetCommand(),
+		// genesis fork
+		GetForkCommand(),
 	)
 
 	return genesisCmd
 }
 
 func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.specPath,
+		specFlag,
+		"",
+		"the genesis spec file (.json, .yaml or .yml) declaring the options of the command, "+
+			"the predeployed contracts and the fork schedule. The flags set explicitly override the spec",
+	)
+
 	cmd.Flags().StringVar(
 		&params.genesisPath,
 		dirFlag,
@@ -340,12 +349,19 @@ func setLegacyFlags(cmd *cobra.Command) {
 }
 
 func preRunCommand(cmd *cobra.Command, _ []string) error {
+	if params.specPath != "" {
+		spec, err := readGenesisSpec(params.specPath)
+		if err != nil {
+			return err
+		}
+
+		params.specErrors = spec.apply(params, cmd.Flags())
+	}
+
 	if err := params.validateFlags(); err != nil {
 		return err
 	}
 
-	helper.SetRequiredFlags(cmd, params.getRequiredFlags())
-
 	return params.initRawParams()
 }
 
@@ -50,6 +50,7 @@ var (
 
 var (
 	errValidatorsNotSpecified   = errors.New("validator information not specified")
+	errBootnodesNotSpecified    = errors.New("bootnodes not specified")
 	errUnsupportedConsensus     = errors.New("specified consensusRaw not supported")
 	errInvalidEpochSize         = errors.New("epoch size must be greater than 1")
 	errReserveAccMustBePremined = errors.New(
@@ -132,36 +133,54 @@ type genesisParams struct {
 
 	secretsConfigPath string
 	secretsConfig     *secrets.SecretsManagerConfig
+
+	// spec file
+	specPath   string
+	specErrors []error
+	predeploys []*predeploySpec
+	forks      map[string]*chain.Fork
 }
 
+// validateFlags validates the genesis parameters and reports all the invalid ones at once
 func (p *genesisParams) validateFlags() error {
+	return errors.Join(p.validate()...)
+}
+
+func (p *genesisParams) validate() []error {
+	// the spec file errors are reported together with the errors of the parameters
+	errs := append([]error{}, p.specErrors...)
+
 	// Check if the consensusRaw is supported
 	if !server.ConsensusSupported(p.consensusRaw) {
-		return errUnsupportedConsensus
+		errs = append(errs, errUnsupportedConsensus)
 	}
 
 	if err := p.validateGenesisBaseFeeConfig(); err != nil {
-		return err
+		errs = append(errs, err)
 	}
 
 	// Check if validator information is set at all
 	if p.isIBFTConsensus() &&
 		!p.areValidatorsSetManually() &&
 		!p.areValidatorsSetByPrefix() {
-		return errValidatorsNotSpecified
+		errs = append(errs, errValidatorsNotSpecified)
+	}
+
+	// the boot nodes are checked here since the spec file can provide them as well
+	if p.isIBFTConsensus() && len(p.bootnodes) == 0 {
+		errs = append(errs, errBootnodesNotSpecified)
 	}
 
 	if err := p.parsePremineInfo(); err != nil {
-		return err
+		errs = append(errs, err)
 	}
 
 	if p.isPolyBFTConsensus() {
+		// the burn contract is validated against the native token, so it needs a valid token config
 		if err := p.extractNativeTokenMetadata(); err != nil {
-			return err
-		}
-
-		if err := p.validateBurnContract(); err != nil {
-			return err
+			errs = append(errs, err)
+		} else if err := p.validateBurnContract(); err != nil {
+			errs = append(errs, err)
 		}
 
 		// Hydra modification: We don't need a reserve account premining, we use the 0x0 address for burning
@@ -170,36 +189,39 @@ func (p *genesisParams) validateFlags() error {
 		// }
 
 		if err := p.validateProxyContractsAdmin(); err != nil {
-			return err
+			errs = append(errs, err)
 		}
 	}
 
 	// Check if the genesis file already exists
 	if generateError := verifyGenesisExistence(p.genesisPath); generateError != nil {
-		return errors.New(generateError.GetMessage())
+		errs = append(errs, errors.New(generateError.GetMessage()))
 	}
 
 	// Check that the epoch size is correct
 	if p.epochSize < 2 && (p.isIBFTConsensus() || p.isPolyBFTConsensus()) {
 		// Epoch size must be greater than 1, so new transactions have a chance to be added to a block.
 		// Otherwise, every block would be an endblock (meaning it will not have any transactions).
-		// Check is placed here to avoid additional parsing if epochSize < 2
-		return errInvalidEpochSize
+		errs = append(errs, errInvalidEpochSize)
 	}
 
 	// Validate validatorsPath only if validators information were not provided via CLI flag
 	if len(p.validators) == 0 {
 		if _, err := os.Stat(p.validatorsPath); err != nil {
-			return fmt.Errorf(
+			errs = append(errs, fmt.Errorf(
 				"invalid validators path ('%s') provided. Error: %w",
 				p.validatorsPath,
 				err,
-			)
+			))
 		}
 	}
 
 	// Validate min and max validators number
-	return command.ValidateMinMaxValidatorsNumber(p.minNumValidators, p.maxNumValidators)
+	if err := command.ValidateMinMaxValidatorsNumber(p.minNumValidators, p.maxNumValidators); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
 }
 
 func (p *genesisParams) isIBFTConsensus() bool {
@@ -218,16 +240,6 @@ func (p *genesisParams) areValidatorsSetByPrefix() bool {
 	return p.validatorsPrefixPath != ""
 }
 
-func (p *genesisParams) getRequiredFlags() []string {
-	if p.isIBFTConsensus() {
-		return []string{
-			command.BootnodeFlag,
-		}
-	}
-
-	return []string{}
-}
-
 func (p *genesisParams) initRawParams() error {
 	p.consensus = server.ConsensusType(p.consensusRaw)
 
@@ -394,7 +406,7 @@ func (p *genesisParams) generateGenesis() error {
 
 func (p *genesisParams) initGenesisConfig() error {
 	// Disable london hardfork if burn contract address is not provided
-	enabledForks := chain.AllForksEnabled
+	enabledForks := p.getForks()
 	// Hydra modification: london hardfork is enabled no matter the burn contract state
 	// if !p.isBurnContractEnabled() {
 	// 	enabledForks.RemoveFork(chain.London)
@@ -439,6 +451,10 @@ func (p *genesisParams) initGenesisConfig() error {
 		}
 	}
 
+	if err := p.predeployContracts(chainConfig); err != nil {
+		return err
+	}
+
 	p.genesisConfig = chainConfig
 
 	return nil
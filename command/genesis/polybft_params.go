@@ -63,6 +63,9 @@ var (
 	errNoGenesisValidators = errors.New("genesis validators aren't provided")
 	errNoPremineAllowed    = errors.New("native token is not mintable, so no premine is allowed " +
 		"except for zero address and reward wallet if native token is used as reward token")
+
+	// initialPrices retrieves the price history stored in the genesis, tests replace it to stay offline
+	initialPrices = (*genesisParams).getInitialPrices
 )
 
 type contractInfo struct {
@@ -112,10 +115,6 @@ func (p *genesisParams) generatePolyBftChainConfig(o command.OutputFormatter) er
 		}
 	}
 
-	if err := p.initSecretsConfig(); err != nil {
-		return err
-	}
-
 	polyBftConfig := &polybft.PolyBFTConfig{
 		InitialValidatorSet: initialValidators,
 		BlockTime:           common.Duration{Duration: p.blockTime},
@@ -133,13 +132,13 @@ func (p *genesisParams) generatePolyBftChainConfig(o command.OutputFormatter) er
 		ProxyContractsAdmin:      types.StringToAddress(p.proxyContractsAdmin),
 	}
 
-	polyBftConfig.InitialPrices, err = p.getInitialPrices()
+	polyBftConfig.InitialPrices, err = initialPrices(p)
 	if err != nil {
 		return err
 	}
 
 	// Disable london hardfork if burn contract address is not provided
-	enabledForks := chain.AllForksEnabled
+	enabledForks := p.getForks()
 	// Hydra modification: london hardfork is enabled no matter the burn contract state
 	// if !p.isBurnContractEnabled() {
 	// 	enabledForks.RemoveFork(chain.London)
@@ -191,7 +190,7 @@ func (p *genesisParams) generatePolyBftChainConfig(o command.OutputFormatter) er
 	}
 
 	// deploy genesis contracts
-	allocs, err := p.deployContracts(totalStake)
+	allocs, err := deployContracts(totalStake)
 	if err != nil {
 		return err
 	}
@@ -301,12 +300,37 @@ func (p *genesisParams) generatePolyBftChainConfig(o command.OutputFormatter) er
 	chainConfig.Genesis.BaseFeeEM = p.parsedBaseFeeConfig.baseFeeEM
 	chainConfig.Genesis.BaseFeeChangeDenom = p.parsedBaseFeeConfig.baseFeeChangeDenom
 
+	if err := p.predeployContracts(chainConfig); err != nil {
+		return err
+	}
+
 	return helper.WriteGenesisConfigToDisk(chainConfig, params.genesisPath)
 }
 
-func (p *genesisParams) deployContracts(
+func deployContracts(
 	totalStake *big.Int,
 ) (map[types.Address]*chain.GenesisAccount, error) {
+	genesisContracts := getGenesisContracts()
+	allocations := make(map[types.Address]*chain.GenesisAccount, len(genesisContracts)+1)
+
+	for _, contract := range genesisContracts {
+		allocations[contract.address] = &chain.GenesisAccount{
+			Balance: big.NewInt(0),
+			Code:    contract.artifact.DeployedBytecode,
+		}
+	}
+
+	// HydraStaking must have funds pre-allocated, because of withdrawal workflow
+	allocations[contracts.HydraStakingContract].Balance = totalStake
+
+	// RewardWallet must have funds pre-allocated (2/3 of maxUint256)
+	allocations[contracts.RewardWalletContract].Balance = common.GetTwoThirdOfMaxUint256()
+
+	return allocations, nil
+}
+
+// getGenesisContracts returns the system contracts deployed in the genesis, including their proxies
+func getGenesisContracts() []*contractInfo {
 	proxyToImplAddrMap := contracts.GetProxyImplementationMapping()
 	proxyAddresses := make([]types.Address, 0, len(proxyToImplAddrMap))
 
@@ -390,24 +414,7 @@ func (p *genesisParams) deployContracts(
 	// 		})
 	// }
 
-	allocations := make(map[types.Address]*chain.GenesisAccount, len(genesisContracts)+1)
-
-	genesisContracts = append(genesisContracts, getProxyContractsInfo(proxyAddresses)...)
-
-	for _, contract := range genesisContracts {
-		allocations[contract.address] = &chain.GenesisAccount{
-			Balance: big.NewInt(0),
-			Code:    contract.artifact.DeployedBytecode,
-		}
-	}
-
-	// HydraStaking must have funds pre-allocated, because of withdrawal workflow
-	allocations[contracts.HydraStakingContract].Balance = totalStake
-
-	// RewardWallet must have funds pre-allocated (2/3 of maxUint256)
-	allocations[contracts.RewardWalletContract].Balance = common.GetTwoThirdOfMaxUint256()
-
-	return allocations, nil
+	return append(genesisContracts, getProxyContractsInfo(proxyAddresses)...)
 }
 
 // getValidatorAccounts gathers validator accounts info either from CLI or from provided local storage
@@ -502,6 +509,10 @@ func getProxyContractsInfo(addresses []types.Address) []*contractInfo {
 func (p *genesisParams) getInitialPrices() ([310]*big.Int, error) {
 	convertedPrices := [310]*big.Int{}
 
+	if err := p.initSecretsConfig(); err != nil {
+		return convertedPrices, err
+	}
+
 	apiKey, err := getCGAPIKey(p.secretsConfig)
 	if err != nil {
 		return convertedPrices, err
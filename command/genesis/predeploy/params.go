@@ -64,10 +64,6 @@ func (p *predeployParams) initRawParams() error {
 		return err
 	}
 
-	if err := p.verifyMinAddress(); err != nil {
-		return err
-	}
-
 	if err := p.initChain(); err != nil {
 		return err
 	}
@@ -81,8 +77,8 @@ func (p *predeployParams) initPredeployAddress() error {
 	}
 
 	address := types.StringToAddress(p.addressRaw)
-	if isReservedAddress(address) {
-		return errReservedPredeployAddress
+	if err := ValidateAddress(address); err != nil {
+		return err
 	}
 
 	p.address = address
@@ -90,6 +86,15 @@ func (p *predeployParams) initPredeployAddress() error {
 	return nil
 }
 
+// ValidateAddress checks whether a contract can be predeployed at the given address
+func ValidateAddress(address types.Address) error {
+	if isReservedAddress(address) {
+		return errReservedPredeployAddress
+	}
+
+	return verifyMinAddress(address)
+}
+
 func isReservedAddress(address types.Address) bool {
 	for _, reservedAddress := range reservedAddresses {
 		if address == reservedAddress {
@@ -100,8 +105,8 @@ func isReservedAddress(address types.Address) bool {
 	return false
 }
 
-func (p *predeployParams) verifyMinAddress() error {
-	address, err := hex.DecodeHexToBig(p.address.String())
+func verifyMinAddress(predeployAddress types.Address) error {
+	address, err := hex.DecodeHexToBig(predeployAddress.String())
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,9 @@ package genesis
 
 import (
 	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
 )
 
 type GenesisResult struct {
@@ -16,3 +19,28 @@ func (r *GenesisResult) GetOutput() string {
 
 	return buffer.String()
 }
+
+type GenesisForkResult struct {
+	GenesisPath string `json:"genesisPath"`
+	TrieDir     string `json:"trieDir"`
+	Block       uint64 `json:"block"`
+	ChainID     uint64 `json:"chainID"`
+	StateRoot   string `json:"stateRoot"`
+}
+
+func (r *GenesisForkResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[GENESIS FORK SUCCESS]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Forked Block|%d", r.Block),
+		fmt.Sprintf("Chain ID|%d", r.ChainID),
+		fmt.Sprintf("State Root|%s", r.StateRoot),
+		fmt.Sprintf("Genesis|%s", r.GenesisPath),
+		fmt.Sprintf("Trie|%s", r.TrieDir),
+	}))
+	buffer.WriteString(fmt.Sprintf("\n\nCopy %s to the trie directory of every node of the new network "+
+		"before starting it\n", r.TrieDir))
+
+	return buffer.String()
+}
@@ -0,0 +1,528 @@
+package genesis
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/genesis/predeploy"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/predeployment"
+	"github.com/0xPolygon/polygon-edge/txpool"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	specFlag = "spec"
+)
+
+var (
+	errPredeployAddressTaken = errors.New("the predeploy address is taken by another genesis account")
+)
+
+// genesisSpec is the declarative form of the genesis command. Its keys are named after the flags
+// of the command and its values are converted to the flag values, so a spec file generates
+// the same genesis as the equivalent flags. The predeployed contracts and the fork schedule
+// are available only in the spec file
+type genesisSpec struct {
+	Dir                 *string            `json:"dir"`
+	Name                *string            `json:"name"`
+	ChainID             *uint64            `json:"chain-id"`
+	Consensus           *string            `json:"consensus"`
+	Premine             []*premineSpec     `json:"premine"`
+	BlockGasLimit       *uint64            `json:"block-gas-limit"`
+	BurnContract        *burnContractSpec  `json:"burn-contract"`
+	BaseFeeConfig       *baseFeeConfigSpec `json:"base-fee-config"`
+	Bootnodes           []string           `json:"bootnodes"`
+	EpochSize           *uint64            `json:"epoch-size"`
+	ProxyContractsAdmin *string            `json:"proxy-contracts-admin"`
+	SecretsConfig       *string            `json:"secrets-config"`
+
+	// PoS
+	Pos               *bool            `json:"pos"`
+	MinValidatorCount *uint64          `json:"min-validator-count"`
+	MaxValidatorCount *uint64          `json:"max-validator-count"`
+	ValidatorsPath    *string          `json:"validators-path"`
+	ValidatorsPrefix  *string          `json:"validators-prefix"`
+	Validators        []*validatorSpec `json:"validators"`
+
+	// IBFT
+	IBFTValidatorType *string `json:"ibft-validator-type"`
+
+	// PolyBFT
+	SprintSize               *uint64                `json:"sprint-size"`
+	BlockTime                *common.Duration       `json:"block-time"`
+	EpochReward              *uint64                `json:"epoch-reward"`
+	TrieRoot                 *string                `json:"trieroot"`
+	NativeTokenConfig        *nativeTokenConfigSpec `json:"native-token-config"`
+	BlockTimeDrift           *uint64                `json:"block-time-drift"`
+	BlockTrackerPollInterval *common.Duration       `json:"block-tracker-poll-interval"`
+
+	// access lists
+	ContractDeployerAllowList *accessListSpec `json:"contract-deployer-allow-list"`
+	ContractDeployerBlockList *accessListSpec `json:"contract-deployer-block-list"`
+	TransactionsAllowList     *accessListSpec `json:"transactions-allow-list"`
+	TransactionsBlockList     *accessListSpec `json:"transactions-block-list"`
+	BridgeAllowList           *accessListSpec `json:"bridge-allow-list"`
+	BridgeBlockList           *accessListSpec `json:"bridge-block-list"`
+
+	Predeploys []*predeploySpec       `json:"predeploys"`
+	Forks      map[string]*chain.Fork `json:"forks"`
+}
+
+type premineSpec struct {
+	Address string `json:"address"`
+	// Amount is a string, so the amounts above the integer range of YAML and JSON can be used
+	Amount string `json:"amount"`
+}
+
+type validatorSpec struct {
+	MultiAddr    string `json:"multi-addr"`
+	Address      string `json:"address"`
+	BLSKey       string `json:"bls-key"`
+	BLSSignature string `json:"bls-signature"`
+}
+
+type burnContractSpec struct {
+	Block       uint64 `json:"block"`
+	Address     string `json:"address"`
+	Destination string `json:"destination"`
+}
+
+type baseFeeConfigSpec struct {
+	BaseFee            *uint64 `json:"base-fee"`
+	BaseFeeEM          *uint64 `json:"base-fee-em"`
+	BaseFeeChangeDenom *uint64 `json:"base-fee-change-denom"`
+}
+
+type nativeTokenConfigSpec struct {
+	Name     string `json:"name"`
+	Symbol   string `json:"symbol"`
+	Decimals uint8  `json:"decimals"`
+	Mintable bool   `json:"mintable"`
+	Owner    string `json:"owner"`
+}
+
+type accessListSpec struct {
+	Admin   []string `json:"admin"`
+	Enabled []string `json:"enabled"`
+}
+
+type predeploySpec struct {
+	Address         string   `json:"address"`
+	ArtifactsPath   string   `json:"artifacts-path"`
+	ConstructorArgs []string `json:"constructor-args"`
+}
+
+// readGenesisSpec reads the genesis spec from a JSON or YAML file. Unknown keys are rejected,
+// so a misspelled option doesn't silently fall back to its default
+func readGenesisSpec(path string) (*genesisSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the genesis spec: %w", err)
+	}
+
+	switch {
+	case strings.HasSuffix(path, ".json"):
+	case strings.HasSuffix(path, ".yaml"), strings.HasSuffix(path, ".yml"):
+		// YAML is converted to JSON, so both formats share the keys,
+		// including the ones of the fork parameters
+		var raw interface{}
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("failed to parse the genesis spec: %w", err)
+		}
+
+		if data, err = json.Marshal(raw); err != nil {
+			return nil, fmt.Errorf("failed to parse the genesis spec: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("suffix of %s is neither json, yaml nor yml", path)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	spec := &genesisSpec{}
+	if err := decoder.Decode(spec); err != nil {
+		return nil, fmt.Errorf("failed to parse the genesis spec: %w", err)
+	}
+
+	return spec, nil
+}
+
+// apply sets the genesis parameters from the spec. The flags set explicitly take precedence
+// over the spec. It returns the errors of the options which can't be validated by the flags
+func (s *genesisSpec) apply(p *genesisParams, flags *pflag.FlagSet) []error {
+	fromSpec := func(flag string, inSpec bool) bool {
+		return inSpec && !flags.Changed(flag)
+	}
+
+	if fromSpec(dirFlag, s.Dir != nil) {
+		p.genesisPath = *s.Dir
+	}
+
+	if fromSpec(nameFlag, s.Name != nil) {
+		p.name = *s.Name
+	}
+
+	if fromSpec(chainIDFlag, s.ChainID != nil) {
+		p.chainID = *s.ChainID
+	}
+
+	if fromSpec(command.ConsensusFlag, s.Consensus != nil) {
+		p.consensusRaw = *s.Consensus
+	}
+
+	if fromSpec(premineFlag, s.Premine != nil) {
+		p.premine = make([]string, len(s.Premine))
+
+		for i, premine := range s.Premine {
+			p.premine[i] = premine.String()
+		}
+	}
+
+	if fromSpec(blockGasLimitFlag, s.BlockGasLimit != nil) {
+		p.blockGasLimit = *s.BlockGasLimit
+	}
+
+	if fromSpec(burnContractFlag, s.BurnContract != nil) {
+		p.burnContract = s.BurnContract.String()
+	}
+
+	if fromSpec(genesisBaseFeeConfigFlag, s.BaseFeeConfig != nil) {
+		p.baseFeeConfig = s.BaseFeeConfig.String()
+	}
+
+	if fromSpec(command.BootnodeFlag, s.Bootnodes != nil) {
+		p.bootnodes = s.Bootnodes
+	}
+
+	if fromSpec(epochSizeFlag, s.EpochSize != nil) {
+		p.epochSize = *s.EpochSize
+	}
+
+	if fromSpec(proxyContractsAdminFlag, s.ProxyContractsAdmin != nil) {
+		p.proxyContractsAdmin = *s.ProxyContractsAdmin
+	}
+
+	if fromSpec(command.SecretsConfigFlag, s.SecretsConfig != nil) {
+		p.secretsConfigPath = *s.SecretsConfig
+	}
+
+	if fromSpec(posFlag, s.Pos != nil) {
+		p.isPos = *s.Pos
+	}
+
+	if fromSpec(command.MinValidatorCountFlag, s.MinValidatorCount != nil) {
+		p.minNumValidators = *s.MinValidatorCount
+	}
+
+	if fromSpec(command.MaxValidatorCountFlag, s.MaxValidatorCount != nil) {
+		p.maxNumValidators = *s.MaxValidatorCount
+	}
+
+	if fromSpec(command.ValidatorRootFlag, s.ValidatorsPath != nil) {
+		p.validatorsPath = *s.ValidatorsPath
+	}
+
+	if fromSpec(command.ValidatorPrefixFlag, s.ValidatorsPrefix != nil) {
+		p.validatorsPrefixPath = *s.ValidatorsPrefix
+	}
+
+	if fromSpec(command.ValidatorFlag, s.Validators != nil) {
+		p.validators = make([]string, len(s.Validators))
+
+		for i, v := range s.Validators {
+			p.validators[i] = v.String()
+		}
+	}
+
+	if fromSpec(command.IBFTValidatorTypeFlag, s.IBFTValidatorType != nil) {
+		p.rawIBFTValidatorType = *s.IBFTValidatorType
+	}
+
+	if fromSpec(sprintSizeFlag, s.SprintSize != nil) {
+		p.sprintSize = *s.SprintSize
+	}
+
+	if fromSpec(blockTimeFlag, s.BlockTime != nil) {
+		p.blockTime = s.BlockTime.Duration
+	}
+
+	if fromSpec(epochRewardFlag, s.EpochReward != nil) {
+		p.epochReward = *s.EpochReward
+	}
+
+	if fromSpec(trieRootFlag, s.TrieRoot != nil) {
+		p.initialStateRoot = *s.TrieRoot
+	}
+
+	if fromSpec(nativeTokenConfigFlag, s.NativeTokenConfig != nil) {
+		p.nativeTokenConfigRaw = s.NativeTokenConfig.String()
+	}
+
+	if fromSpec(blockTimeDriftFlag, s.BlockTimeDrift != nil) {
+		p.blockTimeDrift = *s.BlockTimeDrift
+	}
+
+	if fromSpec(blockTrackerPollIntervalFlag, s.BlockTrackerPollInterval != nil) {
+		p.blockTrackerPollInterval = s.BlockTrackerPollInterval.Duration
+	}
+
+	accessLists := []struct {
+		spec                   *accessListSpec
+		adminFlag, enabledFlag string
+		admin, enabled         *[]string
+	}{
+		{s.ContractDeployerAllowList, contractDeployerAllowListAdminFlag, contractDeployerAllowListEnabledFlag,
+			&p.contractDeployerAllowListAdmin, &p.contractDeployerAllowListEnabled},
+		{s.ContractDeployerBlockList, contractDeployerBlockListAdminFlag, contractDeployerBlockListEnabledFlag,
+			&p.contractDeployerBlockListAdmin, &p.contractDeployerBlockListEnabled},
+		{s.TransactionsAllowList, transactionsAllowListAdminFlag, transactionsAllowListEnabledFlag,
+			&p.transactionsAllowListAdmin, &p.transactionsAllowListEnabled},
+		{s.TransactionsBlockList, transactionsBlockListAdminFlag, transactionsBlockListEnabledFlag,
+			&p.transactionsBlockListAdmin, &p.transactionsBlockListEnabled},
+		{s.BridgeAllowList, bridgeAllowListAdminFlag, bridgeAllowListEnabledFlag,
+			&p.bridgeAllowListAdmin, &p.bridgeAllowListEnabled},
+		{s.BridgeBlockList, bridgeBlockListAdminFlag, bridgeBlockListEnabledFlag,
+			&p.bridgeBlockListAdmin, &p.bridgeBlockListEnabled},
+	}
+
+	for _, list := range accessLists {
+		if list.spec == nil {
+			continue
+		}
+
+		if fromSpec(list.adminFlag, list.spec.Admin != nil) {
+			*list.admin = list.spec.Admin
+		}
+
+		if fromSpec(list.enabledFlag, list.spec.Enabled != nil) {
+			*list.enabled = list.spec.Enabled
+		}
+	}
+
+	p.predeploys = s.Predeploys
+	p.forks = s.Forks
+
+	return s.validate(p)
+}
+
+// validate validates the options of the spec which aren't validated by the genesis parameters,
+// the predeployed contracts, the fork schedule and the options depending on each other
+func (s *genesisSpec) validate(p *genesisParams) []error {
+	var errs []error
+
+	if len(s.Validators) != 0 && (s.ValidatorsPath != nil || s.ValidatorsPrefix != nil) {
+		errs = append(errs, fmt.Errorf("%s: can't be used together with %s and %s",
+			command.ValidatorFlag, command.ValidatorRootFlag, command.ValidatorPrefixFlag))
+	}
+
+	validatorAddresses := make(map[types.Address]struct{}, len(p.validators))
+
+	for i, v := range s.Validators {
+		address := types.StringToAddress(v.Address)
+		if _, ok := validatorAddresses[address]; ok {
+			errs = append(errs, fmt.Errorf("%s[%d]: duplicate validator %s", command.ValidatorFlag, i, address))
+		}
+
+		validatorAddresses[address] = struct{}{}
+	}
+
+	if count := uint64(len(validatorAddresses)); count != 0 &&
+		(count < p.minNumValidators || count > p.maxNumValidators) {
+		errs = append(errs, fmt.Errorf("%s: %d validators provided, the validator count must be between %d and %d",
+			command.ValidatorFlag, count, p.minNumValidators, p.maxNumValidators))
+	}
+
+	premined := make(map[types.Address]struct{}, len(s.Premine))
+
+	for i, premine := range s.Premine {
+		address := types.StringToAddress(premine.Address)
+		if _, ok := premined[address]; ok {
+			errs = append(errs, fmt.Errorf("%s[%d]: duplicate premine of %s", premineFlag, i, address))
+		}
+
+		premined[address] = struct{}{}
+	}
+
+	accessLists := []struct {
+		name string
+		spec *accessListSpec
+	}{
+		{"contract-deployer-allow-list", s.ContractDeployerAllowList},
+		{"contract-deployer-block-list", s.ContractDeployerBlockList},
+		{"transactions-allow-list", s.TransactionsAllowList},
+		{"transactions-block-list", s.TransactionsBlockList},
+		{"bridge-allow-list", s.BridgeAllowList},
+		{"bridge-block-list", s.BridgeBlockList},
+	}
+
+	for _, list := range accessLists {
+		if list.spec != nil && len(list.spec.Enabled) != 0 && len(list.spec.Admin) == 0 {
+			// the list is enabled only with an admin, otherwise it could never be updated
+			errs = append(errs, fmt.Errorf("%s: the enabled addresses require at least one admin", list.name))
+		}
+	}
+
+	predeployed := make(map[types.Address]struct{}, len(s.Predeploys))
+
+	for i, contract := range s.Predeploys {
+		field := fmt.Sprintf("predeploys[%d]", i)
+
+		if err := types.IsValidAddress(contract.Address); err != nil {
+			errs = append(errs, fmt.Errorf("%s: invalid address %s: %w", field, contract.Address, err))
+
+			continue
+		}
+
+		address := types.StringToAddress(contract.Address)
+		if err := predeploy.ValidateAddress(address); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", field, err))
+		}
+
+		if _, ok := predeployed[address]; ok {
+			errs = append(errs, fmt.Errorf("%s: duplicate predeploy address %s", field, address))
+		}
+
+		if _, ok := premined[address]; ok {
+			errs = append(errs, fmt.Errorf("%s: the predeploy address %s is premined", field, address))
+		}
+
+		if _, err := os.Stat(contract.ArtifactsPath); err != nil {
+			errs = append(errs, fmt.Errorf("%s: invalid artifacts path: %w", field, err))
+		}
+
+		predeployed[address] = struct{}{}
+	}
+
+	// the forks are validated in the order of their names, so the errors are reported in a stable order
+	forkNames := make([]string, 0, len(s.Forks))
+	for name := range s.Forks {
+		forkNames = append(forkNames, name)
+	}
+
+	sort.Strings(forkNames)
+
+	for _, name := range forkNames {
+		field := fmt.Sprintf("forks.%s", name)
+
+		if !chain.IsForkAvailable(name) {
+			errs = append(errs, fmt.Errorf("%s: fork is not available", field))
+
+			continue
+		}
+
+		fork := s.Forks[name]
+		if fork == nil || fork.Params == nil {
+			continue
+		}
+
+		if fork.Params.EpochSize != nil && *fork.Params.EpochSize < 2 {
+			errs = append(errs, fmt.Errorf("%s: %w", field, errInvalidEpochSize))
+		}
+
+		if fork.Params.TxOrderingPolicy != nil {
+			if err := txpool.ValidateTxOrderingPolicy(*fork.Params.TxOrderingPolicy); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", field, err))
+			}
+		}
+	}
+
+	return errs
+}
+
+// getForks returns all the supported forks enabled from the genesis block,
+// with the schedule of the spec file applied over them
+func (p *genesisParams) getForks() *chain.Forks {
+	if len(p.forks) == 0 {
+		return chain.AllForksEnabled
+	}
+
+	forks := chain.AllForksEnabled.Copy()
+	for name, fork := range p.forks {
+		if fork == nil {
+			continue
+		}
+
+		forks.SetFork(name, *fork)
+	}
+
+	return forks
+}
+
+// predeployContracts adds the contracts predeployed by the spec file to the genesis allocations
+func (p *genesisParams) predeployContracts(chainConfig *chain.Chain) error {
+	for _, contract := range p.predeploys {
+		address := types.StringToAddress(contract.Address)
+		if chainConfig.Genesis.Alloc[address] != nil {
+			return fmt.Errorf("failed to predeploy %s: %w", contract.ArtifactsPath, errPredeployAddressTaken)
+		}
+
+		account, err := predeployment.GenerateGenesisAccountFromFile(
+			contract.ArtifactsPath,
+			contract.ConstructorArgs,
+			address,
+			chainConfig.Params.ChainID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to predeploy %s: %w", contract.ArtifactsPath, err)
+		}
+
+		chainConfig.Genesis.Alloc[address] = account
+	}
+
+	return nil
+}
+
+func (s *premineSpec) String() string {
+	if s.Amount == "" {
+		return s.Address
+	}
+
+	return fmt.Sprintf("%s:%s", s.Address, s.Amount)
+}
+
+func (s *validatorSpec) String() string {
+	return strings.Join([]string{s.MultiAddr, s.Address, s.BLSKey, s.BLSSignature}, ":")
+}
+
+func (s *burnContractSpec) String() string {
+	if s.Destination == "" {
+		return fmt.Sprintf("%d:%s", s.Block, s.Address)
+	}
+
+	return fmt.Sprintf("%d:%s:%s", s.Block, s.Address, s.Destination)
+}
+
+func (s *baseFeeConfigSpec) String() string {
+	// the values which aren't set are left empty and fall back to their defaults
+	format := func(value *uint64) string {
+		if value == nil {
+			return ""
+		}
+
+		return strconv.FormatUint(*value, 10)
+	}
+
+	return strings.Join([]string{format(s.BaseFee), format(s.BaseFeeEM), format(s.BaseFeeChangeDenom)}, ":")
+}
+
+func (s *nativeTokenConfigSpec) String() string {
+	raw := fmt.Sprintf("%s:%s:%d:%t", s.Name, s.Symbol, s.Decimals, s.Mintable)
+	if s.Owner != "" {
+		raw = fmt.Sprintf("%s:%s", raw, s.Owner)
+	}
+
+	return raw
+}
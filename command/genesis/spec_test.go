@@ -0,0 +1,284 @@
+package genesis
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
+	"github.com/0xPolygon/polygon-edge/forkmanager"
+)
+
+var (
+	testSpecValidator = strings.Join([]string{
+		"/ip4/127.0.0.1/tcp/30301",
+		"0x" + strings.Repeat("1", ecdsaAddressLength),
+		strings.Repeat("2", blsKeyLength),
+		strings.Repeat("3", blsSignatureLength),
+	}, ":")
+)
+
+func Test_genesisSpec_MatchesFlags(t *testing.T) {
+	initialPrices = func(*genesisParams) ([310]*big.Int, error) {
+		prices := [310]*big.Int{}
+		for i := range prices {
+			prices[i] = big.NewInt(int64(i + 1))
+		}
+
+		return prices, nil
+	}
+
+	t.Cleanup(func() { initialPrices = (*genesisParams).getInitialPrices })
+
+	// the genesis generation needs a validator with a valid BLS key and signature
+	v := validator.NewTestValidator(t, "A", 1).ParamsValidator()
+
+	dir := t.TempDir()
+	flagGenesisPath := filepath.Join(dir, "flag-genesis.json")
+	specGenesisPath := filepath.Join(dir, "spec-genesis.json")
+	specPath := writeTestGenesisSpec(t, "genesis.yaml", `
+dir: `+specGenesisPath+`
+name: spec-chain
+chain-id: 42
+consensus: polybft
+premine:
+  - address: "0x00000000000000000000000000000000000000aa"
+    amount: "1000000000000000000000"
+  - address: "0x00000000000000000000000000000000000000bb"
+block-gas-limit: 30000000
+burn-contract:
+  block: 0
+  address: "0x0000000000000000000000000000000000000000"
+base-fee-config:
+  base-fee: 1000
+  base-fee-em: 2
+  base-fee-change-denom: 8
+bootnodes:
+  - /ip4/127.0.0.1/tcp/30301/p2p/16Uiu2HAmJxxH1tScDX2rLGSU9exnuvZKNM9SoK3v315azp68DLPW
+epoch-size: 20
+proxy-contracts-admin: "0x00000000000000000000000000000000000000cc"
+min-validator-count: 1
+max-validator-count: 10
+validators:
+  - multi-addr: /ip4/127.0.0.1/tcp/30301
+    address: "`+v.Address.String()+`"
+    bls-key: "`+v.BlsKey+`"
+    bls-signature: "`+v.BlsSignature+`"
+sprint-size: 4
+block-time: 3s
+epoch-reward: 2
+native-token-config:
+  name: Test
+  symbol: TST
+  decimals: 18
+  mintable: true
+  owner: "0x00000000000000000000000000000000000000dd"
+block-time-drift: 5
+block-tracker-poll-interval: 500ms
+transactions-allow-list:
+  admin: ["0x00000000000000000000000000000000000000ee"]
+  enabled: ["0x00000000000000000000000000000000000000ff"]
+`)
+
+	flagParams, err := parseTestGenesisParams(t,
+		"--dir", flagGenesisPath,
+		"--name", "spec-chain",
+		"--chain-id", "42",
+		"--consensus", "polybft",
+		"--premine", "0x00000000000000000000000000000000000000aa:1000000000000000000000",
+		"--premine", "0x00000000000000000000000000000000000000bb",
+		"--block-gas-limit", "30000000",
+		"--burn-contract", "0:0x0000000000000000000000000000000000000000",
+		"--base-fee-config", "1000:2:8",
+		"--bootnode", "/ip4/127.0.0.1/tcp/30301/p2p/16Uiu2HAmJxxH1tScDX2rLGSU9exnuvZKNM9SoK3v315azp68DLPW",
+		"--epoch-size", "20",
+		"--proxy-contracts-admin", "0x00000000000000000000000000000000000000cc",
+		"--min-validator-count", "1",
+		"--max-validator-count", "10",
+		"--validators", strings.Join([]string{
+			"/ip4/127.0.0.1/tcp/30301", v.Address.String(), v.BlsKey, v.BlsSignature,
+		}, ":"),
+		"--sprint-size", "4",
+		"--block-time", "3s",
+		"--epoch-reward", "2",
+		"--native-token-config", "Test:TST:18:true:0x00000000000000000000000000000000000000dd",
+		"--block-time-drift", "5",
+		"--block-tracker-poll-interval", "500ms",
+		"--transactions-allow-list-admin", "0x00000000000000000000000000000000000000ee",
+		"--transactions-allow-list-enabled", "0x00000000000000000000000000000000000000ff",
+	)
+	require.NoError(t, err)
+
+	flagGenesis := generateTestGenesis(t, flagParams)
+
+	specParams, err := parseTestGenesisParams(t, "--spec", specPath)
+	require.NoError(t, err)
+
+	specGenesis := generateTestGenesis(t, specParams)
+
+	// the same parameters generate the same genesis
+	require.Equal(t, string(flagGenesis), string(specGenesis))
+}
+
+func Test_genesisSpec_FlagsOverrideSpec(t *testing.T) {
+	dir := t.TempDir()
+	specPath := writeTestGenesisSpec(t, "genesis.json", `{
+	"dir": "`+filepath.Join(dir, "genesis.json")+`",
+	"chain-id": 42,
+	"name": "spec-chain",
+	"proxy-contracts-admin": "0x00000000000000000000000000000000000000cc",
+	"validators": [{
+		"multi-addr": "/ip4/127.0.0.1/tcp/30301",
+		"address": "0x`+strings.Repeat("1", ecdsaAddressLength)+`",
+		"bls-key": "`+strings.Repeat("2", blsKeyLength)+`",
+		"bls-signature": "`+strings.Repeat("3", blsSignatureLength)+`"
+	}]
+}`)
+
+	p, err := parseTestGenesisParams(t, "--spec", specPath, "--chain-id", "7")
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), p.chainID)
+	require.Equal(t, "spec-chain", p.name)
+	require.Equal(t, []string{testSpecValidator}, p.validators)
+}
+
+func Test_genesisSpec_ReportsAllErrors(t *testing.T) {
+	dir := t.TempDir()
+	specPath := writeTestGenesisSpec(t, "genesis.yml", `
+dir: `+filepath.Join(dir, "genesis.json")+`
+epoch-size: 1
+proxy-contracts-admin: ""
+premine:
+  - address: "0x00000000000000000000000000000000000000aa"
+    amount: lots
+validators-path: `+dir+`
+validators:
+  - multi-addr: /ip4/127.0.0.1/tcp/30301
+    address: "0x`+strings.Repeat("1", ecdsaAddressLength)+`"
+    bls-key: "`+strings.Repeat("2", blsKeyLength)+`"
+    bls-signature: "`+strings.Repeat("3", blsSignatureLength)+`"
+transactions-block-list:
+  enabled: ["0x00000000000000000000000000000000000000ff"]
+predeploys:
+  - address: "0x0000000000000000000000000000000000001000"
+    artifacts-path: `+filepath.Join(dir, "missing.json")+`
+forks:
+  unknown:
+    block: 10
+  londonfix:
+    block: 20
+    params:
+      txOrderingPolicy: random
+`)
+
+	_, err := parseTestGenesisParams(t, "--spec", specPath)
+	require.Error(t, err)
+
+	for _, expected := range []string{
+		"validators: can't be used together with validators-path and validators-prefix",
+		"transactions-block-list: the enabled addresses require at least one admin",
+		"predeploys[0]: the provided predeploy address must be >=",
+		"predeploys[0]: invalid artifacts path",
+		"forks.londonfix: unknown tx ordering policy: random",
+		"forks.unknown: fork is not available",
+		"invalid premine balance amount provided",
+		"proxy contracts admin address must be set",
+		errInvalidEpochSize.Error(),
+	} {
+		require.ErrorContains(t, err, expected)
+	}
+}
+
+func Test_genesisSpec_IBFTBootnodes(t *testing.T) {
+	dir := t.TempDir()
+	spec := `
+dir: ` + filepath.Join(dir, "genesis.json") + `
+consensus: ibft
+validators-path: ` + dir + `
+validators-prefix: test-chain-
+`
+
+	_, err := parseTestGenesisParams(t, "--spec", writeTestGenesisSpec(t, "genesis.yaml", spec))
+	require.ErrorIs(t, err, errBootnodesNotSpecified)
+
+	// the boot nodes of the spec file satisfy the requirement
+	spec += "bootnodes:\n  - /ip4/127.0.0.1/tcp/30301/p2p/16Uiu2HAmJxxH1tScDX2rLGSU9exnuvZKNM9SoK3v315azp68DLPW\n"
+
+	_, err = parseTestGenesisParams(t, "--spec", writeTestGenesisSpec(t, "genesis.yaml", spec))
+	require.NotErrorIs(t, err, errBootnodesNotSpecified)
+}
+
+func Test_genesisSpec_UnknownKey(t *testing.T) {
+	specPath := writeTestGenesisSpec(t, "genesis.yaml", "chain-idd: 42\n")
+
+	_, err := parseTestGenesisParams(t, "--spec", specPath)
+	require.ErrorContains(t, err, `unknown field "chain-idd"`)
+}
+
+func Test_getForks(t *testing.T) {
+	t.Parallel()
+
+	// without a schedule all the supported forks are enabled from the genesis block
+	require.Equal(t, chain.AllForksEnabled, (&genesisParams{}).getForks())
+
+	policy := "fifo"
+	p := &genesisParams{
+		forks: map[string]*chain.Fork{
+			chain.LondonFix: {
+				Block:  100,
+				Params: &forkmanager.ForkParams{TxOrderingPolicy: &policy},
+			},
+			// the opt-in forks are enabled by the schedule only
+			chain.Berlin: {Block: 10},
+		},
+	}
+
+	forks := p.getForks()
+	require.False(t, chain.AllForksEnabled.IsActive(chain.Berlin, 10))
+	require.True(t, forks.IsActive(chain.Berlin, 10))
+	require.True(t, forks.IsActive(chain.London, 0))
+	require.False(t, forks.IsActive(chain.LondonFix, 99))
+	require.True(t, forks.IsActive(chain.LondonFix, 100))
+	require.Equal(t, &policy, (*forks)[chain.LondonFix].Params.TxOrderingPolicy)
+
+	// the supported forks aren't changed
+	require.True(t, chain.AllForksEnabled.IsActive(chain.LondonFix, 0))
+}
+
+// parseTestGenesisParams parses the arguments of the genesis command into new genesis parameters
+func parseTestGenesisParams(t *testing.T, args ...string) (*genesisParams, error) {
+	t.Helper()
+
+	params = &genesisParams{}
+	cmd := GetCommand()
+	require.NoError(t, cmd.ParseFlags(args))
+
+	return params, preRunCommand(cmd, nil)
+}
+
+func generateTestGenesis(t *testing.T, p *genesisParams) []byte {
+	t.Helper()
+
+	require.NoError(t, p.generatePolyBftChainConfig(command.InitializeOutputter(&cobra.Command{})))
+
+	genesis, err := os.ReadFile(p.genesisPath)
+	require.NoError(t, err)
+
+	return genesis
+}
+
+func writeTestGenesisSpec(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	return path
+}
@@ -1,12 +1,14 @@
 package helper
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
 	"net"
 	"net/url"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command"
 	ibftOp "github.com/0xPolygon/polygon-edge/consensus/ibft/proto"
 	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/ipc"
 	"github.com/0xPolygon/polygon-edge/server"
 	"github.com/0xPolygon/polygon-edge/server/proto"
 	txpoolOp "github.com/0xPolygon/polygon-edge/txpool/proto"
@@ -21,10 +24,17 @@ import (
 	"github.com/ryanuber/columnize"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
-var ErrBlockTrackerPollInterval = errors.New("block tracker poll interval must be greater than 0")
+var (
+	ErrBlockTrackerPollInterval = errors.New("block tracker poll interval must be greater than 0")
+	ErrGRPCEndpointMissing      = errors.New("the node isn't set, use --data-dir or --ipc-path " +
+		"(or --grpc-address with the client certificate)")
+	ErrGRPCTLSMissing = errors.New("the tcp GRPC interface requires mutual TLS, " +
+		"use --grpc-tls-cert, --grpc-tls-key and --grpc-tls-ca")
+)
 
 type ClientCloseResult struct {
 	Message string `json:"message"`
@@ -103,11 +113,11 @@ func FormatKV(in []string) string {
 }
 
 // GetTxPoolClientConnection returns the TxPool operator client connection
-func GetTxPoolClientConnection(address string) (
+func GetTxPoolClientConnection(endpoint GRPCEndpoint) (
 	txpoolOp.TxnPoolOperatorClient,
 	error,
 ) {
-	conn, err := GetGRPCConnection(address)
+	conn, err := GetGRPCConnection(endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -116,11 +126,11 @@ func GetTxPoolClientConnection(address string) (
 }
 
 // GetSystemClientConnection returns the System operator client connection
-func GetSystemClientConnection(address string) (
+func GetSystemClientConnection(endpoint GRPCEndpoint) (
 	proto.SystemClient,
 	error,
 ) {
-	conn, err := GetGRPCConnection(address)
+	conn, err := GetGRPCConnection(endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -129,11 +139,11 @@ func GetSystemClientConnection(address string) (
 }
 
 // GetIBFTOperatorClientConnection returns the IBFT operator client connection
-func GetIBFTOperatorClientConnection(address string) (
+func GetIBFTOperatorClientConnection(endpoint GRPCEndpoint) (
 	ibftOp.IbftOperatorClient,
 	error,
 ) {
-	conn, err := GetGRPCConnection(address)
+	conn, err := GetGRPCConnection(endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -141,9 +151,50 @@ func GetIBFTOperatorClientConnection(address string) (
 	return ibftOp.NewIbftOperatorClient(conn), nil
 }
 
+// GRPCEndpoint is the admin gRPC endpoint of a node, its IPC socket
+// or a tcp address which requires mutual TLS
+type GRPCEndpoint struct {
+	// IPCPath is the path of the IPC socket, it is used if the address isn't set
+	IPCPath string
+
+	Address string
+	TLSCert string
+	TLSKey  string
+	TLSCA   string
+}
+
 // GetGRPCConnection returns a grpc client connection
-func GetGRPCConnection(address string) (*grpc.ClientConn, error) {
-	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+func GetGRPCConnection(endpoint GRPCEndpoint) (*grpc.ClientConn, error) {
+	var (
+		conn *grpc.ClientConn
+		err  error
+	)
+
+	switch {
+	case endpoint.Address != "":
+		if endpoint.TLSCert == "" || endpoint.TLSKey == "" || endpoint.TLSCA == "" {
+			return nil, ErrGRPCTLSMissing
+		}
+
+		tlsConfig, tlsErr := common.ClientMTLSConfig(endpoint.TLSCA, endpoint.TLSCert, endpoint.TLSKey)
+		if tlsErr != nil {
+			return nil, fmt.Errorf("grpc tls: %w", tlsErr)
+		}
+
+		conn, err = grpc.Dial(endpoint.Address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	case endpoint.IPCPath != "":
+		// the socket is accessible only by the user running the node
+		conn, err = grpc.Dial(
+			"passthrough:///localhost",
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithContextDialer(func(_ context.Context, _ string) (net.Conn, error) {
+				return ipc.Dial(endpoint.IPCPath)
+			}),
+		)
+	default:
+		return nil, ErrGRPCEndpointMissing
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to server: %w", err)
 	}
@@ -151,6 +202,23 @@ func GetGRPCConnection(address string) (*grpc.ClientConn, error) {
 	return conn, nil
 }
 
+// GetGRPCEndpoint extracts the admin gRPC endpoint of the node, the IPC socket in its data dir by default
+func GetGRPCEndpoint(cmd *cobra.Command) GRPCEndpoint {
+	endpoint := GRPCEndpoint{
+		Address: GetGRPCAddress(cmd),
+		IPCPath: cmd.Flag(command.IPCPathFlag).Value.String(),
+		TLSCert: cmd.Flag(command.GRPCTLSCertFlag).Value.String(),
+		TLSKey:  cmd.Flag(command.GRPCTLSKeyFlag).Value.String(),
+		TLSCA:   cmd.Flag(command.GRPCTLSCAFlag).Value.String(),
+	}
+
+	if dataDir := cmd.Flag(command.DataDirFlag).Value.String(); endpoint.IPCPath == "" && dataDir != "" {
+		endpoint.IPCPath = filepath.Join(dataDir, server.IPCFileName)
+	}
+
+	return endpoint
+}
+
 // GetGRPCAddress extracts the set GRPC address
 func GetGRPCAddress(cmd *cobra.Command) string {
 	if cmd.Flags().Changed(command.GRPCAddressFlagLEGACY) {
@@ -184,8 +252,8 @@ func RegisterJSONOutputFlag(cmd *cobra.Command) {
 func RegisterGRPCAddressFlag(cmd *cobra.Command) {
 	cmd.PersistentFlags().String(
 		command.GRPCAddressFlag,
-		fmt.Sprintf("%s:%d", LocalHostBinding, server.DefaultGRPCPort),
-		"the GRPC interface",
+		"",
+		"the tcp address of the GRPC interface, which requires mutual TLS",
 	)
 }
 
@@ -193,14 +261,50 @@ func RegisterGRPCAddressFlag(cmd *cobra.Command) {
 func RegisterLegacyGRPCAddressFlag(cmd *cobra.Command) {
 	cmd.PersistentFlags().String(
 		command.GRPCAddressFlagLEGACY,
-		fmt.Sprintf("%s:%d", LocalHostBinding, server.DefaultGRPCPort),
-		"the GRPC interface",
+		"",
+		"the tcp address of the GRPC interface, which requires mutual TLS",
 	)
 
 	// Mark the legacy grpc flag as hidden
 	_ = cmd.PersistentFlags().MarkHidden(command.GRPCAddressFlagLEGACY)
 }
 
+// RegisterGRPCEndpointFlags registers the flags of the admin gRPC endpoint for all child commands,
+// the IPC socket of the node or its tcp address with the client certificate
+func RegisterGRPCEndpointFlags(cmd *cobra.Command) {
+	RegisterGRPCAddressFlag(cmd)
+
+	cmd.PersistentFlags().String(
+		command.DataDirFlag,
+		"",
+		fmt.Sprintf("the data directory of the node, whose %s socket is used", server.IPCFileName),
+	)
+
+	cmd.PersistentFlags().String(
+		command.IPCPathFlag,
+		"",
+		"the path of the IPC socket of the node, if it isn't in the data directory",
+	)
+
+	cmd.PersistentFlags().String(
+		command.GRPCTLSCertFlag,
+		"",
+		"the client certificate of the tcp GRPC interface",
+	)
+
+	cmd.PersistentFlags().String(
+		command.GRPCTLSKeyFlag,
+		"",
+		"the key of the client certificate of the tcp GRPC interface",
+	)
+
+	cmd.PersistentFlags().String(
+		command.GRPCTLSCAFlag,
+		"",
+		"the CA certificate the tcp GRPC interface is verified against",
+	)
+}
+
 // ParseGRPCAddress parses the passed in GRPC address
 func ParseGRPCAddress(grpcAddress string) (*net.TCPAddr, error) {
 	return net.ResolveTCPAddr("tcp", grpcAddress)
@@ -0,0 +1,55 @@
+package helper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"syscall"
+
+	"golang.org/x/term"
+)
+
+var errPasswordMismatch = errors.New("passwords do not match")
+
+// ReadPassword reads the password from the given file, trimming the trailing new line.
+// If no file is given, the password is read from the terminal, and typed twice if confirm is set
+func ReadPassword(passwordFile, prompt string, confirm bool) ([]byte, error) {
+	if passwordFile != "" {
+		raw, err := os.ReadFile(passwordFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the password file: %w", err)
+		}
+
+		return []byte(strings.TrimRight(string(raw), "\r\n")), nil
+	}
+
+	fmt.Printf("%s: ", prompt)
+
+	password, err := term.ReadPassword(syscall.Stdin)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Println()
+
+	if !confirm {
+		return password, nil
+	}
+
+	fmt.Print("Confirm password: ")
+
+	confirmation, err := term.ReadPassword(syscall.Stdin)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Println()
+
+	if !bytes.Equal(password, confirmation) {
+		return nil, errPasswordMismatch
+	}
+
+	return password, nil
+}
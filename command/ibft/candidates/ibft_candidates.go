@@ -22,7 +22,7 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
-	candidatesResponse, err := getIBFTCandidates(helper.GetGRPCAddress(cmd))
+	candidatesResponse, err := getIBFTCandidates(helper.GetGRPCEndpoint(cmd))
 	if err != nil {
 		outputter.SetError(err)
 
@@ -34,9 +34,9 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	)
 }
 
-func getIBFTCandidates(grpcAddress string) (*ibftOp.CandidatesResp, error) {
+func getIBFTCandidates(grpcEndpoint helper.GRPCEndpoint) (*ibftOp.CandidatesResp, error) {
 	client, err := helper.GetIBFTOperatorClientConnection(
-		grpcAddress,
+		grpcEndpoint,
 	)
 	if err != nil {
 		return nil, err
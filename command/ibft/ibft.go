@@ -17,7 +17,7 @@ func GetCommand() *cobra.Command {
 		Short: "Top level IBFT command for interacting with the IBFT consensus. Only accepts subcommands.",
 	}
 
-	helper.RegisterGRPCAddressFlag(ibftCmd)
+	helper.RegisterGRPCEndpointFlags(ibftCmd)
 
 	registerSubcommands(ibftCmd)
 
@@ -102,8 +102,8 @@ func isValidVoteType(vote string) bool {
 	return vote == authVote || vote == dropVote
 }
 
-func (p *proposeParams) proposeCandidate(grpcAddress string) error {
-	ibftClient, err := helper.GetIBFTOperatorClientConnection(grpcAddress)
+func (p *proposeParams) proposeCandidate(grpcEndpoint helper.GRPCEndpoint) error {
+	ibftClient, err := helper.GetIBFTOperatorClientConnection(grpcEndpoint)
 	if err != nil {
 		return err
 	}
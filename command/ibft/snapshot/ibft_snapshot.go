@@ -33,7 +33,7 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
-	if err := params.initSnapshot(helper.GetGRPCAddress(cmd)); err != nil {
+	if err := params.initSnapshot(helper.GetGRPCEndpoint(cmd)); err != nil {
 		outputter.SetError(err)
 
 		return
@@ -22,8 +22,8 @@ type snapshotParams struct {
 	snapshot *ibftOp.Snapshot
 }
 
-func (p *snapshotParams) initSnapshot(grpcAddress string) error {
-	ibftClient, err := helper.GetIBFTOperatorClientConnection(grpcAddress)
+func (p *snapshotParams) initSnapshot(grpcEndpoint helper.GRPCEndpoint) error {
+	ibftClient, err := helper.GetIBFTOperatorClientConnection(grpcEndpoint)
 	if err != nil {
 		return err
 	}
@@ -22,7 +22,7 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
-	statusResponse, err := getIBFTStatus(helper.GetGRPCAddress(cmd))
+	statusResponse, err := getIBFTStatus(helper.GetGRPCEndpoint(cmd))
 	if err != nil {
 		outputter.SetError(err)
 
@@ -34,9 +34,9 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	})
 }
 
-func getIBFTStatus(grpcAddress string) (*ibftOp.IbftStatusResp, error) {
+func getIBFTStatus(grpcEndpoint helper.GRPCEndpoint) (*ibftOp.IbftStatusResp, error) {
 	client, err := helper.GetIBFTOperatorClientConnection(
-		grpcAddress,
+		grpcEndpoint,
 	)
 	if err != nil {
 		return nil, err
@@ -22,7 +22,7 @@ func GetCommand() *cobra.Command {
 		Run:   runCommand,
 	}
 
-	helper.RegisterGRPCAddressFlag(monitorCmd)
+	helper.RegisterGRPCEndpointFlags(monitorCmd)
 
 	return monitorCmd
 }
@@ -33,18 +33,18 @@ func runCommand(cmd *cobra.Command, _ []string) {
 
 	subscribeToEvents(
 		outputter,
-		helper.GetGRPCAddress(cmd),
+		helper.GetGRPCEndpoint(cmd),
 	)
 }
 
 func subscribeToEvents(
 	outputter command.OutputFormatter,
-	grpcAddress string,
+	grpcEndpoint helper.GRPCEndpoint,
 ) {
 	ctx, cancelFn := context.WithCancel(context.Background())
 	defer cancelFn()
 
-	stream, err := getMonitorStream(ctx, grpcAddress)
+	stream, err := getMonitorStream(ctx, grpcEndpoint)
 	if err != nil {
 		outputter.SetError(err)
 		outputter.WriteOutput()
@@ -60,9 +60,9 @@ func subscribeToEvents(
 
 func getMonitorStream(
 	ctx context.Context,
-	grpcAddress string,
+	grpcEndpoint helper.GRPCEndpoint,
 ) (proto.System_SubscribeClient, error) {
-	client, err := helper.GetSystemClientConnection(grpcAddress)
+	client, err := helper.GetSystemClientConnection(grpcEndpoint)
 	if err != nil {
 		return nil, err
 	}
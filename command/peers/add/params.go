@@ -21,11 +21,13 @@ var (
 )
 
 const (
-	addrFlag = "addr"
+	addrFlag   = "addr"
+	staticFlag = "static"
 )
 
 type addParams struct {
 	peerAddresses []string
+	static        bool
 
 	systemClient proto.SystemClient
 
@@ -47,8 +49,8 @@ func (p *addParams) validateFlags() error {
 	return nil
 }
 
-func (p *addParams) initSystemClient(grpcAddress string) error {
-	systemClient, err := helper.GetSystemClientConnection(grpcAddress)
+func (p *addParams) initSystemClient(grpcEndpoint helper.GRPCEndpoint) error {
+	systemClient, err := helper.GetSystemClientConnection(grpcEndpoint)
 	if err != nil {
 		return err
 	}
@@ -74,7 +76,8 @@ func (p *addParams) addPeer(peerAddress string) error {
 	if _, err := p.systemClient.PeersAdd(
 		context.Background(),
 		&proto.PeersAddRequest{
-			Id: peerAddress,
+			Id:     peerAddress,
+			Static: p.static,
 		},
 	); err != nil {
 		return err
@@ -87,6 +90,7 @@ func (p *addParams) getResult() command.CommandResult {
 	return &PeersAddResult{
 		NumRequested: len(p.peerAddresses),
 		NumAdded:     len(p.addedPeers),
+		Static:       p.static,
 		Peers:        p.addedPeers,
 		Errors:       p.addErrors,
 	}
@@ -27,6 +27,13 @@ func setFlags(cmd *cobra.Command) {
 		[]string{},
 		"the libp2p addresses of the peers",
 	)
+
+	cmd.Flags().BoolVar(
+		&params.static,
+		staticFlag,
+		false,
+		"mark the peers as static, so the node keeps them connected and redials them on disconnect",
+	)
 }
 
 func runPreRun(_ *cobra.Command, _ []string) error {
@@ -37,7 +44,7 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
-	if err := params.initSystemClient(helper.GetGRPCAddress(cmd)); err != nil {
+	if err := params.initSystemClient(helper.GetGRPCEndpoint(cmd)); err != nil {
 		outputter.SetError(err)
 
 		return
@@ -10,6 +10,7 @@ import (
 type PeersAddResult struct {
 	NumRequested int      `json:"num_requested"`
 	NumAdded     int      `json:"num_added"`
+	Static       bool     `json:"static"`
 	Peers        []string `json:"peers"`
 	Errors       []string `json:"errors"`
 }
@@ -21,6 +22,7 @@ func (r *PeersAddResult) GetOutput() string {
 	buffer.WriteString(helper.FormatKV([]string{
 		fmt.Sprintf("Peers listed|%d", r.NumRequested), // The number of peers the user wanted to add
 		fmt.Sprintf("Peers added|%d", r.NumAdded),      // The number of peers that have been added
+		fmt.Sprintf("Static|%t", r.Static),             // Flag indicating if the peers were added as static
 	}))
 
 	if len(r.Peers) > 0 {
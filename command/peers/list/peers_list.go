@@ -24,7 +24,7 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
-	peersList, err := getPeersList(helper.GetGRPCAddress(cmd))
+	peersList, err := getPeersList(helper.GetGRPCEndpoint(cmd))
 	if err != nil {
 		outputter.SetError(err)
 
@@ -36,8 +36,8 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	)
 }
 
-func getPeersList(grpcAddress string) (*proto.PeersListResponse, error) {
-	client, err := helper.GetSystemClientConnection(grpcAddress)
+func getPeersList(grpcEndpoint helper.GRPCEndpoint) (*proto.PeersListResponse, error) {
+	client, err := helper.GetSystemClientConnection(grpcEndpoint)
 	if err != nil {
 		return nil, err
 	}
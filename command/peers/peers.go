@@ -14,7 +14,7 @@ func GetCommand() *cobra.Command {
 		Short: "Top level command for interacting with the network peers. Only accepts subcommands.",
 	}
 
-	helper.RegisterGRPCAddressFlag(peersCmd)
+	helper.RegisterGRPCEndpointFlags(peersCmd)
 
 	registerSubcommands(peersCmd)
 
@@ -28,8 +28,8 @@ func (p *statusParams) getRequiredFlags() []string {
 	}
 }
 
-func (p *statusParams) initPeerInfo(grpcAddress string) error {
-	systemClient, err := helper.GetSystemClientConnection(grpcAddress)
+func (p *statusParams) initPeerInfo(grpcEndpoint helper.GRPCEndpoint) error {
+	systemClient, err := helper.GetSystemClientConnection(grpcEndpoint)
 	if err != nil {
 		return err
 	}
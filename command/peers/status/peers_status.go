@@ -32,7 +32,7 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
-	if err := params.initPeerInfo(helper.GetGRPCAddress(cmd)); err != nil {
+	if err := params.initPeerInfo(helper.GetGRPCEndpoint(cmd)); err != nil {
 		outputter.SetError(err)
 
 		return
@@ -0,0 +1,51 @@
+package remotesigner
+
+import (
+	"errors"
+	"net"
+
+	sidechainHelper "github.com/0xPolygon/polygon-edge/command/sidechain"
+)
+
+const (
+	listenFlag        = "listen"
+	tlsCACertFlag     = "tls-ca-cert"
+	tlsCertFlag       = "tls-cert"
+	tlsKeyFlag        = "tls-key"
+	slashingDBFlag    = "slashing-db"
+	defaultListenAddr = "127.0.0.1:9400"
+)
+
+var errMissingTLSFlags = errors.New("the signing service requires a CA certificate, a certificate and a key")
+
+type remoteSignerParams struct {
+	accountDir         string
+	accountConfig      string
+	insecureLocalStore bool
+
+	listenAddr string
+	caCertPath string
+	certPath   string
+	keyPath    string
+	slashingDB string
+}
+
+func (p *remoteSignerParams) getRequiredFlags() []string {
+	return []string{
+		slashingDBFlag,
+	}
+}
+
+func (p *remoteSignerParams) validateFlags() error {
+	if err := sidechainHelper.ValidateSecretFlags(p.accountDir, p.accountConfig); err != nil {
+		return err
+	}
+
+	if p.caCertPath == "" || p.certPath == "" || p.keyPath == "" {
+		return errMissingTLSFlags
+	}
+
+	_, err := net.ResolveTCPAddr("tcp", p.listenAddr)
+
+	return err
+}
@@ -0,0 +1,141 @@
+package remotesigner
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+	sidechainHelper "github.com/0xPolygon/polygon-edge/command/sidechain"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet/remote"
+)
+
+var params remoteSignerParams
+
+// GetCommand returns the remote signer command
+func GetCommand() *cobra.Command {
+	remoteSignerCmd := &cobra.Command{
+		Use: "remote-signer",
+		Short: "Starts a signing service holding the validator keys. Nodes and hydragon commands connect to it " +
+			"over mTLS, and it refuses to sign conflicting consensus messages for the same height and round",
+		PreRunE: runPreRun,
+		RunE:    runCommand,
+	}
+
+	setFlags(remoteSignerCmd)
+	helper.SetRequiredFlags(remoteSignerCmd, params.getRequiredFlags())
+
+	return remoteSignerCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.accountDir,
+		polybftsecrets.AccountDirFlag,
+		"",
+		polybftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.accountConfig,
+		polybftsecrets.AccountConfigFlag,
+		"",
+		polybftsecrets.AccountConfigFlagDesc,
+	)
+
+	cmd.Flags().BoolVar(
+		&params.insecureLocalStore,
+		sidechainHelper.InsecureLocalStoreFlag,
+		false,
+		"a flag to indicate if the secrets used are encrypted. If set to true, the secrets are stored in plain text.",
+	)
+
+	cmd.Flags().StringVar(
+		&params.listenAddr,
+		listenFlag,
+		defaultListenAddr,
+		"the address the signing service listens on",
+	)
+
+	cmd.Flags().StringVar(
+		&params.caCertPath,
+		tlsCACertFlag,
+		"",
+		"the path to the CA certificate the client certificates must be issued by",
+	)
+
+	cmd.Flags().StringVar(
+		&params.certPath,
+		tlsCertFlag,
+		"",
+		"the path to the certificate of the signing service",
+	)
+
+	cmd.Flags().StringVar(
+		&params.keyPath,
+		tlsKeyFlag,
+		"",
+		"the path to the private key of the signing service certificate",
+	)
+
+	cmd.Flags().StringVar(
+		&params.slashingDB,
+		slashingDBFlag,
+		"",
+		"the path to the slashing protection database",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) error {
+	account, err := sidechainHelper.GetAccount(params.accountDir, params.accountConfig, params.insecureLocalStore)
+	if err != nil {
+		return err
+	}
+
+	tlsConfig, err := remote.ServerTLSConfig(params.caCertPath, params.certPath, params.keyPath)
+	if err != nil {
+		return err
+	}
+
+	protection, err := remote.NewSlashingProtection(params.slashingDB)
+	if err != nil {
+		return fmt.Errorf("failed to open the slashing protection database: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", params.listenAddr)
+	if err != nil {
+		_ = protection.Close()
+
+		return err
+	}
+
+	logger := hclog.New(&hclog.LoggerOptions{Output: cmd.OutOrStderr()})
+	server := remote.NewServer(logger, wallet.NewLocalSigner(account), protection)
+
+	signalCh := make(chan os.Signal, 1)
+	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		<-signalCh
+		logger.Info("stopping the signing service")
+
+		if err := server.Close(); err != nil {
+			logger.Error("failed to stop the signing service", "err", err)
+		}
+	}()
+
+	return server.Serve(listener, tlsConfig)
+}
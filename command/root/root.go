@@ -8,6 +8,9 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/command/backup"
 	"github.com/0xPolygon/polygon-edge/command/bridge"
+	"github.com/0xPolygon/polygon-edge/command/db"
+	"github.com/0xPolygon/polygon-edge/command/debug"
+	"github.com/0xPolygon/polygon-edge/command/devnet"
 	"github.com/0xPolygon/polygon-edge/command/genesis"
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/command/license"
@@ -15,6 +18,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/peers"
 	"github.com/0xPolygon/polygon-edge/command/polybft"
 	"github.com/0xPolygon/polygon-edge/command/regenesis"
+	"github.com/0xPolygon/polygon-edge/command/remotesigner"
 	"github.com/0xPolygon/polygon-edge/command/secrets"
 	"github.com/0xPolygon/polygon-edge/command/server"
 	"github.com/0xPolygon/polygon-edge/command/status"
@@ -56,6 +60,10 @@ func (rc *RootCommand) registerSubCommands() {
 		polybft.GetCommand(),
 		bridge.GetCommand(),
 		regenesis.GetCommand(),
+		db.GetCommand(),
+		devnet.GetCommand(),
+		remotesigner.GetCommand(),
+		debug.GetCommand(),
 	)
 }
 
@@ -0,0 +1,75 @@
+package changepassword
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+)
+
+var params = &changePasswordParams{}
+
+func GetCommand() *cobra.Command {
+	changePasswordCmd := &cobra.Command{
+		Use: "change-password",
+		Short: "Re-encrypts the encrypted local secrets with a new password. " +
+			"The current secrets are backed up before they are replaced.",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(changePasswordCmd)
+	helper.SetRequiredFlags(changePasswordCmd, params.getRequiredFlags())
+
+	return changePasswordCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		polybftsecrets.AccountDirFlag,
+		"",
+		polybftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.passwordFile,
+		passwordFileFlag,
+		"",
+		"the path to the file containing the current password, if omitted, "+
+			"the password is read from the unlock sources or prompted",
+	)
+
+	cmd.Flags().StringVar(
+		&params.newPasswordFile,
+		newPasswordFileFlag,
+		"",
+		"the path to the file containing the new password, if omitted, the password is prompted",
+	)
+
+	cmd.Flags().StringVar(
+		&params.backupDir,
+		backupDirFlag,
+		"",
+		"the directory the current secrets are copied to, <data-dir>/secrets-backup-<timestamp> by default",
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	result, err := params.changePassword()
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(result)
+}
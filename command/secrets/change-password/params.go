@@ -0,0 +1,78 @@
+package changepassword
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+	"github.com/0xPolygon/polygon-edge/secrets/encryptedlocal"
+	secretsHelper "github.com/0xPolygon/polygon-edge/secrets/helper"
+)
+
+const (
+	passwordFileFlag    = "password-file"
+	newPasswordFileFlag = "new-password-file"
+	backupDirFlag       = "backup-dir"
+)
+
+type changePasswordParams struct {
+	dataDir         string
+	passwordFile    string
+	newPasswordFile string
+	backupDir       string
+}
+
+func (cp *changePasswordParams) getRequiredFlags() []string {
+	return []string{
+		polybftsecrets.AccountDirFlag,
+	}
+}
+
+func (cp *changePasswordParams) validateFlags() error {
+	if cp.backupDir == "" {
+		cp.backupDir = filepath.Join(cp.dataDir, fmt.Sprintf("secrets-backup-%d", time.Now().Unix()))
+	}
+
+	return nil
+}
+
+func (cp *changePasswordParams) changePassword() (*ChangePasswordResult, error) {
+	sm, err := secretsHelper.SetupEncryptedLocalSecretsManager(cp.dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	esm, ok := sm.(*encryptedlocal.EncryptedLocalSecretsManager)
+	if !ok {
+		return nil, errors.New("invalid type assertion")
+	}
+
+	if cp.passwordFile != "" {
+		password, err := helper.ReadPassword(cp.passwordFile, "", false)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := esm.Unlock(password); err != nil {
+			return nil, err
+		}
+	}
+
+	newPassword, err := helper.ReadPassword(cp.newPasswordFile, "Enter new password", true)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := esm.ChangePassword(newPassword, cp.backupDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChangePasswordResult{
+		Secrets:   changed,
+		BackupDir: cp.backupDir,
+	}, nil
+}
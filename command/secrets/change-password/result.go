@@ -0,0 +1,27 @@
+package changepassword
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type ChangePasswordResult struct {
+	Secrets   []string `json:"secrets"`
+	BackupDir string   `json:"backup_dir"`
+}
+
+func (r *ChangePasswordResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[SECRETS PASSWORD CHANGED]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Re-encrypted|%s", strings.Join(r.Secrets, ", ")),
+		fmt.Sprintf("Backup|%s", r.BackupDir),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,97 @@
+package export
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	formatFlag       = "format"
+	kdfFlag          = "kdf"
+	outputFlag       = "output"
+	passwordFileFlag = "password-file"
+
+	formatKeystoreV3 = "keystore-v3"
+)
+
+type exportParams struct {
+	accountDir         string
+	accountConfig      string
+	insecureLocalStore bool
+
+	format       string
+	kdf          string
+	outputPath   string
+	passwordFile string
+}
+
+func (ep *exportParams) getRequiredFlags() []string {
+	return []string{
+		outputFlag,
+	}
+}
+
+func (ep *exportParams) validateFlags() error {
+	if ep.accountDir == "" && ep.accountConfig == "" {
+		return polybftsecrets.ErrInvalidParams
+	}
+
+	if ep.format != formatKeystoreV3 {
+		return fmt.Errorf("unsupported export format '%s', only '%s' is supported", ep.format, formatKeystoreV3)
+	}
+
+	if kdf := keystore.KDF(ep.kdf); kdf != keystore.KDFScrypt && kdf != keystore.KDFPBKDF2 {
+		return fmt.Errorf("unsupported key derivation function '%s'", ep.kdf)
+	}
+
+	if common.FileExists(ep.outputPath) {
+		return fmt.Errorf("output file '%s' already exists", ep.outputPath)
+	}
+
+	return nil
+}
+
+// exportKeystore encrypts the validator ECDSA key into a keystore v3 file
+func (ep *exportParams) exportKeystore() (*SecretsExportResult, error) {
+	secretsManager, err := polybftsecrets.GetSecretsManager(ep.accountDir, ep.accountConfig, ep.insecureLocalStore)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := wallet.GetEcdsaFromSecret(secretsManager)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := key.MarshallPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	password, err := helper.ReadPassword(ep.passwordFile, "Enter keystore password", true)
+	if err != nil {
+		return nil, err
+	}
+
+	keyJSON, err := keystore.EncryptV3(privateKey, types.Address(key.Address()), string(password), keystore.KDF(ep.kdf))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := common.SaveFileSafe(ep.outputPath, keyJSON, 0600); err != nil {
+		return nil, fmt.Errorf("failed to write the keystore: %w", err)
+	}
+
+	return &SecretsExportResult{
+		Address: types.Address(key.Address()),
+		Format:  ep.format,
+		KDF:     ep.kdf,
+		Output:  ep.outputPath,
+	}, nil
+}
@@ -0,0 +1,31 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type SecretsExportResult struct {
+	Address types.Address `json:"address"`
+	Format  string        `json:"format"`
+	KDF     string        `json:"kdf"`
+	Output  string        `json:"output"`
+}
+
+func (r *SecretsExportResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[SECRETS EXPORT]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("EVM Address|%s", r.Address),
+		fmt.Sprintf("Format|%s", r.Format),
+		fmt.Sprintf("KDF|%s", r.KDF),
+		fmt.Sprintf("Output|%s", r.Output),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,100 @@
+package export
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
+)
+
+var params = &exportParams{}
+
+func GetCommand() *cobra.Command {
+	secretsExportCmd := &cobra.Command{
+		Use: "export",
+		Short: "Exports the validator ECDSA key from the specified Secrets Manager " +
+			"into an Ethereum keystore v3 file which can be used by standard wallets.",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(secretsExportCmd)
+	helper.SetRequiredFlags(secretsExportCmd, params.getRequiredFlags())
+
+	return secretsExportCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.accountDir,
+		polybftsecrets.AccountDirFlag,
+		"",
+		polybftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.accountConfig,
+		polybftsecrets.AccountConfigFlag,
+		"",
+		polybftsecrets.AccountConfigFlagDesc,
+	)
+
+	cmd.Flags().BoolVar(
+		&params.insecureLocalStore,
+		polybftsecrets.InsecureLocalStoreFlag,
+		false,
+		"the flag indicates if the stored secrets are encrypted or not",
+	)
+
+	cmd.Flags().StringVar(
+		&params.format,
+		formatFlag,
+		formatKeystoreV3,
+		"the export format",
+	)
+
+	cmd.Flags().StringVar(
+		&params.kdf,
+		kdfFlag,
+		string(keystore.KDFScrypt),
+		fmt.Sprintf("the key derivation function of the keystore (%s or %s)", keystore.KDFScrypt, keystore.KDFPBKDF2),
+	)
+
+	cmd.Flags().StringVar(
+		&params.outputPath,
+		outputFlag,
+		"",
+		"the path of the keystore file to write",
+	)
+
+	cmd.Flags().StringVar(
+		&params.passwordFile,
+		passwordFileFlag,
+		"",
+		"the path to the file containing the keystore password, if omitted, the password is prompted",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	result, err := params.exportKeystore()
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(result)
+}
@@ -0,0 +1,134 @@
+package secretsimport
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/0xPolygon/polygon-edge/bls"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/helper/keystore"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	secretsHelper "github.com/0xPolygon/polygon-edge/secrets/helper"
+	"github.com/0xPolygon/polygon-edge/types"
+	ethWallet "github.com/umbracle/ethgo/wallet"
+)
+
+const (
+	keystoreFlag     = "keystore"
+	passwordFileFlag = "password-file"
+)
+
+var (
+	errValidatorKeyExists = errors.New("validator ECDSA key already exists in the secrets manager")
+	errAddressMismatch    = errors.New("the keystore address doesn't match its private key")
+)
+
+type importParams struct {
+	accountDir         string
+	accountConfig      string
+	insecureLocalStore bool
+
+	keystorePath string
+	passwordFile string
+	chainID      int64
+}
+
+func (ip *importParams) getRequiredFlags() []string {
+	return []string{
+		keystoreFlag,
+	}
+}
+
+func (ip *importParams) validateFlags() error {
+	if ip.accountDir == "" && ip.accountConfig == "" {
+		return polybftsecrets.ErrInvalidParams
+	}
+
+	return nil
+}
+
+// importKeystore decrypts the keystore and stores its key as the validator ECDSA key.
+// The BLS key is generated if it doesn't exist yet, and the BLS proof of possession
+// is created for the imported address
+func (ip *importParams) importKeystore() (*SecretsImportResult, error) {
+	keyJSON, err := os.ReadFile(ip.keystorePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the keystore: %w", err)
+	}
+
+	password, err := helper.ReadPassword(ip.passwordFile, "Enter keystore password", false)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, address, err := keystore.DecryptV3(keyJSON, string(password))
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ethWallet.NewWalletFromPrivKey(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keystore private key: %w", err)
+	}
+
+	// the address field is optional in keystore v3 files
+	if address != types.ZeroAddress && address != types.Address(key.Address()) {
+		return nil, errAddressMismatch
+	}
+
+	secretsManager, err := polybftsecrets.GetSecretsManager(ip.accountDir, ip.accountConfig, ip.insecureLocalStore)
+	if err != nil {
+		return nil, err
+	}
+
+	if secretsManager.HasSecret(secrets.ValidatorKey) {
+		return nil, errValidatorKeyExists
+	}
+
+	if err := secretsManager.SetSecret(secrets.ValidatorKey, []byte(hex.EncodeToString(privateKey))); err != nil {
+		return nil, err
+	}
+
+	generated := []string{secrets.ValidatorKey}
+
+	if !secretsManager.HasSecret(secrets.ValidatorBLSKey) {
+		blsKey, err := bls.GenerateBlsKey()
+		if err != nil {
+			return nil, err
+		}
+
+		blsRaw, err := blsKey.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := secretsManager.SetSecret(secrets.ValidatorBLSKey, blsRaw); err != nil {
+			return nil, err
+		}
+
+		generated = append(generated, secrets.ValidatorBLSKey)
+	}
+
+	account, err := wallet.NewAccountFromSecret(secretsManager)
+	if err != nil {
+		return nil, err
+	}
+
+	if !secretsManager.HasSecret(secrets.ValidatorBLSSignature) {
+		if _, err := secretsHelper.InitValidatorBLSSignature(secretsManager, account, ip.chainID); err != nil {
+			return nil, fmt.Errorf("%w: error initializing validator-bls-signature", err)
+		}
+
+		generated = append(generated, secrets.ValidatorBLSSignature)
+	}
+
+	return &SecretsImportResult{
+		Address:   account.Address(),
+		BLSPubkey: hex.EncodeToString(account.Bls.PublicKey().Marshal()),
+		Generated: generated,
+	}, nil
+}
@@ -0,0 +1,30 @@
+package secretsimport
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type SecretsImportResult struct {
+	Address   types.Address `json:"address"`
+	BLSPubkey string        `json:"bls_pubkey"`
+	Generated []string      `json:"generated"`
+}
+
+func (r *SecretsImportResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[SECRETS IMPORT]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("EVM Address|%s", r.Address),
+		fmt.Sprintf("BLS Public key|%s", r.BLSPubkey),
+		fmt.Sprintf("Generated|%s", strings.Join(r.Generated, ", ")),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,90 @@
+package secretsimport
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+)
+
+var params = &importParams{}
+
+func GetCommand() *cobra.Command {
+	secretsImportCmd := &cobra.Command{
+		Use: "import",
+		Short: "Imports an Ethereum keystore v3 file (e.g. created by geth or MetaMask) as the validator ECDSA key " +
+			"into the specified Secrets Manager. A BLS key is generated if it doesn't exist yet.",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(secretsImportCmd)
+	helper.SetRequiredFlags(secretsImportCmd, params.getRequiredFlags())
+
+	return secretsImportCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.accountDir,
+		polybftsecrets.AccountDirFlag,
+		"",
+		polybftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.accountConfig,
+		polybftsecrets.AccountConfigFlag,
+		"",
+		polybftsecrets.AccountConfigFlagDesc,
+	)
+
+	cmd.Flags().BoolVar(
+		&params.insecureLocalStore,
+		polybftsecrets.InsecureLocalStoreFlag,
+		false,
+		"the flag indicating should the secrets stored on the local storage be encrypted",
+	)
+
+	cmd.Flags().StringVar(
+		&params.keystorePath,
+		keystoreFlag,
+		"",
+		"the path to the keystore v3 JSON file",
+	)
+
+	cmd.Flags().StringVar(
+		&params.passwordFile,
+		passwordFileFlag,
+		"",
+		"the path to the file containing the keystore password, if omitted, the password is prompted",
+	)
+
+	cmd.Flags().Int64Var(
+		&params.chainID,
+		polybftsecrets.ChainIDFlag,
+		command.DefaultChainID,
+		"the ID of the chain the BLS proof of possession is created for",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	result, err := params.importKeystore()
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(result)
+}
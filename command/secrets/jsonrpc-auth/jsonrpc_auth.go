@@ -0,0 +1,89 @@
+package jsonrpcauth
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+)
+
+var params = &jsonRPCAuthParams{}
+
+func GetCommand() *cobra.Command {
+	jsonRPCAuthCmd := &cobra.Command{
+		Use: "jsonrpc-auth",
+		Short: "Generates the JWT secret and the API keys the JSON-RPC clients authenticate with. " +
+			"The tiers of the API keys are defined in the file set by the server --json-rpc-auth-config flag",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(jsonRPCAuthCmd)
+
+	return jsonRPCAuthCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.accountDir,
+		polybftsecrets.AccountDirFlag,
+		"",
+		polybftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.accountConfig,
+		polybftsecrets.AccountConfigFlag,
+		"",
+		polybftsecrets.AccountConfigFlagDesc,
+	)
+
+	cmd.Flags().BoolVar(
+		&params.insecureLocalStore,
+		polybftsecrets.InsecureLocalStoreFlag,
+		false,
+		"the flag indicating should the secrets stored on the local storage be encrypted",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.jwt,
+		jwtFlag,
+		false,
+		"generates a new JWT secret, replacing the current one",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.addAPIKeys,
+		addAPIKeyFlag,
+		[]string{},
+		"generates a new API key of the tier",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.removeAPIKeys,
+		removeAPIKeyFlag,
+		[]string{},
+		"removes the API key",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
+	cmd.MarkFlagsOneRequired(jwtFlag, addAPIKeyFlag, removeAPIKeyFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	result, err := params.updateSecrets()
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(result)
+}
@@ -0,0 +1,126 @@
+package jsonrpcauth
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	secretsHelper "github.com/0xPolygon/polygon-edge/secrets/helper"
+)
+
+const (
+	jwtFlag          = "jwt"
+	addAPIKeyFlag    = "add-api-key"
+	removeAPIKeyFlag = "remove-api-key"
+
+	// secretLength is the number of random bytes of the JWT secret and the API keys
+	secretLength = 32
+)
+
+type jsonRPCAuthParams struct {
+	accountDir         string
+	accountConfig      string
+	insecureLocalStore bool
+
+	jwt           bool
+	addAPIKeys    []string
+	removeAPIKeys []string
+}
+
+func (p *jsonRPCAuthParams) validateFlags() error {
+	if p.accountDir == "" && p.accountConfig == "" {
+		return polybftsecrets.ErrInvalidParams
+	}
+
+	for _, tier := range p.addAPIKeys {
+		if tier == "" {
+			return fmt.Errorf("the tier of the %s flag is empty", addAPIKeyFlag)
+		}
+	}
+
+	return nil
+}
+
+// updateSecrets generates the JWT secret and the API keys and removes the API keys.
+// The new secrets are printed once, since the clients need them
+func (p *jsonRPCAuthParams) updateSecrets() (*JSONRPCAuthResult, error) {
+	secretsManager, err := polybftsecrets.GetSecretsManager(p.accountDir, p.accountConfig, p.insecureLocalStore)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &JSONRPCAuthResult{}
+
+	if p.jwt {
+		if result.JWTSecret, err = generateSecret(); err != nil {
+			return nil, err
+		}
+
+		if err := secretsHelper.ReplaceSecret(
+			secretsManager, secrets.JSONRPCJWTSecret, []byte(result.JWTSecret),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(p.addAPIKeys) == 0 && len(p.removeAPIKeys) == 0 {
+		return result, nil
+	}
+
+	apiKeys := map[string]string{}
+
+	if secretsManager.HasSecret(secrets.JSONRPCAPIKeys) {
+		rawKeys, err := secretsManager.GetSecret(secrets.JSONRPCAPIKeys)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(rawKeys, &apiKeys); err != nil {
+			return nil, fmt.Errorf("invalid api keys: %w", err)
+		}
+	}
+
+	for _, key := range p.removeAPIKeys {
+		if _, ok := apiKeys[key]; !ok {
+			return nil, fmt.Errorf("api key %s doesn't exist", key)
+		}
+
+		delete(apiKeys, key)
+	}
+
+	for _, tier := range p.addAPIKeys {
+		key, err := generateSecret()
+		if err != nil {
+			return nil, err
+		}
+
+		apiKeys[key] = tier
+		result.APIKeys = append(result.APIKeys, APIKey{Key: key, Tier: tier})
+	}
+
+	rawKeys, err := json.Marshal(apiKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := secretsHelper.ReplaceSecret(secretsManager, secrets.JSONRPCAPIKeys, rawKeys); err != nil {
+		return nil, err
+	}
+
+	result.RemovedAPIKeys = len(p.removeAPIKeys)
+	result.TotalAPIKeys = len(apiKeys)
+
+	return result, nil
+}
+
+func generateSecret() (string, error) {
+	secret := make([]byte, secretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secret), nil
+}
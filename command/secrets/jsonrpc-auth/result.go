@@ -0,0 +1,48 @@
+package jsonrpcauth
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type APIKey struct {
+	Key  string `json:"key"`
+	Tier string `json:"tier"`
+}
+
+type JSONRPCAuthResult struct {
+	JWTSecret      string   `json:"jwt_secret,omitempty"`
+	APIKeys        []APIKey `json:"api_keys,omitempty"`
+	RemovedAPIKeys int      `json:"removed_api_keys,omitempty"`
+	TotalAPIKeys   int      `json:"total_api_keys,omitempty"`
+}
+
+func (r *JSONRPCAuthResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[JSON-RPC AUTHENTICATION]\n")
+
+	vals := make([]string, 0, len(r.APIKeys)+3)
+
+	if r.JWTSecret != "" {
+		vals = append(vals, fmt.Sprintf("JWT secret|%s", r.JWTSecret))
+	}
+
+	for _, key := range r.APIKeys {
+		vals = append(vals, fmt.Sprintf("API key (%s)|%s", key.Tier, key.Key))
+	}
+
+	if len(r.APIKeys) > 0 || r.RemovedAPIKeys > 0 {
+		vals = append(vals,
+			fmt.Sprintf("Removed API keys|%d", r.RemovedAPIKeys),
+			fmt.Sprintf("Total API keys|%d", r.TotalAPIKeys),
+		)
+	}
+
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n\nThe secrets are shown only once. The node loads them when it is restarted.\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,336 @@
+package rotatebls
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/contract"
+
+	"github.com/0xPolygon/polygon-edge/bls"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	secretsHelper "github.com/0xPolygon/polygon-edge/secrets/helper"
+	"github.com/0xPolygon/polygon-edge/txrelayer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	errNoBlsKeyUpdatedLog = errors.New(
+		"could not find an appropriate log in the receipt that validates the BLS key has been updated")
+	errRotateTxReverted    = errors.New("rotate BLS key transaction reverted")
+	errPreviousBLSKeyInUse = errors.New(
+		"the BLS key replaced by the last rotation is in use until the current epoch ends, rotate the key after it")
+)
+
+type rotateParams struct {
+	accountDir         string
+	accountConfig      string
+	insecureLocalStore bool
+	jsonRPC            string
+}
+
+func (rp *rotateParams) validateFlags() error {
+	if rp.accountDir == "" && rp.accountConfig == "" {
+		return polybftsecrets.ErrInvalidParams
+	}
+
+	if _, err := helper.ParseJSONRPCAddress(rp.jsonRPC); err != nil {
+		return fmt.Errorf("failed to parse json rpc address. Error: %w", err)
+	}
+
+	return nil
+}
+
+// rotateBLSKey generates a new BLS key with its proof of possession and submits them to HydraChain.
+// The new key is kept as a pending secret until the transaction is confirmed, so that it is not lost
+// if the outcome of the transaction is unknown; running the command again completes such a rotation.
+// The replaced key is kept in the secrets manager, because the node signs with it until the new key
+// takes effect at the next epoch, and no other rotation is started while it is still in use
+func (rp *rotateParams) rotateBLSKey() (*RotateBLSResult, error) {
+	// the rotation is refused before a key is generated, the transaction would revert otherwise
+	if !contractsapi.BlsKeyRotationSupported() {
+		return nil, contractsapi.ErrBlsKeyRotationUnsupported
+	}
+
+	secretsManager, err := polybftsecrets.GetSecretsManager(rp.accountDir, rp.accountConfig, rp.insecureLocalStore)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := wallet.NewAccountFromSecret(secretsManager)
+	if err != nil {
+		return nil, err
+	}
+
+	txRelayer, err := txrelayer.NewTxRelayer(
+		txrelayer.WithIPAddress(rp.jsonRPC),
+		txrelayer.WithReceiptTimeout(150*time.Millisecond),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := txRelayer.Client().Eth().ChainID()
+	if err != nil {
+		return nil, err
+	}
+
+	hydraChain := contract.NewContract(
+		ethgo.Address(contracts.HydraChainContract),
+		contractsapi.HydraChain.Abi,
+		contract.WithJsonRPC(txRelayer.Client().Eth()),
+	)
+
+	var newBlsKey *bls.PrivateKey
+
+	if secretsManager.HasSecret(secrets.ValidatorBLSKeyPending) {
+		// complete the rotation whose transaction outcome was unknown
+		if newBlsKey, err = wallet.GetPendingBlsFromSecret(secretsManager); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := checkPreviousBLSKey(secretsManager, hydraChain, account); err != nil {
+			return nil, err
+		}
+
+		if newBlsKey, err = bls.GenerateBlsKey(); err != nil {
+			return nil, err
+		}
+
+		newBlsRaw, err := newBlsKey.Marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := secretsManager.SetSecret(secrets.ValidatorBLSKeyPending, newBlsRaw); err != nil {
+			return nil, err
+		}
+	}
+
+	signature, err := signer.MakeKOSKSignature(newBlsKey, account.Address(), chainID.Int64(), signer.DomainHydraChain)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RotateBLSResult{
+		Address:        account.Address(),
+		PreviousPubkey: hex.EncodeToString(account.Bls.PublicKey().Marshal()),
+		NewPubkey:      hex.EncodeToString(newBlsKey.PublicKey().Marshal()),
+	}
+
+	isRegistered, err := isRegisteredBLSKey(hydraChain, account.Address(), newBlsKey, ethgo.Latest)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isRegistered {
+		receipt, err := sendRotateTx(txRelayer, account, newBlsKey, signature)
+		if err != nil {
+			// the pending key is registered if the transaction of a previous run was executed meanwhile
+			isRegistered, err = rollbackBLSKey(secretsManager, hydraChain, account, newBlsKey, err)
+			if !isRegistered {
+				return nil, err
+			}
+		} else {
+			result.TxHash = types.Hash(receipt.TransactionHash)
+		}
+	}
+
+	if err := storeBLSKey(secretsManager, account.Bls, newBlsKey, signature); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// rollbackBLSKey removes the pending key if the rotation transaction reverted. The pending key is kept
+// on any other error, because the transaction can still be executed. It reports whether the pending key
+// is registered nevertheless, which completes the rotation
+func rollbackBLSKey(
+	secretsManager secrets.SecretsManager, hydraChain *contract.Contract,
+	account *wallet.Account, pendingBlsKey *bls.PrivateKey, txErr error,
+) (bool, error) {
+	if !errors.Is(txErr, errRotateTxReverted) {
+		return false, fmt.Errorf("%w. The outcome of the rotation is unknown, the new BLS key is kept as %s "+
+			"and the rotation is completed by running the command again", txErr, secrets.ValidatorBLSKeyPending)
+	}
+
+	isRegistered, err := isRegisteredBLSKey(hydraChain, account.Address(), pendingBlsKey, ethgo.Latest)
+	if err != nil {
+		return false, fmt.Errorf("%w (checking the registered BLS key failed: %v)", txErr, err)
+	}
+
+	if isRegistered {
+		return true, nil
+	}
+
+	if err := secretsManager.RemoveSecret(secrets.ValidatorBLSKeyPending); err != nil {
+		return false, fmt.Errorf("%w (removing the pending BLS key failed: %v)", txErr, err)
+	}
+
+	return false, txErr
+}
+
+// checkPreviousBLSKey refuses the rotation while the key replaced by the last rotation is in use,
+// i.e. until the epoch following that rotation starts. Once it is no longer used it is removed
+func checkPreviousBLSKey(
+	secretsManager secrets.SecretsManager, hydraChain *contract.Contract, account *wallet.Account,
+) error {
+	if !secretsManager.HasSecret(secrets.ValidatorBLSKeyPrevious) {
+		return nil
+	}
+
+	rawOutput, err := hydraChain.Call("currentEpochId", ethgo.Latest)
+	if err != nil {
+		return fmt.Errorf("failed to call currentEpochId function: %w", err)
+	}
+
+	currentEpochID, ok := rawOutput["0"].(*big.Int)
+	if !ok {
+		return errors.New("failed to decode current epoch id")
+	}
+
+	// the first epoch runs with the genesis validator set, so the rotation happened in it
+	if currentEpochID.Uint64() <= 1 {
+		return errPreviousBLSKeyInUse
+	}
+
+	rawOutput, err = hydraChain.Call("epochs", ethgo.Latest, new(big.Int).Sub(currentEpochID, big.NewInt(1)))
+	if err != nil {
+		return fmt.Errorf("failed to call epochs function: %w", err)
+	}
+
+	endBlock, ok := rawOutput["endBlock"].(*big.Int)
+	if !ok {
+		return errors.New("failed to decode epoch end block")
+	}
+
+	// the validator set of the current epoch is calculated at the end of the previous one,
+	// before its last block is executed
+	isRegistered, err := isRegisteredBLSKey(
+		hydraChain, account.Address(), account.Bls, ethgo.BlockNumber(endBlock.Uint64()-1))
+	if err != nil {
+		return err
+	}
+
+	if !isRegistered {
+		return errPreviousBLSKeyInUse
+	}
+
+	return secretsManager.RemoveSecret(secrets.ValidatorBLSKeyPrevious)
+}
+
+// isRegisteredBLSKey checks whether the BLS key is the one registered for the validator at the given block
+func isRegisteredBLSKey(
+	hydraChain *contract.Contract, address types.Address, blsKey *bls.PrivateKey, block ethgo.BlockNumber,
+) (bool, error) {
+	rawOutput, err := hydraChain.Call("getValidator", block, address)
+	if err != nil {
+		return false, fmt.Errorf("failed to call getValidator function: %w", err)
+	}
+
+	rawKey, ok := rawOutput["blsKey"].([4]*big.Int)
+	if !ok {
+		return false, errors.New("failed to decode bls key")
+	}
+
+	registeredKey, err := bls.UnmarshalPublicKeyFromBigInt(rawKey)
+	if err != nil {
+		return false, fmt.Errorf("failed to unmarshal BLS public key: %w", err)
+	}
+
+	return bytes.Equal(registeredKey.Marshal(), blsKey.PublicKey().Marshal()), nil
+}
+
+// storeBLSKey makes the confirmed pending key the validator key and keeps the replaced one
+func storeBLSKey(
+	secretsManager secrets.SecretsManager, previousBlsKey, blsKey *bls.PrivateKey, signature *bls.Signature,
+) error {
+	previousBlsRaw, err := previousBlsKey.Marshal()
+	if err != nil {
+		return err
+	}
+
+	blsRaw, err := blsKey.Marshal()
+	if err != nil {
+		return err
+	}
+
+	signatureRaw, err := signature.Marshal()
+	if err != nil {
+		return err
+	}
+
+	if err := secretsHelper.ReplaceSecret(secretsManager, secrets.ValidatorBLSKeyPrevious, previousBlsRaw); err != nil {
+		return err
+	}
+
+	if err := secretsHelper.ReplaceSecret(secretsManager, secrets.ValidatorBLSKey, blsRaw); err != nil {
+		return err
+	}
+
+	if err := secretsHelper.ReplaceSecret(
+		secretsManager, secrets.ValidatorBLSSignature, []byte(hex.EncodeToString(signatureRaw))); err != nil {
+		return err
+	}
+
+	return secretsManager.RemoveSecret(secrets.ValidatorBLSKeyPending)
+}
+
+func sendRotateTx(
+	sender txrelayer.TxRelayer, account *wallet.Account, blsKey *bls.PrivateKey, signature *bls.Signature,
+) (*ethgo.Receipt, error) {
+	sigMarshal, err := signature.ToBigInt()
+	if err != nil {
+		return nil, err
+	}
+
+	rotateFn := &contractsapi.RotateBlsKeyHydraChainFn{
+		Signature: sigMarshal,
+		Pubkey:    blsKey.PublicKey().ToBigInt(),
+	}
+
+	input, err := rotateFn.EncodeAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	txn := &ethgo.Transaction{
+		Input: input,
+		To:    (*ethgo.Address)(&contracts.HydraChainContract),
+	}
+
+	receipt, err := sender.SendTransaction(txn, wallet.NewEcdsaSigner(wallet.NewKey(account)))
+	if err != nil {
+		return nil, err
+	}
+
+	if receipt.Status != uint64(types.ReceiptSuccess) {
+		return nil, fmt.Errorf("%w. Tx: %s", errRotateTxReverted, receipt.TransactionHash)
+	}
+
+	for _, log := range receipt.Logs {
+		var event contractsapi.BlsKeyUpdatedEvent
+
+		doesMatch, err := event.ParseLog(log)
+		if err != nil {
+			return nil, err
+		}
+
+		if doesMatch && event.Validator == account.Address() {
+			return receipt, nil
+		}
+	}
+
+	return nil, errNoBlsKeyUpdatedLog
+}
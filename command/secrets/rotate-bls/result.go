@@ -0,0 +1,37 @@
+package rotatebls
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type RotateBLSResult struct {
+	Address        types.Address `json:"address"`
+	PreviousPubkey string        `json:"previous_bls_pubkey"`
+	NewPubkey      string        `json:"new_bls_pubkey"`
+	TxHash         types.Hash    `json:"tx_hash"`
+}
+
+func (r *RotateBLSResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[BLS KEY ROTATION]\n")
+	vals := []string{
+		fmt.Sprintf("EVM Address|%s", r.Address),
+		fmt.Sprintf("Previous BLS Public key|%s", r.PreviousPubkey),
+		fmt.Sprintf("New BLS Public key|%s", r.NewPubkey),
+	}
+
+	// the transaction is unknown if the key was registered by a previous run
+	if r.TxHash != types.ZeroHash {
+		vals = append(vals, fmt.Sprintf("Transaction|%s", r.TxHash))
+	}
+
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n\nThe new BLS key takes effect at the beginning of the next epoch.\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,73 @@
+package rotatebls
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+)
+
+var params = &rotateParams{}
+
+func GetCommand() *cobra.Command {
+	rotateBLSCmd := &cobra.Command{
+		Use: "rotate-bls",
+		Short: "Generates a new validator BLS key and proof of possession and registers them in HydraChain. " +
+			"The new key takes effect at the next epoch, when the node switches to it without a restart. " +
+			"If the outcome of the transaction is unknown, running the command again completes the rotation.",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(rotateBLSCmd)
+
+	return rotateBLSCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.accountDir,
+		polybftsecrets.AccountDirFlag,
+		"",
+		polybftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.accountConfig,
+		polybftsecrets.AccountConfigFlag,
+		"",
+		polybftsecrets.AccountConfigFlagDesc,
+	)
+
+	cmd.Flags().BoolVar(
+		&params.insecureLocalStore,
+		polybftsecrets.InsecureLocalStoreFlag,
+		false,
+		"the flag indicating should the secrets stored on the local storage be encrypted",
+	)
+
+	helper.RegisterJSONRPCFlag(cmd)
+
+	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
+}
+
+func runPreRun(cmd *cobra.Command, _ []string) error {
+	params.jsonRPC = helper.GetJSONRPCAddress(cmd)
+
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	result, err := params.rotateBLSKey()
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(result)
+}
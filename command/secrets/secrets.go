@@ -3,9 +3,15 @@ package secrets
 import (
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+	changepassword "github.com/0xPolygon/polygon-edge/command/secrets/change-password"
+	"github.com/0xPolygon/polygon-edge/command/secrets/export"
 	"github.com/0xPolygon/polygon-edge/command/secrets/generate"
+	secretsimport "github.com/0xPolygon/polygon-edge/command/secrets/import"
+	jsonrpcauth "github.com/0xPolygon/polygon-edge/command/secrets/jsonrpc-auth"
 	outputpublic "github.com/0xPolygon/polygon-edge/command/secrets/output-private"
 	outputprivate "github.com/0xPolygon/polygon-edge/command/secrets/output-public"
+	rotatebls "github.com/0xPolygon/polygon-edge/command/secrets/rotate-bls"
+	"github.com/0xPolygon/polygon-edge/command/secrets/verify"
 	"github.com/spf13/cobra"
 )
 
@@ -32,5 +38,17 @@ func registerSubcommands(baseCmd *cobra.Command) {
 		outputprivate.GetCommand(),
 		// secrets output private and public data
 		outputpublic.GetCommand(),
+		// import keystore v3 file as validator key
+		secretsimport.GetCommand(),
+		// export validator key as keystore v3 file
+		export.GetCommand(),
+		// rotate validator BLS key
+		rotatebls.GetCommand(),
+		// change the password of the encrypted local secrets
+		changepassword.GetCommand(),
+		// verify the backup of the encrypted local secrets
+		verify.GetCommand(),
+		// generate the credentials of the json-rpc clients
+		jsonrpcauth.GetCommand(),
 	)
 }
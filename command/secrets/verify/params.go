@@ -0,0 +1,143 @@
+package verify
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+	"github.com/0xPolygon/polygon-edge/secrets/encryptedlocal"
+	secretsHelper "github.com/0xPolygon/polygon-edge/secrets/helper"
+)
+
+const (
+	passwordFileFlag = "password-file"
+	backupFileFlag   = "backup-file"
+
+	statusVerified = "verified"
+	statusMismatch = "MISMATCH"
+	statusMissing  = "no backup value"
+)
+
+var errBackupMismatch = errors.New("the backed up values don't restore all the stored secrets")
+
+type verifyParams struct {
+	dataDir      string
+	passwordFile string
+	backupFile   string
+}
+
+func (vp *verifyParams) getRequiredFlags() []string {
+	return []string{
+		polybftsecrets.AccountDirFlag,
+	}
+}
+
+func (vp *verifyParams) validateFlags() error {
+	if vp.backupFile != "" {
+		if _, err := os.Stat(vp.backupFile); err != nil {
+			return fmt.Errorf("invalid backup file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// verify decrypts the secrets shown to be backed up when they were created and compares them to the
+// backed up values. The secrets stored later (e.g. the replaced BLS key, the JSON-RPC credentials) have no backup
+func (vp *verifyParams) verify() (*VerifyResult, error) {
+	sm, err := secretsHelper.SetupEncryptedLocalSecretsManager(vp.dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	esm, ok := sm.(*encryptedlocal.EncryptedLocalSecretsManager)
+	if !ok {
+		return nil, errors.New("invalid type assertion")
+	}
+
+	if vp.passwordFile != "" {
+		password, err := helper.ReadPassword(vp.passwordFile, "", false)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := esm.Unlock(password); err != nil {
+			return nil, err
+		}
+	}
+
+	backups, err := vp.readBackupFile()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &VerifyResult{}
+	failed := false
+
+	for _, name := range esm.BackedUpSecrets() {
+		backup, ok := backups[name]
+		if !ok && vp.backupFile == "" {
+			value, err := helper.ReadPassword("", fmt.Sprintf("Enter the backed up value of %s", name), false)
+			if err != nil {
+				return nil, err
+			}
+
+			backup, ok = string(value), true
+		}
+
+		status := statusMissing
+
+		if ok {
+			matches, err := esm.VerifyBackup(name, backup)
+			if err != nil {
+				return nil, err
+			}
+
+			status = statusVerified
+			if !matches {
+				status = statusMismatch
+			}
+		}
+
+		failed = failed || status != statusVerified
+
+		result.Secrets = append(result.Secrets, SecretStatus{Name: name, Status: status})
+	}
+
+	if failed {
+		return nil, fmt.Errorf("%w: %s", errBackupMismatch, result.summary())
+	}
+
+	return result, nil
+}
+
+func (vp *verifyParams) readBackupFile() (map[string]string, error) {
+	backups := make(map[string]string)
+	if vp.backupFile == "" {
+		return backups, nil
+	}
+
+	raw, err := os.ReadFile(vp.backupFile)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range strings.Split(string(raw), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		name, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid backup file line, expected '<secret name>=<value>'")
+		}
+
+		backups[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+
+	return backups, nil
+}
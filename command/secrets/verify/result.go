@@ -0,0 +1,42 @@
+package verify
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type SecretStatus struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+type VerifyResult struct {
+	Secrets []SecretStatus `json:"secrets"`
+}
+
+func (r *VerifyResult) summary() string {
+	statuses := make([]string, 0, len(r.Secrets))
+	for _, s := range r.Secrets {
+		statuses = append(statuses, fmt.Sprintf("%s: %s", s.Name, s.Status))
+	}
+
+	return strings.Join(statuses, ", ")
+}
+
+func (r *VerifyResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	vals := make([]string, 0, len(r.Secrets))
+	for _, s := range r.Secrets {
+		vals = append(vals, fmt.Sprintf("%s|%s", s.Name, s.Status))
+	}
+
+	buffer.WriteString("\n[SECRETS VERIFIED]\n")
+	buffer.WriteString(helper.FormatKV(vals))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,69 @@
+package verify
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
+)
+
+var params = &verifyParams{}
+
+func GetCommand() *cobra.Command {
+	verifyCmd := &cobra.Command{
+		Use: "verify",
+		Short: "Verifies that the password decrypts the encrypted local secrets " +
+			"and that their backed up values restore the same keys",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(verifyCmd)
+	helper.SetRequiredFlags(verifyCmd, params.getRequiredFlags())
+
+	return verifyCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		polybftsecrets.AccountDirFlag,
+		"",
+		polybftsecrets.AccountDirFlagDesc,
+	)
+
+	cmd.Flags().StringVar(
+		&params.passwordFile,
+		passwordFileFlag,
+		"",
+		"the path to the file containing the password, if omitted, "+
+			"the password is read from the unlock sources or prompted",
+	)
+
+	cmd.Flags().StringVar(
+		&params.backupFile,
+		backupFileFlag,
+		"",
+		"the path to the file containing the backed up values, the raw hex values or the mnemonics, "+
+			"as '<secret name>=<value>' lines, if omitted, the values are prompted",
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	result, err := params.verify()
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(result)
+}
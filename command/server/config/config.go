@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/dbengine"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/hashicorp/hcl"
 	"gopkg.in/yaml.v3"
@@ -16,9 +17,12 @@ import (
 type Config struct {
 	GenesisPath              string     `json:"chain_config" yaml:"chain_config"`
 	SecretsConfigPath        string     `json:"secrets_config" yaml:"secrets_config"`
+	RemoteSignerConfigPath   string     `json:"remote_signer_config" yaml:"remote_signer_config"`
 	DataDir                  string     `json:"data_dir" yaml:"data_dir"`
+	DBEngine                 string     `json:"db_engine" yaml:"db_engine"`
 	BlockGasTarget           string     `json:"block_gas_target" yaml:"block_gas_target"`
 	GRPCAddr                 string     `json:"grpc_addr" yaml:"grpc_addr"`
+	IPCPath                  string     `json:"ipc_path" yaml:"ipc_path"`
 	JSONRPCAddr              string     `json:"jsonrpc_addr" yaml:"jsonrpc_addr"`
 	Telemetry                *Telemetry `json:"telemetry" yaml:"telemetry"`
 	Network                  *Network   `json:"network" yaml:"network"`
@@ -33,6 +37,25 @@ type Config struct {
 	JSONLogFormat            bool       `json:"json_log_format" yaml:"json_log_format"`
 	CorsAllowedOrigins       []string   `json:"cors_allowed_origins" yaml:"cors_allowed_origins"`
 
+	// the methods served by the JSON-RPC listener and the optional private listener
+	JSONRPCNamespaces         []string `json:"json_rpc_namespaces" yaml:"json_rpc_namespaces"`
+	JSONRPCMethods            []string `json:"json_rpc_methods" yaml:"json_rpc_methods"`
+	JSONRPCDenyMethods        []string `json:"json_rpc_deny_methods" yaml:"json_rpc_deny_methods"`
+	JSONRPCPrivateAddr        string   `json:"json_rpc_private_addr" yaml:"json_rpc_private_addr"`
+	JSONRPCPrivateNamespaces  []string `json:"json_rpc_private_namespaces" yaml:"json_rpc_private_namespaces"`
+	JSONRPCPrivateMethods     []string `json:"json_rpc_private_methods" yaml:"json_rpc_private_methods"`
+	JSONRPCPrivateDenyMethods []string `json:"json_rpc_private_deny_methods" yaml:"json_rpc_private_deny_methods"`
+	JSONRPCAuthConfigPath     string   `json:"json_rpc_auth_config" yaml:"json_rpc_auth_config"`
+
+	// the rate limit of the JSON-RPC clients by their IP address and the costs of the methods
+	JSONRPCRateLimit      float64          `json:"json_rpc_rate_limit" yaml:"json_rpc_rate_limit"`
+	JSONRPCRateLimitBurst int              `json:"json_rpc_rate_limit_burst" yaml:"json_rpc_rate_limit_burst"`
+	JSONRPCMethodCosts    map[string]int64 `json:"json_rpc_method_costs" yaml:"json_rpc_method_costs"`
+
+	// the GraphQL endpoint of the JSON-RPC listeners and the maximum cost of a query
+	GraphQL        bool   `json:"graphql" yaml:"graphql"`
+	GraphQLMaxCost uint64 `json:"graphql_max_cost" yaml:"graphql_max_cost"`
+
 	Relayer               bool   `json:"relayer" yaml:"relayer"`
 	NumBlockConfirmations uint64 `json:"num_block_confirmations" yaml:"num_block_confirmations"`
 
@@ -40,11 +63,31 @@ type Config struct {
 	WebSocketReadLimit      uint64 `json:"web_socket_read_limit" yaml:"web_socket_read_limit"`
 
 	MetricsInterval time.Duration `json:"metrics_interval" yaml:"metrics_interval"`
+
+	// the thresholds of the /health and /ready endpoints of the JSON-RPC listeners
+	HealthMaxHeadAge time.Duration `json:"health_max_head_age" yaml:"health_max_head_age"`
+	HealthMinPeers   uint64        `json:"health_min_peers" yaml:"health_min_peers"`
+	HealthMaxSyncLag uint64        `json:"health_max_sync_lag" yaml:"health_max_sync_lag"`
+
+	// the thresholds of the automatic profile capture and the number of the captures kept in the data dir
+	ProfileBlockLatency time.Duration `json:"profile_block_latency" yaml:"profile_block_latency"`
+	ProfileRound        uint64        `json:"profile_round" yaml:"profile_round"`
+	ProfileCPUDuration  time.Duration `json:"profile_cpu_duration" yaml:"profile_cpu_duration"`
+	ProfileMaxCaptures  int           `json:"profile_max_captures" yaml:"profile_max_captures"`
+
+	// the certificate of the tcp gRPC listener and the CA of its clients
+	GRPCTLSCert     string `json:"grpc_tls_cert" yaml:"grpc_tls_cert"`
+	GRPCTLSKey      string `json:"grpc_tls_key" yaml:"grpc_tls_key"`
+	GRPCTLSClientCA string `json:"grpc_tls_client_ca" yaml:"grpc_tls_client_ca"`
 }
 
 // Telemetry holds the config details for metric services.
 type Telemetry struct {
-	PrometheusAddr string `json:"prometheus_addr" yaml:"prometheus_addr"`
+	PrometheusAddr   string  `json:"prometheus_addr" yaml:"prometheus_addr"`
+	OTLPEndpoint     string  `json:"otlp_endpoint" yaml:"otlp_endpoint"`
+	OTLPInsecure     bool    `json:"otlp_insecure" yaml:"otlp_insecure"`
+	TraceSampleRatio float64 `json:"trace_sample_ratio" yaml:"trace_sample_ratio"`
+	PprofAddr        string  `json:"pprof_addr" yaml:"pprof_addr"`
 }
 
 // Network defines the network configuration params
@@ -56,6 +99,10 @@ type Network struct {
 	MaxPeers         int64  `json:"max_peers,omitempty" yaml:"max_peers,omitempty"`
 	MaxOutboundPeers int64  `json:"max_outbound_peers,omitempty" yaml:"max_outbound_peers,omitempty"`
 	MaxInboundPeers  int64  `json:"max_inbound_peers,omitempty" yaml:"max_inbound_peers,omitempty"`
+
+	StaticPeers   []string `json:"static_peers,omitempty" yaml:"static_peers,omitempty"`
+	TrustedPeers  []string `json:"trusted_peers,omitempty" yaml:"trusted_peers,omitempty"`
+	PeerAllowlist bool     `json:"peer_allowlist" yaml:"peer_allowlist"`
 }
 
 // TxPool defines the TxPool configuration params
@@ -82,6 +129,9 @@ const (
 	// requests with fromBlock/toBlock values (e.g. eth_getLogs)
 	DefaultJSONRPCBlockRangeLimit uint64 = 1000
 
+	// DefaultGraphQLMaxCost is the maximum cost of a GraphQL query in rate limit units
+	DefaultGraphQLMaxCost uint64 = 1000
+
 	// DefaultNumBlockConfirmations minimal number of child blocks required for the parent block to be considered final
 	// on ethereum epoch lasts for 32 blocks. more details: https://www.alchemy.com/overviews/ethereum-commitment-levels
 	DefaultNumBlockConfirmations uint64 = 64
@@ -97,6 +147,24 @@ const (
 	// DefaultMetricsInterval specifies the time interval after which Prometheus metrics will be generated.
 	// A value of 0 means the metrics are disabled.
 	DefaultMetricsInterval time.Duration = time.Second * 8
+
+	// DefaultHealthMaxHeadAge is the maximum age of the head block of a healthy node
+	DefaultHealthMaxHeadAge time.Duration = time.Minute
+
+	// DefaultHealthMinPeers is the minimum number of peers of a ready node
+	DefaultHealthMinPeers uint64 = 1
+
+	// DefaultHealthMaxSyncLag is the maximum number of blocks a ready node is behind while syncing
+	DefaultHealthMaxSyncLag uint64 = 10
+
+	// DefaultTraceSampleRatio is the ratio of the traces started by the node which are exported
+	DefaultTraceSampleRatio float64 = 1
+
+	// DefaultProfileCPUDuration is the duration of the CPU profile of a capture
+	DefaultProfileCPUDuration time.Duration = 10 * time.Second
+
+	// DefaultProfileMaxCaptures is the number of the captures kept in the data dir
+	DefaultProfileMaxCaptures = 10
 )
 
 // DefaultConfig returns the default server configuration
@@ -106,6 +174,7 @@ func DefaultConfig() *Config {
 	return &Config{
 		GenesisPath:    "./genesis.json",
 		DataDir:        "",
+		DBEngine:       string(dbengine.Default),
 		BlockGasTarget: "0x0", // Special value signaling the parent gas limit should be applied
 		Network: &Network{
 			NoDiscover:       defaultNetworkConfig.NoDiscover,
@@ -117,7 +186,9 @@ func DefaultConfig() *Config {
 				defaultNetworkConfig.Addr.Port,
 			),
 		},
-		Telemetry:  &Telemetry{},
+		Telemetry: &Telemetry{
+			TraceSampleRatio: DefaultTraceSampleRatio,
+		},
 		ShouldSeal: true,
 		TxPool: &TxPool{
 			PriceLimit:         0,
@@ -132,11 +203,17 @@ func DefaultConfig() *Config {
 		LogFilePath:              "",
 		JSONRPCBatchRequestLimit: DefaultJSONRPCBatchRequestLimit,
 		JSONRPCBlockRangeLimit:   DefaultJSONRPCBlockRangeLimit,
+		GraphQLMaxCost:           DefaultGraphQLMaxCost,
 		Relayer:                  false,
 		NumBlockConfirmations:    DefaultNumBlockConfirmations,
 		ConcurrentRequestsDebug:  DefaultConcurrentRequestsDebug,
 		WebSocketReadLimit:       DefaultWebSocketReadLimit,
 		MetricsInterval:          DefaultMetricsInterval,
+		HealthMaxHeadAge:         DefaultHealthMaxHeadAge,
+		HealthMinPeers:           DefaultHealthMinPeers,
+		HealthMaxSyncLag:         DefaultHealthMaxSyncLag,
+		ProfileCPUDuration:       DefaultProfileCPUDuration,
+		ProfileMaxCaptures:       DefaultProfileMaxCaptures,
 	}
 }
 
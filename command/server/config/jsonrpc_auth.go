@@ -0,0 +1,62 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// JSONRPCAuth defines the authentication of the JSON-RPC clients and their tiers.
+// The JWT secret and the API keys are loaded from the secrets manager
+type JSONRPCAuth struct {
+	// JWTTier is the tier of the clients authenticated with a JWT, the JWTs aren't accepted if it is empty
+	JWTTier string `json:"jwt_tier" yaml:"jwt_tier"`
+
+	// AnonymousTier is the tier of the clients without credentials, they are rejected if it is empty
+	AnonymousTier string `json:"anonymous_tier" yaml:"anonymous_tier"`
+
+	// Tiers are the tiers by name
+	Tiers map[string]*JSONRPCTier `json:"tiers" yaml:"tiers"`
+}
+
+// JSONRPCTier defines the methods the clients of the tier can call and their rate limit
+type JSONRPCTier struct {
+	Namespaces  []string `json:"namespaces" yaml:"namespaces"`
+	Methods     []string `json:"methods" yaml:"methods"`
+	DenyMethods []string `json:"deny_methods" yaml:"deny_methods"`
+	RateLimit   float64  `json:"rate_limit" yaml:"rate_limit"`
+	Burst       int      `json:"burst" yaml:"burst"`
+}
+
+// ReadJSONRPCAuthFile reads the JSON-RPC authentication from the JSON or YAML file
+func ReadJSONRPCAuthFile(path string) (*JSONRPCAuth, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var unmarshalFunc func([]byte, interface{}) error
+
+	switch {
+	case strings.HasSuffix(path, ".json"):
+		unmarshalFunc = json.Unmarshal
+	case strings.HasSuffix(path, ".yaml"), strings.HasSuffix(path, ".yml"):
+		unmarshalFunc = yaml.Unmarshal
+	default:
+		return nil, fmt.Errorf("suffix of %s is neither json, yaml nor yml", path)
+	}
+
+	auth := &JSONRPCAuth{}
+	if err := unmarshalFunc(data, auth); err != nil {
+		return nil, err
+	}
+
+	if len(auth.Tiers) == 0 {
+		return nil, fmt.Errorf("no tiers are defined in %s", path)
+	}
+
+	return auth, nil
+}
@@ -5,8 +5,12 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"path/filepath"
 
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/dbengine"
 	"github.com/0xPolygon/polygon-edge/command/server/config"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet/remote"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 
 	helperCommon "github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/network/common"
@@ -41,6 +45,18 @@ func (p *serverParams) initRawParams() error {
 		return err
 	}
 
+	if err := p.initRemoteSignerConfig(); err != nil {
+		return err
+	}
+
+	if err := p.initJSONRPCAuthConfig(); err != nil {
+		return err
+	}
+
+	if err := p.initJSONRPCMethodCosts(); err != nil {
+		return err
+	}
+
 	if err := p.initGenesisConfig(); err != nil {
 		return err
 	}
@@ -55,6 +71,7 @@ func (p *serverParams) initRawParams() error {
 
 	p.initPeerLimits()
 	p.initLogFileLocation()
+	p.initIPCPath()
 
 	p.relayer = p.rawConfig.Relayer
 
@@ -66,7 +83,12 @@ func (p *serverParams) initDataDirLocation() error {
 		return errDataDirectoryUndefined
 	}
 
-	return nil
+	// config files written before the engine was selectable don't specify one
+	if p.rawConfig.DBEngine == "" {
+		p.rawConfig.DBEngine = string(dbengine.Default)
+	}
+
+	return dbengine.Validate(p.rawConfig.DBEngine)
 }
 
 func (p *serverParams) initLogFileLocation() {
@@ -103,6 +125,75 @@ func (p *serverParams) initSecretsConfig() error {
 	return nil
 }
 
+func (p *serverParams) initRemoteSignerConfig() error {
+	if !p.isRemoteSignerConfigPathSet() {
+		return nil
+	}
+
+	var parseErr error
+
+	if p.remoteSignerConfig, parseErr = remote.ReadConfig(
+		p.rawConfig.RemoteSignerConfigPath,
+	); parseErr != nil {
+		return fmt.Errorf("unable to read remote signer config file, %w", parseErr)
+	}
+
+	return nil
+}
+
+// initJSONRPCAuthConfig reads the tiers of the JSON-RPC clients
+func (p *serverParams) initJSONRPCAuthConfig() error {
+	if !p.isJSONRPCAuthConfigPathSet() {
+		return nil
+	}
+
+	auth, err := config.ReadJSONRPCAuthFile(p.rawConfig.JSONRPCAuthConfigPath)
+	if err != nil {
+		return fmt.Errorf("unable to read json-rpc auth config file, %w", err)
+	}
+
+	p.jsonRPCAuth = &jsonrpc.AuthConfig{
+		JWTTier:       auth.JWTTier,
+		AnonymousTier: auth.AnonymousTier,
+		Tiers:         make(map[string]jsonrpc.Tier, len(auth.Tiers)),
+	}
+
+	for name, tier := range auth.Tiers {
+		if tier == nil {
+			tier = &config.JSONRPCTier{}
+		}
+
+		p.jsonRPCAuth.Tiers[name] = jsonrpc.Tier{
+			AccessPolicy: jsonrpc.AccessPolicy{
+				Namespaces:  tier.Namespaces,
+				Methods:     tier.Methods,
+				DenyMethods: tier.DenyMethods,
+			},
+			RateLimit: jsonrpc.RateLimit{
+				Rate:  tier.RateLimit,
+				Burst: tier.Burst,
+			},
+		}
+	}
+
+	return nil
+}
+
+// initJSONRPCMethodCosts checks the costs of the JSON-RPC methods
+func (p *serverParams) initJSONRPCMethodCosts() error {
+	p.jsonRPCMethodCosts = make(map[string]uint64, len(p.rawConfig.JSONRPCMethodCosts))
+
+	for method, cost := range p.rawConfig.JSONRPCMethodCosts {
+		if cost < 0 {
+			return fmt.Errorf("invalid cost %d of the json-rpc method %s", cost, method)
+		}
+
+		p.jsonRPCMethodCosts[method] = uint64(cost)
+	}
+
+	return nil
+}
+
 func (p *serverParams) initGenesisConfig() error {
 	var parseErr error
 
@@ -204,6 +295,10 @@ func (p *serverParams) initAddresses() error {
 		return err
 	}
 
+	if err := p.initPprofAddress(); err != nil {
+		return err
+	}
+
 	if err := p.initLibp2pAddress(); err != nil {
 		return err
 	}
@@ -220,6 +315,10 @@ func (p *serverParams) initAddresses() error {
 		return err
 	}
 
+	if err := p.initJSONRPCPrivateAddress(); err != nil {
+		return err
+	}
+
 	return p.initGRPCAddress()
 }
 
@@ -240,6 +339,28 @@ func (p *serverParams) initPrometheusAddress() error {
 	return nil
 }
 
+// initPprofAddress resolves the address of the pprof endpoints, which are only served on the loopback interface
+func (p *serverParams) initPprofAddress() error {
+	if p.rawConfig.Telemetry.PprofAddr == "" {
+		return nil
+	}
+
+	var parseErr error
+
+	if p.pprofAddress, parseErr = helper.ResolveAddr(
+		p.rawConfig.Telemetry.PprofAddr,
+		helper.LocalHostBinding,
+	); parseErr != nil {
+		return parseErr
+	}
+
+	if !p.pprofAddress.IP.IsLoopback() {
+		return errPprofNotLoopback
+	}
+
+	return nil
+}
+
 func (p *serverParams) initLibp2pAddress() error {
 	var parseErr error
 
@@ -296,7 +417,35 @@ func (p *serverParams) initJSONRPCAddress() error {
 	return nil
 }
 
+// initJSONRPCPrivateAddress resolves the address of the private JSON-RPC listener,
+// which is bound to localhost if the host is omitted
+func (p *serverParams) initJSONRPCPrivateAddress() error {
+	if p.rawConfig.JSONRPCPrivateAddr == "" {
+		return nil
+	}
+
+	var parseErr error
+
+	if p.jsonRPCPrivateAddress, parseErr = helper.ResolveAddr(
+		p.rawConfig.JSONRPCPrivateAddr,
+		helper.LocalHostBinding,
+	); parseErr != nil {
+		return parseErr
+	}
+
+	return nil
+}
+
+// initGRPCAddress sets the optional tcp address of the grpc server, which requires mutual TLS
 func (p *serverParams) initGRPCAddress() error {
+	if p.rawConfig.GRPCAddr == "" {
+		return nil
+	}
+
+	if p.rawConfig.GRPCTLSCert == "" || p.rawConfig.GRPCTLSKey == "" || p.rawConfig.GRPCTLSClientCA == "" {
+		return errGRPCTLSMissing
+	}
+
 	var parseErr error
 
 	if p.grpcAddress, parseErr = helper.ResolveAddr(
@@ -306,5 +455,19 @@ func (p *serverParams) initGRPCAddress() error {
 		return parseErr
 	}
 
+	p.grpcTLS = &server.GRPCTLS{
+		CertFile:     p.rawConfig.GRPCTLSCert,
+		KeyFile:      p.rawConfig.GRPCTLSKey,
+		ClientCAFile: p.rawConfig.GRPCTLSClientCA,
+	}
+
 	return nil
 }
+
+// initIPCPath sets the path of the unix socket, in the data dir by default
+func (p *serverParams) initIPCPath() {
+	p.ipcPath = p.rawConfig.IPCPath
+	if p.ipcPath == "" {
+		p.ipcPath = filepath.Join(p.rawConfig.DataDir, server.IPCFileName)
+	}
+}
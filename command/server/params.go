@@ -4,8 +4,13 @@ import (
 	"errors"
 	"net"
 
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/dbengine"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/command/server/config"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet/remote"
+	"github.com/0xPolygon/polygon-edge/helper/profiling"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server"
@@ -17,17 +22,38 @@ const (
 	configFlag                   = "config"
 	genesisPathFlag              = "chain"
 	dataDirFlag                  = "data-dir"
+	dbEngineFlag                 = "db-engine"
 	libp2pAddressFlag            = "libp2p"
 	prometheusAddressFlag        = "prometheus"
+	otlpEndpointFlag             = "otlp-endpoint"
+	otlpInsecureFlag             = "otlp-insecure"
+	traceSampleRatioFlag         = "trace-sample-ratio"
+	pprofAddressFlag             = "pprof"
 	natFlag                      = "nat"
 	dnsFlag                      = "dns"
 	sealFlag                     = "seal"
 	maxPeersFlag                 = "max-peers"
 	maxInboundPeersFlag          = "max-inbound-peers"
 	maxOutboundPeersFlag         = "max-outbound-peers"
+	staticPeersFlag              = "static-peers"
+	trustedPeersFlag             = "trusted-peers"
+	peerAllowlistFlag            = "peer-allowlist"
 	priceLimitFlag               = "price-limit"
 	jsonRPCBatchRequestLimitFlag = "json-rpc-batch-request-limit"
 	jsonRPCBlockRangeLimitFlag   = "json-rpc-block-range-limit"
+	jsonRPCNamespacesFlag        = "json-rpc-namespaces"
+	jsonRPCMethodsFlag           = "json-rpc-methods"
+	jsonRPCDenyMethodsFlag       = "json-rpc-deny-methods"
+	jsonRPCPrivateFlag           = "json-rpc-private"
+	jsonRPCPrivateNamespacesFlag = "json-rpc-private-namespaces"
+	jsonRPCPrivateMethodsFlag    = "json-rpc-private-methods"
+	jsonRPCPrivateDenyFlag       = "json-rpc-private-deny-methods"
+	jsonRPCAuthConfigFlag        = "json-rpc-auth-config"
+	jsonRPCRateLimitFlag         = "json-rpc-rate-limit"
+	jsonRPCRateLimitBurstFlag    = "json-rpc-rate-limit-burst"
+	jsonRPCMethodCostsFlag       = "json-rpc-method-costs"
+	graphQLFlag                  = "graphql"
+	graphQLMaxCostFlag           = "graphql-max-cost"
 	maxSlotsFlag                 = "max-slots"
 	maxEnqueuedFlag              = "max-enqueued"
 	blockGasTargetFlag           = "block-gas-target"
@@ -44,6 +70,22 @@ const (
 	webSocketReadLimitFlag      = "websocket-read-limit"
 
 	metricsIntervalFlag = "metrics-interval"
+
+	healthMaxHeadAgeFlag = "health-max-head-age"
+	healthMinPeersFlag   = "health-min-peers"
+	healthMaxSyncLagFlag = "health-max-sync-lag"
+
+	profileBlockLatencyFlag = "profile-block-latency"
+	profileRoundFlag        = "profile-round"
+	profileCPUDurationFlag  = "profile-cpu-duration"
+	profileMaxCapturesFlag  = "profile-max-captures"
+
+	remoteSignerConfigFlag = "remote-signer-config"
+
+	ipcPathFlag         = "ipc-path"
+	grpcTLSCertFlag     = "grpc-tls-cert"
+	grpcTLSKeyFlag      = "grpc-tls-key"
+	grpcTLSClientCAFlag = "grpc-tls-client-ca"
 )
 
 // Flags that are deprecated, but need to be preserved for
@@ -68,6 +110,9 @@ var (
 
 var (
 	errInvalidNATAddress = errors.New("could not parse NAT IP address")
+	errGRPCTLSMissing    = errors.New("the grpc address requires mutual TLS, " +
+		"set --grpc-tls-cert, --grpc-tls-key and --grpc-tls-client-ca")
+	errPprofNotLoopback = errors.New("the pprof address has to be a loopback address, e.g. 127.0.0.1:6060")
 )
 
 type serverParams struct {
@@ -76,11 +121,18 @@ type serverParams struct {
 
 	libp2pAddress     *net.TCPAddr
 	prometheusAddress *net.TCPAddr
+	pprofAddress      *net.TCPAddr
 	natAddress        net.IP
 	dnsAddress        multiaddr.Multiaddr
 	grpcAddress       *net.TCPAddr
+	grpcTLS           *server.GRPCTLS
+	ipcPath           string
 	jsonRPCAddress    *net.TCPAddr
 
+	jsonRPCPrivateAddress *net.TCPAddr
+	jsonRPCAuth           *jsonrpc.AuthConfig
+	jsonRPCMethodCosts    map[string]uint64
+
 	blockGasTarget uint64
 	devInterval    uint64
 	isDevMode      bool
@@ -90,6 +142,8 @@ type serverParams struct {
 	genesisConfig *chain.Chain
 	secretsConfig *secrets.SecretsManagerConfig
 
+	remoteSignerConfig *remote.Config
+
 	logFileLocation string
 
 	relayer bool
@@ -108,6 +162,14 @@ func (p *serverParams) isSecretsConfigPathSet() bool {
 	return p.rawConfig.SecretsConfigPath != ""
 }
 
+func (p *serverParams) isJSONRPCAuthConfigPathSet() bool {
+	return p.rawConfig.JSONRPCAuthConfigPath != ""
+}
+
+func (p *serverParams) isRemoteSignerConfigPathSet() bool {
+	return p.rawConfig.RemoteSignerConfigPath != ""
+}
+
 func (p *serverParams) isPrometheusAddressSet() bool {
 	return p.rawConfig.Telemetry.PrometheusAddr != ""
 }
@@ -158,11 +220,50 @@ func (p *serverParams) generateConfig() *server.Config {
 			BlockRangeLimit:          p.rawConfig.JSONRPCBlockRangeLimit,
 			ConcurrentRequestsDebug:  p.rawConfig.ConcurrentRequestsDebug,
 			WebSocketReadLimit:       p.rawConfig.WebSocketReadLimit,
+			AccessPolicy: jsonrpc.AccessPolicy{
+				Namespaces:  p.rawConfig.JSONRPCNamespaces,
+				Methods:     p.rawConfig.JSONRPCMethods,
+				DenyMethods: p.rawConfig.JSONRPCDenyMethods,
+			},
+			PrivateAddr: p.jsonRPCPrivateAddress,
+			PrivateAccessPolicy: jsonrpc.AccessPolicy{
+				Namespaces:  p.rawConfig.JSONRPCPrivateNamespaces,
+				Methods:     p.rawConfig.JSONRPCPrivateMethods,
+				DenyMethods: p.rawConfig.JSONRPCPrivateDenyMethods,
+			},
+			Auth: p.jsonRPCAuth,
+			RateLimit: jsonrpc.RateLimit{
+				Rate:  p.rawConfig.JSONRPCRateLimit,
+				Burst: p.rawConfig.JSONRPCRateLimitBurst,
+			},
+			MethodCosts:    p.jsonRPCMethodCosts,
+			GraphQL:        p.rawConfig.GraphQL,
+			GraphQLMaxCost: p.rawConfig.GraphQLMaxCost,
+			Health: jsonrpc.HealthConfig{
+				MaxHeadAge: p.rawConfig.HealthMaxHeadAge,
+				MinPeers:   p.rawConfig.HealthMinPeers,
+				MaxSyncLag: p.rawConfig.HealthMaxSyncLag,
+			},
 		},
+		IPCPath:    p.ipcPath,
 		GRPCAddr:   p.grpcAddress,
+		GRPCTLS:    p.grpcTLS,
 		LibP2PAddr: p.libp2pAddress,
 		Telemetry: &server.Telemetry{
 			PrometheusAddr: p.prometheusAddress,
+			PprofAddr:      p.pprofAddress,
+			Profiling: &profiling.Config{
+				BlockLatency: p.rawConfig.ProfileBlockLatency,
+				Round:        p.rawConfig.ProfileRound,
+				CPUDuration:  p.rawConfig.ProfileCPUDuration,
+				MaxCaptures:  p.rawConfig.ProfileMaxCaptures,
+			},
+			Tracing: &tracing.Config{
+				Endpoint:    p.rawConfig.Telemetry.OTLPEndpoint,
+				Insecure:    p.rawConfig.Telemetry.OTLPInsecure,
+				SampleRatio: p.rawConfig.Telemetry.TraceSampleRatio,
+				ServiceName: "hydra",
+			},
 		},
 		Network: &network.Config{
 			NoDiscover:       p.rawConfig.Network.NoDiscover,
@@ -174,13 +275,18 @@ func (p *serverParams) generateConfig() *server.Config {
 			MaxInboundPeers:  p.rawConfig.Network.MaxInboundPeers,
 			MaxOutboundPeers: p.rawConfig.Network.MaxOutboundPeers,
 			Chain:            p.genesisConfig,
+			StaticPeers:      p.rawConfig.Network.StaticPeers,
+			TrustedPeers:     p.rawConfig.Network.TrustedPeers,
+			PeerAllowlist:    p.rawConfig.Network.PeerAllowlist,
 		},
 		DataDir:            p.rawConfig.DataDir,
+		DBEngine:           dbengine.Engine(p.rawConfig.DBEngine),
 		Seal:               p.rawConfig.ShouldSeal,
 		PriceLimit:         p.rawConfig.TxPool.PriceLimit,
 		MaxSlots:           p.rawConfig.TxPool.MaxSlots,
 		MaxAccountEnqueued: p.rawConfig.TxPool.MaxAccountEnqueued,
 		SecretsManager:     p.secretsConfig,
+		RemoteSigner:       p.remoteSignerConfig,
 		RestoreFile:        p.getRestoreFilePath(),
 		LogLevel:           hclog.LevelFromString(p.rawConfig.LogLevel),
 		JSONLogFormat:      p.rawConfig.JSONLogFormat,
@@ -3,6 +3,7 @@ package server
 import (
 	"fmt"
 
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/dbengine"
 	"github.com/0xPolygon/polygon-edge/command"
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/command/server/config"
@@ -67,6 +68,14 @@ func setFlags(cmd *cobra.Command) {
 		"the data directory used for storing Hydra Chain client data",
 	)
 
+	cmd.Flags().StringVar(
+		&params.rawConfig.DBEngine,
+		dbEngineFlag,
+		defaultConfig.DBEngine,
+		fmt.Sprintf("the database engine used for the blockchain and state storage (%s, %s)",
+			dbengine.LevelDB, dbengine.Pebble),
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.Network.Libp2pAddr,
 		libp2pAddressFlag,
@@ -82,6 +91,37 @@ func setFlags(cmd *cobra.Command) {
 			"If only port is defined (:port) it will bind to 0.0.0.0:port",
 	)
 
+	cmd.Flags().StringVar(
+		&params.rawConfig.Telemetry.OTLPEndpoint,
+		otlpEndpointFlag,
+		"",
+		"the address and port of the OTLP gRPC collector the traces are exported to (address:port), "+
+			"the traces aren't exported if it's not set",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.Telemetry.OTLPInsecure,
+		otlpInsecureFlag,
+		false,
+		"export the traces to the OTLP collector without TLS",
+	)
+
+	cmd.Flags().Float64Var(
+		&params.rawConfig.Telemetry.TraceSampleRatio,
+		traceSampleRatioFlag,
+		defaultConfig.Telemetry.TraceSampleRatio,
+		"the ratio of the traces started by the node which are exported, "+
+			"the traces started by the JSON-RPC clients follow the sampling decision of the client",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.Telemetry.PprofAddr,
+		pprofAddressFlag,
+		"",
+		"the loopback address and port of the pprof endpoints (address:port), "+
+			"if only the port is defined (:port) it binds to 127.0.0.1:port. The endpoints are disabled if it's not set",
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.Network.NatAddr,
 		natFlag,
@@ -110,6 +150,14 @@ func setFlags(cmd *cobra.Command) {
 		command.DefaultSecretsConfigPathDesc,
 	)
 
+	cmd.Flags().StringVar(
+		&params.rawConfig.RemoteSignerConfigPath,
+		remoteSignerConfigFlag,
+		"",
+		"the path to the remote signer configuration file. If set, the validator keys are held by "+
+			"the remote signing service instead of the secrets manager",
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.RestoreFile,
 		restoreFlag,
@@ -166,6 +214,27 @@ func setFlags(cmd *cobra.Command) {
 	)
 	cmd.MarkFlagsMutuallyExclusive(maxPeersFlag, maxOutboundPeersFlag)
 
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.Network.StaticPeers,
+		staticPeersFlag,
+		[]string{},
+		"the libp2p addresses of the peers the client always keeps connected (redialed on disconnect)",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.Network.TrustedPeers,
+		trustedPeersFlag,
+		[]string{},
+		"the libp2p addresses of the peers that are allowed to connect regardless of the peer limits",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.Network.PeerAllowlist,
+		peerAllowlistFlag,
+		defaultConfig.Network.PeerAllowlist,
+		"reject inbound connections from peers that are neither static nor trusted",
+	)
+
 	cmd.Flags().Uint64Var(
 		&params.rawConfig.TxPool.PriceLimit,
 		priceLimitFlag,
@@ -212,6 +281,104 @@ func setFlags(cmd *cobra.Command) {
 			"that consider fromBlock/toBlock values (e.g. eth_getLogs), value of 0 disables it",
 	)
 
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.JSONRPCNamespaces,
+		jsonRPCNamespacesFlag,
+		[]string{},
+		"the json-rpc namespaces enabled on the json-rpc address (e.g. eth, net, web3), "+
+			"all of them if neither namespaces nor methods are set",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.JSONRPCMethods,
+		jsonRPCMethodsFlag,
+		[]string{},
+		"the json-rpc methods enabled on the json-rpc address in addition to the namespaces (e.g. debug_traceTransaction), "+
+			"the methods which modify the node (debug_setHead) are served only if they are listed",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.JSONRPCDenyMethods,
+		jsonRPCDenyMethodsFlag,
+		[]string{},
+		"the json-rpc methods disabled on the json-rpc address, a trailing * matches the prefix (e.g. debug_trace*)",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPCPrivateAddr,
+		jsonRPCPrivateFlag,
+		"",
+		"the address of a second json-rpc listener with its own allowlist, bound to localhost if the host is omitted",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.JSONRPCPrivateNamespaces,
+		jsonRPCPrivateNamespacesFlag,
+		[]string{},
+		"the json-rpc namespaces enabled on the private json-rpc address, "+
+			"all of them if neither namespaces nor methods are set",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.JSONRPCPrivateMethods,
+		jsonRPCPrivateMethodsFlag,
+		[]string{},
+		"the json-rpc methods enabled on the private json-rpc address in addition to the namespaces",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&params.rawConfig.JSONRPCPrivateDenyMethods,
+		jsonRPCPrivateDenyFlag,
+		[]string{},
+		"the json-rpc methods disabled on the private json-rpc address, a trailing * matches the prefix",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.JSONRPCAuthConfigPath,
+		jsonRPCAuthConfigFlag,
+		"",
+		"the path to the json-rpc auth config file (json or yaml) defining the tiers of the clients. "+
+			"If set, the clients authenticate with a JWT or an API key stored in the secrets manager",
+	)
+
+	cmd.Flags().Float64Var(
+		&params.rawConfig.JSONRPCRateLimit,
+		jsonRPCRateLimitFlag,
+		0,
+		"the request cost units per second of a json-rpc client by its IP address, unlimited if zero. "+
+			"The clients authenticated with the json-rpc auth config are limited by their tiers",
+	)
+
+	cmd.Flags().IntVar(
+		&params.rawConfig.JSONRPCRateLimitBurst,
+		jsonRPCRateLimitBurstFlag,
+		0,
+		"the request cost units a json-rpc client can spend at once, the rate limit rounded up if zero",
+	)
+
+	cmd.Flags().StringToInt64Var(
+		&params.rawConfig.JSONRPCMethodCosts,
+		jsonRPCMethodCostsFlag,
+		map[string]int64{},
+		"the costs of the json-rpc methods in rate limit units, one by default, a trailing * matches the prefix "+
+			"(e.g. debug_*=10,eth_call=2). eth_getLogs and debug_trace* cost more by block span and gas",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.GraphQL,
+		graphQLFlag,
+		false,
+		"serve the EIP-1767 GraphQL API on /graphql of the json-rpc listeners",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.GraphQLMaxCost,
+		graphQLMaxCostFlag,
+		defaultConfig.GraphQLMaxCost,
+		"the maximum cost of a GraphQL query in rate limit units, each field costs its json-rpc method. "+
+			"Unlimited if zero",
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.LogFilePath,
 		logFileLocationFlag,
@@ -247,6 +414,87 @@ func setFlags(cmd *cobra.Command) {
 		"the interval (in seconds) at which special metrics are generated. a value of zero means the metrics are disabled",
 	)
 
+	cmd.Flags().DurationVar(
+		&params.rawConfig.HealthMaxHeadAge,
+		healthMaxHeadAgeFlag,
+		defaultConfig.HealthMaxHeadAge,
+		"the maximum age of the head block checked by the /health and /ready endpoints (e.g. a few block times), "+
+			"a value of zero disables the check",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.HealthMinPeers,
+		healthMinPeersFlag,
+		defaultConfig.HealthMinPeers,
+		"the minimum number of connected peers checked by the /ready endpoint",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.HealthMaxSyncLag,
+		healthMaxSyncLagFlag,
+		defaultConfig.HealthMaxSyncLag,
+		"the maximum number of blocks the node is behind while syncing checked by the /ready endpoint",
+	)
+
+	cmd.Flags().DurationVar(
+		&params.rawConfig.ProfileBlockLatency,
+		profileBlockLatencyFlag,
+		defaultConfig.ProfileBlockLatency,
+		"capture the cpu, heap and goroutine profiles to the data dir when no block is added for longer, "+
+			"a value of zero disables the trigger",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.ProfileRound,
+		profileRoundFlag,
+		defaultConfig.ProfileRound,
+		"capture the cpu, heap and goroutine profiles to the data dir when the consensus of a block reaches the round, "+
+			"a value of zero disables the trigger",
+	)
+
+	cmd.Flags().DurationVar(
+		&params.rawConfig.ProfileCPUDuration,
+		profileCPUDurationFlag,
+		defaultConfig.ProfileCPUDuration,
+		"the duration of the cpu profile of a capture",
+	)
+
+	cmd.Flags().IntVar(
+		&params.rawConfig.ProfileMaxCaptures,
+		profileMaxCapturesFlag,
+		defaultConfig.ProfileMaxCaptures,
+		"the number of the profile captures kept in the data dir, the oldest ones are removed",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.IPCPath,
+		ipcPathFlag,
+		defaultConfig.IPCPath,
+		fmt.Sprintf("the path of the unix socket of the JSON-RPC and the GRPC admin services (default <data-dir>/%s)",
+			server.IPCFileName),
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.GRPCTLSCert,
+		grpcTLSCertFlag,
+		defaultConfig.GRPCTLSCert,
+		"the certificate of the tcp GRPC interface",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.GRPCTLSKey,
+		grpcTLSKeyFlag,
+		defaultConfig.GRPCTLSKey,
+		"the key of the certificate of the tcp GRPC interface",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.GRPCTLSClientCA,
+		grpcTLSClientCAFlag,
+		defaultConfig.GRPCTLSClientCA,
+		"the CA certificate the clients of the tcp GRPC interface are verified against",
+	)
+
 	setLegacyFlags(cmd)
 
 	setDevFlags(cmd)
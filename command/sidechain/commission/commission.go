@@ -69,9 +69,18 @@ func setFlags(cmd *cobra.Command) {
 		"a flag to indicate if the secrets used are encrypted. If set to true, the secrets are stored in plain text.",
 	)
 
+	cmd.Flags().StringVar(
+		&params.remoteSignerConfig,
+		sidechain.RemoteSignerFlag,
+		"",
+		sidechain.RemoteSignerFlagDesc,
+	)
+
 	helper.RegisterJSONRPCFlag(cmd)
 
 	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountConfigFlag, polybftsecrets.AccountDirFlag)
+	cmd.MarkFlagsMutuallyExclusive(sidechain.RemoteSignerFlag, polybftsecrets.AccountDirFlag)
+	cmd.MarkFlagsMutuallyExclusive(sidechain.RemoteSignerFlag, polybftsecrets.AccountConfigFlag)
 }
 
 func runPreRun(cmd *cobra.Command, _ []string) error {
@@ -84,15 +93,6 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
-	secretsManager, err := polybftsecrets.GetSecretsManager(
-		params.accountDir,
-		params.accountConfig,
-		params.insecureLocalStore,
-	)
-	if err != nil {
-		return err
-	}
-
 	txRelayer, err := txrelayer.NewTxRelayer(
 		txrelayer.WithIPAddress(params.jsonRPC),
 		txrelayer.WithReceiptTimeout(150*time.Millisecond),
@@ -101,12 +101,17 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	validatorAccount, err := wallet.NewAccountFromSecret(secretsManager)
+	validatorKey, err := sidechain.GetValidatorKey(
+		params.accountDir,
+		params.accountConfig,
+		params.remoteSignerConfig,
+		params.insecureLocalStore,
+	)
 	if err != nil {
 		return err
 	}
 
-	receipt, err := setCommission(txRelayer, validatorAccount)
+	receipt, err := setCommission(txRelayer, validatorKey)
 	if err != nil {
 		return err
 	}
@@ -140,7 +145,7 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
-func setCommission(sender txrelayer.TxRelayer, account *wallet.Account) (*ethgo.Receipt, error) {
+func setCommission(sender txrelayer.TxRelayer, key *wallet.Key) (*ethgo.Receipt, error) {
 	setCommissionFn := &contractsapi.SetCommissionHydraDelegationFn{
 		NewCommission: new(big.Int).SetUint64(params.commission),
 	}
@@ -155,10 +160,12 @@ func setCommission(sender txrelayer.TxRelayer, account *wallet.Account) (*ethgo.
 		To:    (*ethgo.Address)(&delegationManager),
 	}
 
-	receipt, err := sender.SendTransaction(txn, account.Ecdsa)
+	ecdsaSigner := wallet.NewEcdsaSigner(key)
+
+	receipt, err := sender.SendTransaction(txn, ecdsaSigner)
 	if err != nil {
 		// retry execution. Issue: https://github.com/valyala/fasthttp/issues/189
-		receipt, err = sender.SendTransaction(txn, account.Ecdsa)
+		receipt, err = sender.SendTransaction(txn, ecdsaSigner)
 	}
 
 	return receipt, err
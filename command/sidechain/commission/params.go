@@ -18,6 +18,7 @@ type setCommissionParams struct {
 	commission         uint64
 	jsonRPC            string
 	insecureLocalStore bool
+	remoteSignerConfig string
 }
 
 type setCommissionResult struct {
@@ -32,7 +33,9 @@ func (scp *setCommissionParams) getRequiredFlags() []string {
 }
 
 func (scp *setCommissionParams) validateFlags() error {
-	if err := sidechainHelper.ValidateSecretFlags(scp.accountDir, scp.accountConfig); err != nil {
+	if err := sidechainHelper.ValidateValidatorKeyFlags(
+		scp.accountDir, scp.accountConfig, scp.remoteSignerConfig,
+	); err != nil {
 		return err
 	}
 
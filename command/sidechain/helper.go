@@ -7,12 +7,17 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet/remote"
 )
 
 const (
 	SelfFlag               = "self"
 	AmountFlag             = "amount"
 	InsecureLocalStoreFlag = "insecure"
+	RemoteSignerFlag       = "remote-signer"
+
+	RemoteSignerFlagDesc = "the path to the remote signer configuration file. " +
+		"If set, the validator keys are held by the remote signing service instead of the secrets manager"
 
 	DefaultGasPrice = 1879048192 // 0x70000000
 	MaxCommission   = 100
@@ -38,6 +43,15 @@ func ValidateSecretFlags(dataDir, config string) error {
 	return nil
 }
 
+// ValidateValidatorKeyFlags validates the secrets flags, unless the remote signer is used
+func ValidateValidatorKeyFlags(dataDir, config, remoteSignerConfig string) error {
+	if remoteSignerConfig != "" {
+		return nil
+	}
+
+	return ValidateSecretFlags(dataDir, config)
+}
+
 // GetAccount resolves secrets manager and returns an account object
 func GetAccount(
 	accountDir, accountConfig string,
@@ -60,3 +74,31 @@ func GetAccount(
 func GetAccountFromDir(accountDir string, insecureLocalStore bool) (*wallet.Account, error) {
 	return GetAccount(accountDir, "", insecureLocalStore)
 }
+
+// GetValidatorKey returns the validator key backed by the remote signer if its configuration is provided,
+// or by the account read from the secrets manager otherwise
+func GetValidatorKey(
+	accountDir, accountConfig, remoteSignerConfig string,
+	insecureLocalStore bool,
+) (*wallet.Key, error) {
+	if remoteSignerConfig != "" {
+		config, err := remote.ReadConfig(remoteSignerConfig)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read remote signer config file, %w", err)
+		}
+
+		remoteSigner, err := remote.NewSigner(config)
+		if err != nil {
+			return nil, err
+		}
+
+		return wallet.NewKeyFromSigner(remoteSigner), nil
+	}
+
+	account, err := GetAccount(accountDir, accountConfig, insecureLocalStore)
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.NewKey(account), nil
+}
@@ -19,10 +19,13 @@ type registerParams struct {
 	jsonRPC            string
 	stake              string
 	insecureLocalStore bool
+	remoteSignerConfig string
 }
 
 func (rp *registerParams) validateFlags() error {
-	if err := sidechainHelper.ValidateSecretFlags(rp.accountDir, rp.accountConfig); err != nil {
+	if err := sidechainHelper.ValidateValidatorKeyFlags(
+		rp.accountDir, rp.accountConfig, rp.remoteSignerConfig,
+	); err != nil {
 		return err
 	}
 
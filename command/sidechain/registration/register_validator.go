@@ -14,6 +14,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/polybftsecrets"
 	"github.com/0xPolygon/polygon-edge/command/sidechain"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/helper/common"
@@ -76,9 +77,18 @@ func setFlags(cmd *cobra.Command) {
 		"a flag to indicate if the secrets used are encrypted. If set to true, the secrets are stored in plain text.",
 	)
 
+	cmd.Flags().StringVar(
+		&params.remoteSignerConfig,
+		sidechain.RemoteSignerFlag,
+		"",
+		sidechain.RemoteSignerFlagDesc,
+	)
+
 	helper.RegisterJSONRPCFlag(cmd)
 
 	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountConfigFlag, polybftsecrets.AccountDirFlag)
+	cmd.MarkFlagsMutuallyExclusive(sidechain.RemoteSignerFlag, polybftsecrets.AccountDirFlag)
+	cmd.MarkFlagsMutuallyExclusive(sidechain.RemoteSignerFlag, polybftsecrets.AccountConfigFlag)
 }
 
 func runPreRun(cmd *cobra.Command, _ []string) error {
@@ -91,15 +101,6 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
-	secretsManager, err := polybftsecrets.GetSecretsManager(
-		params.accountDir,
-		params.accountConfig,
-		params.insecureLocalStore,
-	)
-	if err != nil {
-		return err
-	}
-
 	txRelayer, err := txrelayer.NewTxRelayer(
 		txrelayer.WithIPAddress(params.jsonRPC),
 		txrelayer.WithReceiptTimeout(150*time.Millisecond),
@@ -108,27 +109,22 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	newValidatorAccount, err := wallet.NewAccountFromSecret(secretsManager)
-	if err != nil {
-		return err
-	}
-
-	sRaw, err := secretsManager.GetSecret(secrets.ValidatorBLSSignature)
-	if err != nil {
-		return err
-	}
-
-	sb, err := hex.DecodeString(string(sRaw))
+	newValidatorKey, err := sidechain.GetValidatorKey(
+		params.accountDir,
+		params.accountConfig,
+		params.remoteSignerConfig,
+		params.insecureLocalStore,
+	)
 	if err != nil {
 		return err
 	}
 
-	blsSignature, err := bls.UnmarshalSignature(sb)
+	blsSignature, err := getValidatorBLSSignature(txRelayer, newValidatorKey)
 	if err != nil {
 		return err
 	}
 
-	receipt, err := registerValidator(txRelayer, newValidatorAccount, blsSignature)
+	receipt, err := registerValidator(txRelayer, newValidatorKey, blsSignature)
 	if err != nil {
 		return err
 	}
@@ -159,7 +155,7 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	}
 
 	if params.stake != "" {
-		receipt, err := stake(txRelayer, newValidatorAccount)
+		receipt, err := stake(txRelayer, newValidatorKey)
 		if err != nil {
 			result.stakeResult = fmt.Sprintf("Failed to execute stake transaction: %s", err.Error())
 		} else {
@@ -172,7 +168,51 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
-func stake(sender txrelayer.TxRelayer, account *wallet.Account) (*ethgo.Receipt, error) {
+// getValidatorBLSSignature returns the KOSK signature of the validator. The remote signing service
+// signs it on demand, while the local one is read from the secrets manager
+func getValidatorBLSSignature(sender txrelayer.TxRelayer, key *wallet.Key) (*bls.Signature, error) {
+	if params.remoteSignerConfig != "" {
+		chainID, err := sender.Client().Eth().ChainID()
+		if err != nil {
+			return nil, err
+		}
+
+		message, err := signer.KOSKMessage(types.Address(key.Address()), chainID.Int64())
+		if err != nil {
+			return nil, err
+		}
+
+		sb, err := key.SignWithDomain(message, signer.DomainHydraChain)
+		if err != nil {
+			return nil, err
+		}
+
+		return bls.UnmarshalSignature(sb)
+	}
+
+	secretsManager, err := polybftsecrets.GetSecretsManager(
+		params.accountDir,
+		params.accountConfig,
+		params.insecureLocalStore,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sRaw, err := secretsManager.GetSecret(secrets.ValidatorBLSSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	sb, err := hex.DecodeString(string(sRaw))
+	if err != nil {
+		return nil, err
+	}
+
+	return bls.UnmarshalSignature(sb)
+}
+
+func stake(sender txrelayer.TxRelayer, key *wallet.Key) (*ethgo.Receipt, error) {
 	if stakeFn == nil {
 		return nil, errors.New("failed to create stake ABI function")
 	}
@@ -193,10 +233,12 @@ func stake(sender txrelayer.TxRelayer, account *wallet.Account) (*ethgo.Receipt,
 		Value: stake,
 	}
 
-	receipt, err := sender.SendTransaction(txn, account.Ecdsa)
+	ecdsaSigner := wallet.NewEcdsaSigner(key)
+
+	receipt, err := sender.SendTransaction(txn, ecdsaSigner)
 	if err != nil {
 		// retry execution. Issue: https://github.com/valyala/fasthttp/issues/189
-		receipt, err = sender.SendTransaction(txn, account.Ecdsa)
+		receipt, err = sender.SendTransaction(txn, ecdsaSigner)
 	}
 
 	return receipt, err
@@ -232,7 +274,7 @@ func populateStakeResults(receipt *ethgo.Receipt, result *registerResult) {
 
 func registerValidator(
 	sender txrelayer.TxRelayer,
-	account *wallet.Account,
+	key *wallet.Key,
 	signature *bls.Signature,
 ) (*ethgo.Receipt, error) {
 	sigMarshal, err := signature.ToBigInt()
@@ -242,7 +284,7 @@ func registerValidator(
 
 	registerFn := &contractsapi.RegisterHydraChainFn{
 		Signature: sigMarshal,
-		Pubkey:    account.Bls.PublicKey().ToBigInt(),
+		Pubkey:    key.BLSPublicKey().ToBigInt(),
 	}
 
 	input, err := registerFn.EncodeAbi()
@@ -255,5 +297,5 @@ func registerValidator(
 		To:    (*ethgo.Address)(&hydraChain),
 	}
 
-	return sender.SendTransaction(txn, account.Ecdsa)
+	return sender.SendTransaction(txn, wallet.NewEcdsaSigner(key))
 }
@@ -13,6 +13,7 @@ type withdrawRewardsParams struct {
 	accountConfig      string
 	jsonRPC            string
 	insecureLocalStore bool
+	remoteSignerConfig string
 }
 
 type withdrawRewardResult struct {
@@ -29,7 +30,7 @@ func (w *withdrawRewardsParams) validateFlags() error {
 		return fmt.Errorf("failed to parse json rpc address. Error: %w", err)
 	}
 
-	return sidechainHelper.ValidateSecretFlags(w.accountDir, w.accountConfig)
+	return sidechainHelper.ValidateValidatorKeyFlags(w.accountDir, w.accountConfig, w.remoteSignerConfig)
 }
 
 func (wr withdrawRewardResult) GetOutput() string {
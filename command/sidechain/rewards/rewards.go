@@ -13,6 +13,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/sidechain"
 	sidechainHelper "github.com/0xPolygon/polygon-edge/command/sidechain"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/txrelayer"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -56,7 +57,16 @@ func setFlags(cmd *cobra.Command) {
 		"a flag to indicate if the secrets used are encrypted. If set to true, the secrets are stored in plain text.",
 	)
 
+	cmd.Flags().StringVar(
+		&params.remoteSignerConfig,
+		sidechain.RemoteSignerFlag,
+		"",
+		sidechain.RemoteSignerFlagDesc,
+	)
+
 	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
+	cmd.MarkFlagsMutuallyExclusive(sidechain.RemoteSignerFlag, polybftsecrets.AccountDirFlag)
+	cmd.MarkFlagsMutuallyExclusive(sidechain.RemoteSignerFlag, polybftsecrets.AccountConfigFlag)
 }
 
 func runPreRun(cmd *cobra.Command, _ []string) error {
@@ -69,12 +79,17 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
-	validatorAccount, err := sidechainHelper.GetAccount(params.accountDir, params.accountConfig, params.insecureLocalStore)
+	validatorKey, err := sidechainHelper.GetValidatorKey(
+		params.accountDir,
+		params.accountConfig,
+		params.remoteSignerConfig,
+		params.insecureLocalStore,
+	)
 	if err != nil {
 		return err
 	}
 
-	validatorAddr := validatorAccount.Ecdsa.Address()
+	validatorAddr := validatorKey.Address()
 
 	txRelayer, err := txrelayer.NewTxRelayer(txrelayer.WithIPAddress(params.jsonRPC),
 		txrelayer.WithReceiptTimeout(150*time.Millisecond))
@@ -94,7 +109,7 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 		To:    (*ethgo.Address)(&contracts.HydraStakingContract),
 	}
 
-	receipt, err := txRelayer.SendTransaction(txn, validatorAccount.Ecdsa)
+	receipt, err := txRelayer.SendTransaction(txn, wallet.NewEcdsaSigner(validatorKey))
 	if err != nil {
 		return err
 	}
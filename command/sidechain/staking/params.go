@@ -20,6 +20,7 @@ type stakeParams struct {
 	self               bool
 	delegateAddress    string
 	insecureLocalStore bool
+	remoteSignerConfig string
 }
 
 func (v *stakeParams) validateFlags() error {
@@ -27,7 +28,7 @@ func (v *stakeParams) validateFlags() error {
 		return fmt.Errorf("failed to parse json rpc address. Error: %w", err)
 	}
 
-	return sidechainHelper.ValidateSecretFlags(v.accountDir, v.accountConfig)
+	return sidechainHelper.ValidateValidatorKeyFlags(v.accountDir, v.accountConfig, v.remoteSignerConfig)
 }
 
 type stakeResult struct {
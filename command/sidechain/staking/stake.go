@@ -11,6 +11,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/sidechain"
 	sidechainHelper "github.com/0xPolygon/polygon-edge/command/sidechain"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/txrelayer"
@@ -82,8 +83,17 @@ func setFlags(cmd *cobra.Command) {
 		"a flag to indicate if the secrets used are encrypted. If set to true, the secrets are stored in plain text.",
 	)
 
+	cmd.Flags().StringVar(
+		&params.remoteSignerConfig,
+		sidechain.RemoteSignerFlag,
+		"",
+		sidechain.RemoteSignerFlagDesc,
+	)
+
 	cmd.MarkFlagsMutuallyExclusive(sidechainHelper.SelfFlag, delegateAddressFlag)
 	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
+	cmd.MarkFlagsMutuallyExclusive(sidechain.RemoteSignerFlag, polybftsecrets.AccountDirFlag)
+	cmd.MarkFlagsMutuallyExclusive(sidechain.RemoteSignerFlag, polybftsecrets.AccountConfigFlag)
 }
 
 func runPreRun(cmd *cobra.Command, _ []string) error {
@@ -96,9 +106,10 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
-	validatorAccount, err := sidechainHelper.GetAccount(
+	validatorKey, err := sidechainHelper.GetValidatorKey(
 		params.accountDir,
 		params.accountConfig,
+		params.remoteSignerConfig,
 		params.insecureLocalStore,
 	)
 	if err != nil {
@@ -137,13 +148,13 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	}
 
 	txn := &ethgo.Transaction{
-		From:  validatorAccount.Ecdsa.Address(),
+		From:  validatorKey.Address(),
 		Input: encoded,
 		To:    contractAddr,
 		Value: parsedValue,
 	}
 
-	receipt, err := txRelayer.SendTransaction(txn, validatorAccount.Ecdsa)
+	receipt, err := txRelayer.SendTransaction(txn, wallet.NewEcdsaSigner(validatorKey))
 	if err != nil {
 		return err
 	}
@@ -153,7 +164,7 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	}
 
 	result := &stakeResult{
-		validatorAddress: validatorAccount.Ecdsa.Address().String(),
+		validatorAddress: validatorKey.Address().String(),
 	}
 
 	foundLog := false
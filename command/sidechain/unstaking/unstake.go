@@ -10,6 +10,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/sidechain"
 	sidechainHelper "github.com/0xPolygon/polygon-edge/command/sidechain"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/txrelayer"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -62,7 +63,16 @@ func setFlags(cmd *cobra.Command) {
 		"a flag to indicate if the secrets used are encrypted. If set to true, the secrets are stored in plain text.",
 	)
 
+	cmd.Flags().StringVar(
+		&params.remoteSignerConfig,
+		sidechain.RemoteSignerFlag,
+		"",
+		sidechain.RemoteSignerFlagDesc,
+	)
+
 	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
+	cmd.MarkFlagsMutuallyExclusive(sidechain.RemoteSignerFlag, polybftsecrets.AccountDirFlag)
+	cmd.MarkFlagsMutuallyExclusive(sidechain.RemoteSignerFlag, polybftsecrets.AccountConfigFlag)
 }
 
 func runPreRun(cmd *cobra.Command, _ []string) error {
@@ -75,7 +85,12 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
-	validatorAccount, err := sidechainHelper.GetAccount(params.accountDir, params.accountConfig, params.insecureLocalStore)
+	validatorKey, err := sidechainHelper.GetValidatorKey(
+		params.accountDir,
+		params.accountConfig,
+		params.remoteSignerConfig,
+		params.insecureLocalStore,
+	)
 	if err != nil {
 		return err
 	}
@@ -96,12 +111,12 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	}
 
 	txn := &ethgo.Transaction{
-		From:  validatorAccount.Ecdsa.Address(),
+		From:  validatorKey.Address(),
 		Input: encoded,
 		To:    (*ethgo.Address)(&contracts.HydraStakingContract),
 	}
 
-	receipt, err := txRelayer.SendTransaction(txn, validatorAccount.Ecdsa)
+	receipt, err := txRelayer.SendTransaction(txn, wallet.NewEcdsaSigner(validatorKey))
 	if err != nil {
 		return err
 	}
@@ -116,7 +131,7 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	)
 
 	result := &unstakeResult{
-		ValidatorAddress: validatorAccount.Ecdsa.Address().String(),
+		ValidatorAddress: validatorKey.Address().String(),
 	}
 
 	// check the logs to check for the result
@@ -13,6 +13,7 @@ type withdrawParams struct {
 	accountConfig      string
 	jsonRPC            string
 	insecureLocalStore bool
+	remoteSignerConfig string
 }
 
 func (w *withdrawParams) validateFlags() error {
@@ -20,13 +21,13 @@ func (w *withdrawParams) validateFlags() error {
 		return fmt.Errorf("failed to parse json rpc address. Error: %w", err)
 	}
 
-	return sidechainHelper.ValidateSecretFlags(w.accountDir, w.accountConfig)
+	return sidechainHelper.ValidateValidatorKeyFlags(w.accountDir, w.accountConfig, w.remoteSignerConfig)
 }
 
 type withdrawResult struct {
-	ValidatorAddress string   `json:"validatorAddress"`
+	ValidatorAddress string `json:"validatorAddress"`
 	Amount           string `json:"amount"`
-	BlockNumber      uint64   `json:"blockNumber"`
+	BlockNumber      uint64 `json:"blockNumber"`
 }
 
 func (r *withdrawResult) GetOutput() string {
@@ -14,6 +14,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/sidechain"
 	sidechainHelper "github.com/0xPolygon/polygon-edge/command/sidechain"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/txrelayer"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -57,7 +58,16 @@ func setFlags(cmd *cobra.Command) {
 		"a flag to indicate if the secrets used are encrypted. If set to true, the secrets are stored in plain text.",
 	)
 
+	cmd.Flags().StringVar(
+		&params.remoteSignerConfig,
+		sidechain.RemoteSignerFlag,
+		"",
+		sidechain.RemoteSignerFlagDesc,
+	)
+
 	cmd.MarkFlagsMutuallyExclusive(polybftsecrets.AccountDirFlag, polybftsecrets.AccountConfigFlag)
+	cmd.MarkFlagsMutuallyExclusive(sidechain.RemoteSignerFlag, polybftsecrets.AccountDirFlag)
+	cmd.MarkFlagsMutuallyExclusive(sidechain.RemoteSignerFlag, polybftsecrets.AccountConfigFlag)
 }
 
 func runPreRun(cmd *cobra.Command, _ []string) error {
@@ -70,7 +80,12 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
-	validatorAccount, err := sidechainHelper.GetAccount(params.accountDir, params.accountConfig, params.insecureLocalStore)
+	validatorKey, err := sidechainHelper.GetValidatorKey(
+		params.accountDir,
+		params.accountConfig,
+		params.remoteSignerConfig,
+		params.insecureLocalStore,
+	)
 	if err != nil {
 		return err
 	}
@@ -81,16 +96,16 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 		return err
 	}
 
-	withdrawFn := &contractsapi.WithdrawHydraStakingFn{To: (types.Address)(validatorAccount.Ecdsa.Address())}
+	withdrawFn := &contractsapi.WithdrawHydraStakingFn{To: (types.Address)(validatorKey.Address())}
 	encoded, err := withdrawFn.EncodeAbi()
 	if err != nil {
 		return err
 	}
 
 	receiver := (*ethgo.Address)(&contracts.HydraStakingContract)
-	txn := rootHelper.CreateTransaction(validatorAccount.Ecdsa.Address(), receiver, encoded, nil, false)
+	txn := rootHelper.CreateTransaction(validatorKey.Address(), receiver, encoded, nil, false)
 
-	receipt, err := txRelayer.SendTransaction(txn, validatorAccount.Ecdsa)
+	receipt, err := txRelayer.SendTransaction(txn, wallet.NewEcdsaSigner(validatorKey))
 	if err != nil {
 		return err
 	}
@@ -124,7 +139,7 @@ func runCommand(cmd *cobra.Command, _ []string) error {
 
 	outputter.WriteCommandResult(
 		&withdrawResult{
-			ValidatorAddress: validatorAccount.Ecdsa.Address().String(),
+			ValidatorAddress: validatorKey.Address().String(),
 			Amount:           withdrawalEvent.Amount.String(),
 			BlockNumber:      receipt.BlockNumber,
 		})
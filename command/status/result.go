@@ -12,6 +12,7 @@ type StatusResult struct {
 	CurrentBlockNumber int64  `json:"current_block_number"`
 	CurrentBlockHash   string `json:"current_block_hash"`
 	LibP2PAddress      string `json:"libp2p_address"`
+	JSONRPCAddress     string `json:"jsonrpc_address"`
 }
 
 func (r *StatusResult) GetOutput() string {
@@ -23,6 +24,7 @@ func (r *StatusResult) GetOutput() string {
 		fmt.Sprintf("Current Block Number (base 10)|%d", r.CurrentBlockNumber),
 		fmt.Sprintf("Current Block Hash|%s", r.CurrentBlockHash),
 		fmt.Sprintf("Libp2p Address|%s", r.LibP2PAddress),
+		fmt.Sprintf("JSON-RPC Address|%s", r.JSONRPCAddress),
 	}))
 
 	return buffer.String()
@@ -19,7 +19,7 @@ func GetCommand() *cobra.Command {
 		Run:   runCommand,
 	}
 
-	helper.RegisterGRPCAddressFlag(statusCmd)
+	helper.RegisterGRPCEndpointFlags(statusCmd)
 
 	return statusCmd
 }
@@ -28,7 +28,7 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
-	statusResponse, err := getSystemStatus(helper.GetGRPCAddress(cmd))
+	statusResponse, err := getSystemStatus(helper.GetGRPCEndpoint(cmd))
 	if err != nil {
 		outputter.SetError(err)
 
@@ -40,12 +40,13 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		CurrentBlockNumber: statusResponse.Current.Number,
 		CurrentBlockHash:   statusResponse.Current.Hash,
 		LibP2PAddress:      statusResponse.P2PAddr,
+		JSONRPCAddress:     statusResponse.JsonrpcAddr,
 	})
 }
 
-func getSystemStatus(grpcAddress string) (*proto.ServerStatus, error) {
+func getSystemStatus(grpcEndpoint helper.GRPCEndpoint) (*proto.ServerStatus, error) {
 	client, err := helper.GetSystemClientConnection(
-		grpcAddress,
+		grpcEndpoint,
 	)
 	if err != nil {
 		return nil, err
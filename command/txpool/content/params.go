@@ -0,0 +1,58 @@
+package content
+
+import (
+	"context"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	txpoolProto "github.com/0xPolygon/polygon-edge/txpool/proto"
+)
+
+var (
+	params = &contentParams{}
+)
+
+const (
+	addressFlag = "address"
+)
+
+type contentParams struct {
+	address string
+
+	content *txpoolProto.ContentResp
+}
+
+func (p *contentParams) getRequiredFlags() []string {
+	return []string{
+		addressFlag,
+	}
+}
+
+func (p *contentParams) initContent(grpcEndpoint helper.GRPCEndpoint) error {
+	client, err := helper.GetTxPoolClientConnection(grpcEndpoint)
+	if err != nil {
+		return err
+	}
+
+	content, err := client.Content(
+		context.Background(),
+		&txpoolProto.ContentReq{
+			Address: p.address,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	p.content = content
+
+	return nil
+}
+
+func (p *contentParams) getResult() command.CommandResult {
+	return &TxPoolContentResult{
+		Address: p.address,
+		Pending: newTxnSummaryResults(p.content.Pending),
+		Queued:  newTxnSummaryResults(p.content.Queued),
+	}
+}
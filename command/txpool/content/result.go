@@ -0,0 +1,73 @@
+package content
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	txpoolProto "github.com/0xPolygon/polygon-edge/txpool/proto"
+)
+
+type TxnSummaryResult struct {
+	Hash     string `json:"hash"`
+	Nonce    uint64 `json:"nonce"`
+	Value    string `json:"value"`
+	Gas      uint64 `json:"gas"`
+	GasPrice string `json:"gas_price"`
+}
+
+func newTxnSummaryResults(summaries []*txpoolProto.TxnSummary) []*TxnSummaryResult {
+	results := make([]*TxnSummaryResult, len(summaries))
+
+	for i, summary := range summaries {
+		results[i] = &TxnSummaryResult{
+			Hash:     summary.Hash,
+			Nonce:    summary.Nonce,
+			Value:    summary.Value,
+			Gas:      summary.Gas,
+			GasPrice: summary.GasPrice,
+		}
+	}
+
+	return results
+}
+
+type TxPoolContentResult struct {
+	Address string              `json:"address"`
+	Pending []*TxnSummaryResult `json:"pending"`
+	Queued  []*TxnSummaryResult `json:"queued"`
+}
+
+func (r *TxPoolContentResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[TXPOOL ACCOUNT CONTENT]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Address|%s", r.Address),
+		fmt.Sprintf("Pending transactions|%d", len(r.Pending)),
+		fmt.Sprintf("Queued transactions|%d", len(r.Queued)),
+	}))
+
+	writeSummaries := func(title string, summaries []*TxnSummaryResult) {
+		if len(summaries) == 0 {
+			return
+		}
+
+		rows := make([]string, len(summaries)+1)
+		rows[0] = "Nonce|Hash|Value|Gas|Gas Price"
+
+		for i, s := range summaries {
+			rows[i+1] = fmt.Sprintf("%d|%s|%s|%d|%s", s.Nonce, s.Hash, s.Value, s.Gas, s.GasPrice)
+		}
+
+		buffer.WriteString(fmt.Sprintf("\n\n[%s]\n", title))
+		buffer.WriteString(helper.FormatList(rows))
+	}
+
+	writeSummaries("PENDING", r.Pending)
+	writeSummaries("QUEUED", r.Queued)
+
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,83 @@
+package drop
+
+import (
+	"context"
+	"errors"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	txpoolProto "github.com/0xPolygon/polygon-edge/txpool/proto"
+)
+
+var (
+	params = &dropParams{}
+)
+
+var (
+	errInvalidTarget = errors.New("exactly one of the address and tx-hash flags is required")
+)
+
+const (
+	addressFlag = "address"
+	txHashFlag  = "tx-hash"
+)
+
+type dropParams struct {
+	address string
+	txHash  string
+
+	droppedTxHashes []string
+}
+
+func (p *dropParams) validateFlags() error {
+	if (p.address == "") == (p.txHash == "") {
+		return errInvalidTarget
+	}
+
+	return nil
+}
+
+func (p *dropParams) drop(grpcEndpoint helper.GRPCEndpoint) error {
+	client, err := helper.GetTxPoolClientConnection(grpcEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if p.txHash != "" {
+		resp, err := client.RemoveTxn(
+			context.Background(),
+			&txpoolProto.RemoveTxnReq{
+				TxHash: p.txHash,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		p.droppedTxHashes = resp.TxHashes
+
+		return nil
+	}
+
+	resp, err := client.DropAccount(
+		context.Background(),
+		&txpoolProto.DropAccountReq{
+			Address: p.address,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	p.droppedTxHashes = resp.TxHashes
+
+	return nil
+}
+
+func (p *dropParams) getResult() command.CommandResult {
+	return &TxPoolDropResult{
+		Address:  p.address,
+		TxHash:   p.txHash,
+		TxHashes: p.droppedTxHashes,
+	}
+}
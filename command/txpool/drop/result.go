@@ -0,0 +1,41 @@
+package drop
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type TxPoolDropResult struct {
+	Address  string   `json:"address,omitempty"`
+	TxHash   string   `json:"tx_hash,omitempty"`
+	TxHashes []string `json:"dropped_tx_hashes"`
+}
+
+func (r *TxPoolDropResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[TXPOOL DROP]\n")
+
+	if r.Address != "" {
+		buffer.WriteString(helper.FormatKV([]string{
+			fmt.Sprintf("Address|%s", r.Address),
+			fmt.Sprintf("Dropped transactions|%d", len(r.TxHashes)),
+		}))
+	} else {
+		buffer.WriteString(helper.FormatKV([]string{
+			fmt.Sprintf("Transaction hash|%s", r.TxHash),
+			fmt.Sprintf("Dropped transactions|%d", len(r.TxHashes)),
+		}))
+	}
+
+	if len(r.TxHashes) > 0 {
+		buffer.WriteString("\n\n[DROPPED TRANSACTIONS]\n")
+		buffer.WriteString(helper.FormatList(r.TxHashes))
+	}
+
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
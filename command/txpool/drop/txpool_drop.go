@@ -0,0 +1,56 @@
+package drop
+
+import (
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+)
+
+func GetCommand() *cobra.Command {
+	txPoolDropCmd := &cobra.Command{
+		Use: "drop",
+		Short: "Drops a transaction (and the subsequent promoted transactions of its account), " +
+			"or all the transactions of an account from the transaction pool",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(txPoolDropCmd)
+
+	return txPoolDropCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.address,
+		addressFlag,
+		"",
+		"the address of the account whose transactions should be dropped",
+	)
+
+	cmd.Flags().StringVar(
+		&params.txHash,
+		txHashFlag,
+		"",
+		"the hash of the transaction that should be dropped",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(addressFlag, txHashFlag)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.drop(helper.GetGRPCEndpoint(cmd)); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
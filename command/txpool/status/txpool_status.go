@@ -23,7 +23,7 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	outputter := command.InitializeOutputter(cmd)
 	defer outputter.WriteOutput()
 
-	statusResponse, err := getTxPoolStatus(helper.GetGRPCAddress(cmd))
+	statusResponse, err := getTxPoolStatus(helper.GetGRPCEndpoint(cmd))
 	if err != nil {
 		outputter.SetError(err)
 
@@ -35,9 +35,9 @@ func runCommand(cmd *cobra.Command, _ []string) {
 	})
 }
 
-func getTxPoolStatus(grpcAddress string) (*txpoolOp.TxnPoolStatusResp, error) {
+func getTxPoolStatus(grpcEndpoint helper.GRPCEndpoint) (*txpoolOp.TxnPoolStatusResp, error) {
 	client, err := helper.GetTxPoolClientConnection(
-		grpcAddress,
+		grpcEndpoint,
 	)
 	if err != nil {
 		return nil, err
@@ -82,19 +82,19 @@ func runCommand(cmd *cobra.Command, _ []string) {
 		&txpoolProto.SubscribeRequest{
 			Types: params.supportedEvents,
 		},
-		helper.GetGRPCAddress(cmd),
+		helper.GetGRPCEndpoint(cmd),
 	)
 }
 
 func subscribeToEvents(
 	outputter command.OutputFormatter,
 	subscribeRequest *txpoolProto.SubscribeRequest,
-	grpcAddress string,
+	grpcEndpoint helper.GRPCEndpoint,
 ) {
 	ctx, cancelFn := context.WithCancel(context.Background())
 	defer cancelFn()
 
-	stream, err := getSubscribeStream(ctx, grpcAddress, subscribeRequest)
+	stream, err := getSubscribeStream(ctx, grpcEndpoint, subscribeRequest)
 	if err != nil {
 		outputter.SetError(err)
 		outputter.WriteOutput()
@@ -110,11 +110,11 @@ func subscribeToEvents(
 
 func getSubscribeStream(
 	ctx context.Context,
-	grpcAddress string,
+	grpcEndpoint helper.GRPCEndpoint,
 	subscribeRequest *txpoolProto.SubscribeRequest,
 ) (txpoolProto.TxnPoolOperator_SubscribeClient, error) {
 	client, err := helper.GetTxPoolClientConnection(
-		grpcAddress,
+		grpcEndpoint,
 	)
 	if err != nil {
 		return nil, err
@@ -7,6 +7,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet/remote"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
@@ -84,6 +85,10 @@ type Params struct {
 	SecretsManager secrets.SecretsManager
 	BlockTime      uint64
 
+	// RemoteSigner is the configuration of the signing service holding the validator keys.
+	// If it is not set, the validator keys are read from the secrets manager
+	RemoteSigner *remote.Config
+
 	NumBlockConfirmations uint64
 	MetricsInterval       time.Duration
 }
@@ -0,0 +1,180 @@
+package dev
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	errAccountNotImpersonated = errors.New("the sender account is not impersonated")
+)
+
+// snapshot is a head of the chain saved by evm_snapshot
+type snapshot struct {
+	header     *types.Header
+	timeOffset int64
+}
+
+// blockOverride is the state override sealed in the block with the given hash
+type blockOverride struct {
+	hash     types.Hash
+	override types.StateOverride
+}
+
+// Mine seals a new block with the transactions from the pool, at the given timestamp if it is set
+func (d *Dev) Mine(timestamp uint64) error {
+	if timestamp != 0 {
+		if err := d.SetNextBlockTimestamp(timestamp); err != nil {
+			return err
+		}
+	}
+
+	return d.sealBlock(nil, nil)
+}
+
+// IncreaseTime moves the clock of the next blocks forward and returns the total offset in seconds
+func (d *Dev) IncreaseTime(seconds uint64) int64 {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.timeOffset += int64(seconds)
+
+	return d.timeOffset
+}
+
+// SetNextBlockTimestamp sets the timestamp of the next block, the following blocks continue from it
+func (d *Dev) SetNextBlockTimestamp(timestamp uint64) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if head := d.blockchain.Header(); timestamp <= head.Timestamp {
+		return fmt.Errorf("timestamp %d is not after the timestamp %d of the latest block", timestamp, head.Timestamp)
+	}
+
+	d.nextTimestamp = timestamp
+
+	return nil
+}
+
+// Snapshot saves the head of the chain and the clock offset, and returns the ID of the snapshot
+func (d *Dev) Snapshot() uint64 {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.lastSnapshotID++
+	d.snapshots[d.lastSnapshotID] = &snapshot{
+		header:     d.blockchain.Header(),
+		timeOffset: d.timeOffset,
+	}
+
+	return d.lastSnapshotID
+}
+
+// Revert rewinds the chain to the snapshot with the given ID and discards the transactions of the removed blocks.
+// The snapshot and the ones taken after it are removed. It returns false if the snapshot doesn't exist
+func (d *Dev) Revert(id uint64) (bool, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	snap, ok := d.snapshots[id]
+	if !ok {
+		return false, nil
+	}
+
+	header := snap.header
+
+	if canonical, ok := d.blockchain.GetHeaderByNumber(header.Number); !ok || canonical.Hash != header.Hash {
+		return false, fmt.Errorf("block %d of snapshot %d is not in the canonical chain", header.Number, id)
+	}
+
+	if _, err := d.executor.StateAt(header.StateRoot); err != nil {
+		return false, fmt.Errorf("state of snapshot %d is not available: %w", id, err)
+	}
+
+	reverted, err := d.blockchain.SetHead(header.Number)
+	if err != nil {
+		return false, err
+	}
+
+	d.txpool.ResetWithRevertedTxs(reverted)
+
+	d.timeOffset = snap.timeOffset
+	d.nextTimestamp = 0
+
+	for snapID := range d.snapshots {
+		if snapID >= id {
+			delete(d.snapshots, snapID)
+		}
+	}
+
+	d.logger.Info("reverted to snapshot", "id", id, "block", header.Number)
+
+	return true, nil
+}
+
+// SetBalance seals a new block which sets the balance of the account
+func (d *Dev) SetBalance(addr types.Address, balance *big.Int) error {
+	return d.sealBlock(nil, types.StateOverride{addr: {Balance: balance}})
+}
+
+// SetCode seals a new block which sets the code of the account
+func (d *Dev) SetCode(addr types.Address, code []byte) error {
+	return d.sealBlock(nil, types.StateOverride{addr: {Code: code}})
+}
+
+// SetStorageAt seals a new block which sets the value of the storage slot of the account
+func (d *Dev) SetStorageAt(addr types.Address, slot, value types.Hash) error {
+	return d.sealBlock(nil, types.StateOverride{addr: {StateDiff: map[types.Hash]types.Hash{slot: value}}})
+}
+
+// ImpersonateAccount allows sending transactions on behalf of the account without its key
+func (d *Dev) ImpersonateAccount(addr types.Address) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.impersonated[addr] = struct{}{}
+}
+
+// StopImpersonatingAccount stops the impersonation of the account
+func (d *Dev) StopImpersonatingAccount(addr types.Address) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	delete(d.impersonated, addr)
+}
+
+// IsImpersonated returns true if the transactions can be sent on behalf of the account
+func (d *Dev) IsImpersonated(addr types.Address) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	_, ok := d.impersonated[addr]
+
+	return ok
+}
+
+// SendImpersonatedTransaction seals a new block with the unsigned transaction of an impersonated account
+// and returns the transaction hash
+func (d *Dev) SendImpersonatedTransaction(tx *types.Transaction) (types.Hash, error) {
+	if !d.IsImpersonated(tx.From) {
+		return types.ZeroHash, errAccountNotImpersonated
+	}
+
+	// the signature is invalid, the sender is kept in the From field of the sealed transaction.
+	// The sender is part of the signature, so the transactions of the impersonated accounts have unique hashes
+	tx.V = big.NewInt(0)
+	tx.R = new(big.Int).SetBytes(tx.From.Bytes())
+	tx.S = big.NewInt(1)
+	tx.ComputeHash(d.blockchain.Header().Number + 1)
+
+	if err := d.sealBlock([]*types.Transaction{tx}, nil); err != nil {
+		return types.ZeroHash, err
+	}
+
+	d.logger.Debug("impersonated transaction sealed", "from", tx.From, "hash", tx.Hash)
+
+	return tx.Hash, nil
+}
@@ -2,6 +2,7 @@ package dev
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
@@ -29,6 +30,23 @@ type Dev struct {
 
 	blockchain *blockchain.Blockchain
 	executor   *state.Executor
+
+	// lock serializes the blocks sealed on the interval and by the dev controls
+	lock sync.Mutex
+
+	// timeOffset is the number of seconds the clock of the blocks is moved by
+	timeOffset int64
+	// nextTimestamp is the timestamp of the next block, if it is set
+	nextTimestamp uint64
+
+	snapshots      map[uint64]*snapshot
+	lastSnapshotID uint64
+
+	impersonated map[types.Address]struct{}
+
+	// sealedOverride is the state override of the block being sealed,
+	// it is applied again when the block is verified
+	sealedOverride *blockOverride
 }
 
 // Factory implements the base factory method
@@ -44,6 +62,9 @@ func Factory(
 		blockchain: params.Blockchain,
 		executor:   params.Executor,
 		txpool:     params.TxPool,
+
+		snapshots:    make(map[uint64]*snapshot),
+		impersonated: make(map[types.Address]struct{}),
 	}
 
 	rawInterval, ok := params.Config.Config["interval"]
@@ -98,13 +119,41 @@ func (d *Dev) run() {
 		}
 
 		// There are new transactions in the pool, try to seal them
-		header := d.blockchain.Header()
-		if err := d.writeNewBlock(header); err != nil {
+		if err := d.sealBlock(nil, nil); err != nil {
 			d.logger.Error("failed to mine block", "err", err)
 		}
 	}
 }
 
+// sealBlock writes a new block on top of the head. The block holds the given transactions
+// and the state override, or the transactions from the pool if none of them is set
+func (d *Dev) sealBlock(txs []*types.Transaction, override types.StateOverride) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.writeNewBlock(d.blockchain.Header(), txs, override)
+}
+
+// nextBlockTimestamp returns the timestamp of a new block, the one set by evm_setNextBlockTimestamp
+// or the clock moved by evm_increaseTime. It is always after the timestamp of the parent
+func (d *Dev) nextBlockTimestamp(parent *types.Header) uint64 {
+	now := time.Now().UTC().Unix()
+	timestamp := uint64(now + d.timeOffset)
+
+	if d.nextTimestamp != 0 {
+		// the following blocks continue from the set timestamp
+		timestamp = d.nextTimestamp
+		d.timeOffset = int64(d.nextTimestamp) - now
+		d.nextTimestamp = 0
+	}
+
+	if timestamp <= parent.Timestamp {
+		timestamp = parent.Timestamp + 1
+	}
+
+	return timestamp
+}
+
 type transitionInterface interface {
 	Write(txn *types.Transaction) error
 }
@@ -149,16 +198,16 @@ func (d *Dev) writeTransactions(gasLimit uint64, transition transitionInterface)
 	return successful
 }
 
-// writeNewBLock generates a new block based on transactions from the pool,
-// and writes them to the blockchain
-func (d *Dev) writeNewBlock(parent *types.Header) error {
+// writeNewBLock generates a new block based on the given transactions and state override,
+// or the transactions from the pool, and writes them to the blockchain
+func (d *Dev) writeNewBlock(parent *types.Header, txs []*types.Transaction, override types.StateOverride) error {
 	// Generate the base block
 	num := parent.Number
 	header := &types.Header{
 		ParentHash: parent.Hash,
 		Number:     num + 1,
 		GasLimit:   parent.GasLimit, // Inherit from parent for now, will need to adjust dynamically later.
-		Timestamp:  uint64(time.Now().UTC().Unix()),
+		Timestamp:  d.nextBlockTimestamp(parent),
 	}
 
 	// calculate gas limit based on parent header
@@ -181,7 +230,22 @@ func (d *Dev) writeNewBlock(parent *types.Header) error {
 		return err
 	}
 
-	txns := d.writeTransactions(gasLimit, transition)
+	txns := txs
+
+	if txs != nil {
+		for _, tx := range txs {
+			if err := transition.Write(tx); err != nil {
+				return err
+			}
+		}
+	} else if override == nil {
+		txns = d.writeTransactions(gasLimit, transition)
+	}
+
+	// the override is applied after the transactions, as it is when the block is verified
+	if err := transition.WithStateOverride(override); err != nil {
+		return err
+	}
 
 	// Commit the changes
 	_, root, err := transition.Commit()
@@ -201,6 +265,11 @@ func (d *Dev) writeNewBlock(parent *types.Header) error {
 		Receipts: transition.Receipts(),
 	})
 
+	if override != nil {
+		d.sealedOverride = &blockOverride{hash: block.Hash(), override: override}
+		defer func() { d.sealedOverride = nil }()
+	}
+
 	if _, err := d.blockchain.VerifyFinalizedBlock(block); err != nil {
 		return err
 	}
@@ -232,9 +301,14 @@ func (d *Dev) GetBlockCreator(header *types.Header) (types.Address, error) {
 	return types.BytesToAddress(header.Miner), nil
 }
 
-// PreCommitState a hook to be called before finalizing state transition on inserting block
-func (d *Dev) PreCommitState(_ *types.Block, _ *state.Transition) error {
-	return nil
+// PreCommitState a hook to be called before finalizing state transition on inserting block.
+// It applies the state override of the block sealed by the dev controls
+func (d *Dev) PreCommitState(block *types.Block, transition *state.Transition) error {
+	if d.sealedOverride == nil || d.sealedOverride.hash != block.Hash() {
+		return nil
+	}
+
+	return transition.WithStateOverride(d.sealedOverride.override)
 }
 
 func (d *Dev) GetSyncProgression() *progress.Progression {
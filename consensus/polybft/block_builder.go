@@ -1,19 +1,19 @@
 package polybft
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 	hcf "github.com/hashicorp/go-hclog"
+	"go.opentelemetry.io/otel/trace"
 )
 
-//nolint:godox
-// TODO: Add opentracing (to be fixed in EVM-540)
-
 // BlockBuilderParams are fields for the block that cannot be changed
 type BlockBuilderParams struct {
 	// Parent block
@@ -64,10 +64,14 @@ type BlockBuilder struct {
 
 	// state is in memory state transition
 	state *state.Transition
+
+	// ctx is the context of the span the block building is traced in
+	ctx context.Context
 }
 
-// Init initializes block builder before adding transactions and actual block building
-func (b *BlockBuilder) Reset() error {
+// Reset initializes block builder before adding transactions and actual block building.
+// The execution and the state commit are traced as children of the span of the context
+func (b *BlockBuilder) Reset(ctx context.Context) error {
 	// set the timestamp
 	parentTime := time.Unix(int64(b.params.Parent.Timestamp), 0)
 	headerTime := time.Now().UTC()
@@ -98,6 +102,7 @@ func (b *BlockBuilder) Reset() error {
 	b.state = transition
 	b.block = nil
 	b.txns = []*types.Transaction{}
+	b.ctx = ctx
 
 	return nil
 }
@@ -113,7 +118,11 @@ func (b *BlockBuilder) Build(handler func(h *types.Header)) (*types.FullBlock, e
 		handler(b.header)
 	}
 
+	_, span := tracer.Start(b.ctx, "Transition.Commit")
 	_, stateRoot, err := b.state.Commit()
+
+	span.End()
+
 	if err != nil {
 		return nil, fmt.Errorf("failed to commit the state changes: %w", err)
 	}
@@ -139,6 +148,20 @@ func (b *BlockBuilder) Build(handler func(h *types.Header)) (*types.FullBlock, e
 
 // WriteTx applies given transaction to the state. If transaction apply fails, it reverts the saved snapshot.
 func (b *BlockBuilder) WriteTx(tx *types.Transaction) error {
+	return b.writeTx(b.ctx, tx)
+}
+
+// writeTx applies the transaction in a span linked to the span of the transaction received by the node
+func (b *BlockBuilder) writeTx(ctx context.Context, tx *types.Transaction) (err error) {
+	_, span := tracer.Start(ctx, "BlockBuilder.WriteTx",
+		trace.WithAttributes(tracing.TxHashKey.String(tx.Hash.String())),
+		trace.WithLinks(tracing.TxLinks(tx)...))
+
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
 	if tx.Gas > b.params.GasLimit {
 		b.params.Logger.Info("Transaction gas limit exceedes block gas limit", "hash", tx.Hash,
 			"tx gas limit", tx.Gas, "block gas limt", b.params.GasLimit)
@@ -160,17 +183,21 @@ func (b *BlockBuilder) Fill() {
 	minBlockTimer := time.NewTimer(time.Millisecond * 200)
 	maxBlockTimer := time.NewTimer(b.params.BlockTime)
 
+	ctx, span := tracer.Start(b.ctx, "BlockBuilder.Fill")
+
 	b.params.TxPool.Prepare()
 write:
 	for {
 		select {
 		case <-maxBlockTimer.C:
+			span.End()
+
 			return
 		default:
 			tx := b.params.TxPool.Peek()
 
 			// execute transactions one by one
-			finished, err := b.writeTxPoolTransaction(tx)
+			finished, err := b.writeTxPoolTransaction(ctx, tx)
 			if err != nil {
 				b.params.Logger.Debug("Fill transaction error", "hash", tx.Hash, "err", err)
 			}
@@ -181,6 +208,9 @@ write:
 		}
 	}
 
+	// the wait for the timer isn't part of the execution
+	span.End()
+
 	//	wait for the timer to expire
 	<-minBlockTimer.C
 }
@@ -190,12 +220,12 @@ func (b *BlockBuilder) Receipts() []*types.Receipt {
 	return b.state.Receipts()
 }
 
-func (b *BlockBuilder) writeTxPoolTransaction(tx *types.Transaction) (bool, error) {
+func (b *BlockBuilder) writeTxPoolTransaction(ctx context.Context, tx *types.Transaction) (bool, error) {
 	if tx == nil {
 		return true, nil
 	}
 
-	if err := b.WriteTx(tx); err != nil {
+	if err := b.writeTx(ctx, tx); err != nil {
 		if _, ok := err.(*state.GasLimitReachedTransitionApplicationError); ok { //nolint:errorlint
 			// stop processing
 			return true, err
@@ -1,6 +1,7 @@
 package polybft
 
 import (
+	"context"
 	"math/big"
 	"testing"
 	"time"
@@ -8,9 +9,11 @@ import (
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/forkmanager"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/state"
 	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
@@ -122,7 +125,7 @@ func TestBlockBuilder_BuildBlockTxOneFailedTxAndOneTakesTooMuchGas(t *testing.T)
 		Logger:    logger,
 	})
 
-	require.NoError(t, bb.Reset())
+	require.NoError(t, bb.Reset(context.Background()))
 
 	bb.Fill()
 
@@ -158,3 +161,165 @@ func TestBlockBuilder_BuildBlockTxOneFailedTxAndOneTakesTooMuchGas(t *testing.T)
 	assert.False(t, fb.Block.Header.LogsBloom.IsLogInBloom(
 		&types.Log{Address: types.StringToAddress("111177779999")}))
 }
+
+func TestBlockBuilder_FillRespectsTxOrderingPolicy(t *testing.T) {
+	const (
+		gasLimit      = 21000
+		blockGasLimit = 1_000_000_000
+		chainID       = 100
+	)
+
+	accounts := [3]*wallet.Account{}
+	for i := range accounts {
+		accounts[i] = generateTestAccount(t)
+	}
+
+	// txs are added to the pool in this order: (sender index, nonce, gas price)
+	txsSpec := []struct {
+		sender   int
+		nonce    uint64
+		gasPrice int64
+	}{
+		{0, 0, 1_000},
+		{0, 1, 1_000},
+		{1, 0, 3_000},
+		{1, 1, 3_000},
+		{2, 0, 2_000},
+	}
+
+	testCases := []struct {
+		policy   txpool.TxOrderingPolicy
+		expected []int // indexes of txsSpec in expected block order
+	}{
+		{txpool.PriceOrdering, []int{2, 3, 4, 0, 1}},
+		{txpool.FIFOOrdering, []int{0, 1, 2, 3, 4}},
+		{txpool.FairOrdering, []int{2, 4, 0, 3, 1}},
+		{txpool.PriorityOrdering, []int{0, 1, 2, 3, 4}},
+	}
+
+	fm := forkmanager.GetInstance()
+	t.Cleanup(fm.Clear)
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(string(tc.policy), func(t *testing.T) {
+			policy := string(tc.policy)
+
+			fm.Clear()
+			fm.RegisterFork(forkmanager.InitialFork, &forkmanager.ForkParams{TxOrderingPolicy: &policy})
+			require.NoError(t, fm.ActivateFork(forkmanager.InitialFork, 0))
+
+			forks := &chain.Forks{}
+			logger := hclog.NewNullLogger()
+			signer := crypto.NewSigner(forks.At(0), chainID)
+
+			mstate := itrie.NewState(itrie.NewMemoryStorage())
+			executor := state.NewExecutor(&chain.Params{ChainID: chainID, Forks: forks}, mstate, logger)
+			executor.GetHash = func(header *types.Header) func(i uint64) types.Hash {
+				return func(i uint64) types.Hash {
+					return types.BytesToHash(common.EncodeUint64ToBytes(i))
+				}
+			}
+
+			balanceMap := map[types.Address]*chain.GenesisAccount{}
+			for _, acc := range accounts {
+				balanceMap[types.Address(acc.Ecdsa.Address())] = &chain.GenesisAccount{Balance: ethgo.Ether(1)}
+			}
+
+			hash, err := executor.WriteGenesis(balanceMap, types.ZeroHash)
+			require.NoError(t, err)
+
+			parentHeader := &types.Header{StateRoot: hash, GasLimit: blockGasLimit}
+
+			pool, err := txpool.NewTxPool(
+				logger,
+				forks,
+				&txPoolStoreMock{header: parentHeader},
+				nil,
+				nil,
+				&txpool.Config{
+					PriceLimit:         1,
+					MaxSlots:           4096,
+					MaxAccountEnqueued: 128,
+					ChainID:            big.NewInt(chainID),
+					PriorityAddresses:  []types.Address{types.Address(accounts[0].Ecdsa.Address())},
+				},
+			)
+			require.NoError(t, err)
+
+			pool.SetSigner(signer)
+			pool.Start()
+			t.Cleanup(pool.Close)
+
+			txs := make([]*types.Transaction, len(txsSpec))
+
+			for i, spec := range txsSpec {
+				receiver := types.ZeroAddress
+				privateKey, err := accounts[spec.sender].GetEcdsaPrivateKey()
+				require.NoError(t, err)
+
+				txs[i], err = signer.SignTx(&types.Transaction{
+					Value:    big.NewInt(1),
+					GasPrice: big.NewInt(spec.gasPrice),
+					Gas:      gasLimit,
+					Nonce:    spec.nonce,
+					To:       &receiver,
+				}, privateKey)
+				require.NoError(t, err)
+
+				require.NoError(t, pool.AddTx(context.Background(), txs[i]))
+
+				// wait for the tx to get promoted, so the arrival order is preserved
+				require.Eventually(t, func() bool {
+					return pool.Length() == uint64(i+1)
+				}, time.Second, time.Millisecond)
+			}
+
+			bb := NewBlockBuilder(&BlockBuilderParams{
+				BlockTime: time.Millisecond * 100,
+				Parent:    parentHeader,
+				Coinbase:  types.ZeroAddress,
+				Executor:  executor,
+				GasLimit:  blockGasLimit,
+				TxPool:    pool,
+				Logger:    logger,
+			})
+
+			require.NoError(t, bb.Reset(context.Background()))
+
+			bb.Fill()
+
+			require.Len(t, bb.txns, len(tc.expected))
+
+			for i, txIndex := range tc.expected {
+				assert.Equal(t, txs[txIndex].Hash, bb.txns[i].Hash, "unexpected tx at position %d", i)
+			}
+		})
+	}
+}
+
+// txPoolStoreMock is a minimal txpool store backed by a fixed header
+type txPoolStoreMock struct {
+	header *types.Header
+}
+
+func (m *txPoolStoreMock) Header() *types.Header {
+	return m.header
+}
+
+func (m *txPoolStoreMock) GetNonce(types.Hash, types.Address) uint64 {
+	return 0
+}
+
+func (m *txPoolStoreMock) GetBalance(types.Hash, types.Address) (*big.Int, error) {
+	return ethgo.Ether(1), nil
+}
+
+func (m *txPoolStoreMock) GetBlockByHash(types.Hash, bool) (*types.Block, bool) {
+	return nil, false
+}
+
+func (m *txPoolStoreMock) CalculateBaseFee(*types.Header) uint64 {
+	return 0
+}
@@ -1,6 +1,7 @@
 package polybft
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -11,10 +12,12 @@ import (
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/consensus"
 	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/umbracle/ethgo"
 	"github.com/umbracle/ethgo/contract"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -38,7 +41,8 @@ type BlockchainBackend interface {
 		txPool txPoolInterface, blockTime time.Duration, logger hclog.Logger) (blockBuilder, error)
 
 	// ProcessBlock builds a final block from given 'block' on top of 'parent'.
-	ProcessBlock(parent *types.Header, block *types.Block) (*types.FullBlock, error)
+	// The execution and the state commit are traced as children of the span of the context
+	ProcessBlock(ctx context.Context, parent *types.Header, block *types.Block) (*types.FullBlock, error)
 
 	// GetStateProviderForBlock returns a reference to make queries to the state at 'block'.
 	GetStateProviderForBlock(block *types.Header) (contract.Provider, error)
@@ -101,6 +105,7 @@ func (p *blockchainWrapper) CommitBlock(block *types.FullBlock) error {
 
 // ProcessBlock builds a final block from given 'block' on top of 'parent'
 func (p *blockchainWrapper) ProcessBlock(
+	ctx context.Context,
 	parent *types.Header,
 	block *types.Block,
 ) (*types.FullBlock, error) {
@@ -117,13 +122,15 @@ func (p *blockchainWrapper) ProcessBlock(
 	}
 
 	// apply transactions from block
-	for _, tx := range block.Transactions {
-		if err = transition.Write(tx); err != nil {
-			return nil, fmt.Errorf("process block tx error, tx = %v, err = %w", tx.Hash, err)
-		}
+	if err := writeTxs(ctx, transition, block.Transactions); err != nil {
+		return nil, err
 	}
 
+	_, span := tracer.Start(ctx, "Transition.Commit")
 	_, root, err := transition.Commit()
+
+	span.End()
+
 	if err != nil {
 		return nil, fmt.Errorf("failed to commit the state changes: %w", err)
 	}
@@ -152,6 +159,23 @@ func (p *blockchainWrapper) ProcessBlock(
 	}, nil
 }
 
+// writeTxs applies the transactions of a block to the state
+func writeTxs(ctx context.Context, transition *state.Transition, txs []*types.Transaction) error {
+	_, span := tracer.Start(ctx, "Transition.WriteTxs", trace.WithLinks(tracing.TxLinks(txs...)...))
+	defer span.End()
+
+	for _, tx := range txs {
+		if err := transition.Write(tx); err != nil {
+			err = fmt.Errorf("process block tx error, tx = %v, err = %w", tx.Hash, err)
+			tracing.RecordError(span, err)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GetStateProviderForBlock is an implementation of blockchainBackend interface
 func (p *blockchainWrapper) GetStateProviderForBlock(
 	header *types.Header,
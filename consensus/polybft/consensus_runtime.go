@@ -12,12 +12,14 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/consensus"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
-	"github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/types"
 	bolt "go.etcd.io/bbolt"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/Hydra-Chain/go-ibft/messages"
 	"github.com/Hydra-Chain/go-ibft/messages/proto"
@@ -82,6 +84,8 @@ type runtimeConfig struct {
 	txPool                txPoolInterface
 	numBlockConfirmations uint64
 	consensusConfig       *consensus.Config
+	// validatorSigners loads the signers Key can switch to once the registered BLS key changes
+	validatorSigners func() ([]wallet.Signer, error)
 }
 
 // consensusRuntime is a struct that provides consensus runtime features like epoch, state and event management
@@ -107,6 +111,9 @@ type consensusRuntime struct {
 	// activeValidatorFlag indicates whether the given node is amongst currently active validator set
 	activeValidatorFlag atomic.Bool
 
+	// currentView is the view of the last round started by the consensus
+	currentView atomic.Pointer[proto.View]
+
 	// checkpointManager represents abstraction for checkpoint submission
 	checkpointManager CheckpointManager
 
@@ -522,6 +529,46 @@ func (c *consensusRuntime) FSM() error {
 	return nil
 }
 
+// switchToRegisteredBLSKey makes the node sign with the BLS key registered in the given validator set.
+// The registered key changes at the first block of the epoch following a BLS key rotation
+func (c *consensusRuntime) switchToRegisteredBLSKey(validators validator.AccountSet) error {
+	if c.config.validatorSigners == nil {
+		return nil
+	}
+
+	registered := validators.GetValidatorMetadata(types.Address(c.config.Key.Address()))
+	if registered == nil || registered.BlsKey == nil {
+		return nil
+	}
+
+	registeredKey := registered.BlsKey.Marshal()
+	if bytes.Equal(registeredKey, c.config.Key.BLSPublicKey().Marshal()) {
+		return nil
+	}
+
+	signers, err := c.config.validatorSigners()
+	if err != nil {
+		return err
+	}
+
+	for _, s := range signers {
+		if !bytes.Equal(registeredKey, s.BLSPublicKey().Marshal()) {
+			continue
+		}
+
+		if err := c.config.Key.SwapSigner(s); err != nil {
+			return err
+		}
+
+		c.logger.Info("Switched to the registered BLS key", "key", hex.EncodeToString(registeredKey))
+
+		return nil
+	}
+
+	return fmt.Errorf("none of the validator BLS keys matches the registered key %s",
+		hex.EncodeToString(registeredKey))
+}
+
 // restartEpoch resets the previously run epoch and moves to the next one
 // returns *epochMetadata different from nil if the lastEpoch is not the current one and everything was successful
 func (c *consensusRuntime) restartEpoch(
@@ -556,6 +603,10 @@ func (c *consensusRuntime) restartEpoch(
 		return nil, fmt.Errorf("restart epoch - cannot get validators: %w", err)
 	}
 
+	if err := c.switchToRegisteredBLSKey(validatorSet); err != nil {
+		c.logger.Error("Could not switch to the registered BLS key", "epoch", epochNumber, "error", err)
+	}
+
 	updateEpochMetrics(epochMetadata{
 		Number:     epochNumber,
 		Validators: validatorSet,
@@ -601,6 +652,43 @@ func (c *consensusRuntime) restartEpoch(
 	}, nil
 }
 
+// rewind rebuilds the in-memory runtime data (epoch, proposer snapshot and the last built block)
+// after the canonical chain and the consensus state were rewound to the given header
+func (c *consensusRuntime) rewind(header *types.Header) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	dbTx, err := c.state.beginDBTransaction(true)
+	if err != nil {
+		return fmt.Errorf("could not begin dbTx to rewind consensus runtime: %w", err)
+	}
+
+	defer dbTx.Rollback() //nolint:errcheck
+
+	proposerCalculator, err := NewProposerCalculator(c.config, c.proposerCalculator.logger, dbTx)
+	if err != nil {
+		return fmt.Errorf("failed to rebuild proposer calculator: %w", err)
+	}
+
+	// current epoch might be the same as the one of the new head, force its restart
+	c.epoch = nil
+
+	epoch, err := c.restartEpoch(header, dbTx)
+	if err != nil {
+		return fmt.Errorf("rewind - restart epoch failed: %w", err)
+	}
+
+	if err := dbTx.Commit(); err != nil {
+		return fmt.Errorf("could not commit db tx to rewind consensus runtime: %w", err)
+	}
+
+	c.proposerCalculator = proposerCalculator
+	c.epoch = epoch
+	c.lastBuiltBlock = header.Copy()
+
+	return nil
+}
+
 // calculateStateTxsInput calculates the state txs input data for blocks starting from the last built block
 // in the current epoch, and ending at the last block of previous epoch
 func (c *consensusRuntime) calculateStateTxsInput(
@@ -930,9 +1018,25 @@ func (c *consensusRuntime) InsertProposal(
 
 // StartRound starts a new round with the given view
 func (c *consensusRuntime) StartRound(view *proto.View) error {
+	c.currentView.Store(view)
+
+	// the rounds are marked on the span of the sequence, the round changes are the consensus time of a slow block
+	trace.SpanFromContext(tracing.BlockContext(view.Height)).AddEvent("IBFT.round",
+		trace.WithAttributes(tracing.RoundKey.Int64(int64(view.Round))))
+
 	return nil
 }
 
+// currentRound returns the height and the round of the last round started by the consensus
+func (c *consensusRuntime) currentRound() (uint64, uint64) {
+	view := c.currentView.Load()
+	if view == nil {
+		return 0, 0
+	}
+
+	return view.Height, view.Round
+}
+
 // ID return ID (address actually) of the current node
 func (c *consensusRuntime) ID() []byte {
 	return c.config.Key.Address().Bytes()
@@ -1055,7 +1159,7 @@ func (c *consensusRuntime) BuildCommitMessage(
 	proposalHash []byte,
 	view *proto.View,
 ) *proto.Message {
-	committedSeal, err := c.config.Key.SignWithDomain(proposalHash, signer.DomainCheckpointManager)
+	committedSeal, err := c.config.Key.SignCommittedSeal(proposalHash, view)
 	if err != nil {
 		c.logger.Error("Cannot create committed seal message.", "error", err)
 
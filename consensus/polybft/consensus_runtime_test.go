@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/bls"
 	"github.com/0xPolygon/polygon-edge/consensus"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/bitmap"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
@@ -667,6 +668,46 @@ func TestConsensusRuntime_restartEpoch_SameEpochNumberAsTheLastOne(t *testing.T)
 	blockchainMock.AssertExpectations(t)
 }
 
+func TestConsensusRuntime_switchToRegisteredBLSKey(t *testing.T) {
+	t.Parallel()
+
+	validators := validator.NewTestValidatorsWithAliases(t, []string{"A", "B"})
+	account := validators.GetValidator("A").Account
+
+	rotatedBlsKey, err := bls.GenerateBlsKey()
+	require.NoError(t, err)
+
+	rotatedSigner := wallet.NewLocalSigner(&wallet.Account{Ecdsa: account.Ecdsa, Bls: rotatedBlsKey})
+	key := wallet.NewKey(account)
+	runtime := &consensusRuntime{
+		logger: hclog.NewNullLogger(),
+		config: &runtimeConfig{
+			Key: key,
+			validatorSigners: func() ([]wallet.Signer, error) {
+				return []wallet.Signer{rotatedSigner, wallet.NewLocalSigner(account)}, nil
+			},
+		},
+	}
+
+	// the registered key is the one in use
+	validatorSet := validators.GetPublicIdentities()
+	require.NoError(t, runtime.switchToRegisteredBLSKey(validatorSet))
+	require.Equal(t, account.Bls.PublicKey().Marshal(), key.BLSPublicKey().Marshal())
+
+	// the rotated key has been registered
+	validatorSet.GetValidatorMetadata(account.Address()).BlsKey = rotatedBlsKey.PublicKey()
+	require.NoError(t, runtime.switchToRegisteredBLSKey(validatorSet))
+	require.Equal(t, rotatedBlsKey.PublicKey().Marshal(), key.BLSPublicKey().Marshal())
+
+	// none of the keys is registered
+	unknownBlsKey, err := bls.GenerateBlsKey()
+	require.NoError(t, err)
+
+	validatorSet.GetValidatorMetadata(account.Address()).BlsKey = unknownBlsKey.PublicKey()
+	require.Error(t, runtime.switchToRegisteredBLSKey(validatorSet))
+	require.Equal(t, rotatedBlsKey.PublicKey().Marshal(), key.BLSPublicKey().Marshal())
+}
+
 func TestConsensusRuntime_calculateRewardWalletFundTxValue(t *testing.T) {
 	t.Parallel()
 
@@ -1063,6 +1104,22 @@ func TestConsensusRuntime_ID(t *testing.T) {
 	require.NotEqual(t, runtime.ID(), key2.Address().Bytes())
 }
 
+func TestConsensusRuntime_CurrentRound(t *testing.T) {
+	t.Parallel()
+
+	runtime := &consensusRuntime{}
+
+	height, round := runtime.currentRound()
+	require.Zero(t, height)
+	require.Zero(t, round)
+
+	require.NoError(t, runtime.StartRound(&proto.View{Height: 10, Round: 2}))
+
+	height, round = runtime.currentRound()
+	require.Equal(t, uint64(10), height)
+	require.Equal(t, uint64(2), round)
+}
+
 func TestConsensusRuntime_GetVotingPowers(t *testing.T) {
 	t.Parallel()
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
 )
 
 type method interface {
@@ -76,3 +77,23 @@ func TestEncoding_Struct(t *testing.T) {
 	require.Equal(t, commitment.EndID, commitmentDecoded.EndID)
 	require.Equal(t, commitment.Root, commitmentDecoded.Root)
 }
+
+func TestBlsKeyRotation_Unsupported(t *testing.T) {
+	t.Parallel()
+
+	// the embedded HydraChain artifact predates the BLS key rotation
+	require.False(t, BlsKeyRotationSupported())
+
+	rotateFn := &RotateBlsKeyHydraChainFn{}
+
+	_, err := rotateFn.EncodeAbi()
+	require.ErrorIs(t, err, ErrBlsKeyRotationUnsupported)
+	require.ErrorIs(t, rotateFn.DecodeAbi([]byte{0x1}), ErrBlsKeyRotationUnsupported)
+
+	event := &BlsKeyUpdatedEvent{}
+	require.Equal(t, ethgo.ZeroHash, event.Sig())
+
+	doesMatch, err := event.ParseLog(&ethgo.Log{Topics: []ethgo.Hash{{0x1}}})
+	require.NoError(t, err)
+	require.False(t, doesMatch)
+}
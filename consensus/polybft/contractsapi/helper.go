@@ -1,6 +1,10 @@
 package contractsapi
 
 import (
+	"errors"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/umbracle/ethgo"
 	"github.com/umbracle/ethgo/abi"
 )
@@ -32,6 +36,75 @@ var (
 	GetCheckpointBlockABIResponse = abi.MustNewType("tuple(bool isFound, uint256 checkpointBlock)")
 )
 
+// ErrBlsKeyRotationUnsupported is returned when the embedded HydraChain artifact has no BLS key rotation
+var ErrBlsKeyRotationUnsupported = errors.New(
+	"the HydraChain contract has no rotateBlsKey method and BlsKeyUpdated event")
+
+// BlsKeyRotationSupported checks if the embedded HydraChain artifact has the BLS key rotation.
+// The rotation bindings below are unusable until the artifacts are regenerated
+// from a HydraChain version which has the rotateBlsKey method and the BlsKeyUpdated event
+func BlsKeyRotationSupported() bool {
+	return HydraChain.Abi.Methods["rotateBlsKey"] != nil && HydraChain.Abi.Events["BlsKeyUpdated"] != nil
+}
+
+type RotateBlsKeyHydraChainFn struct {
+	Signature [2]*big.Int `abi:"signature"`
+	Pubkey    [4]*big.Int `abi:"pubkey"`
+}
+
+func (r *RotateBlsKeyHydraChainFn) Sig() []byte {
+	if !BlsKeyRotationSupported() {
+		return nil
+	}
+
+	return HydraChain.Abi.Methods["rotateBlsKey"].ID()
+}
+
+func (r *RotateBlsKeyHydraChainFn) EncodeAbi() ([]byte, error) {
+	if !BlsKeyRotationSupported() {
+		return nil, ErrBlsKeyRotationUnsupported
+	}
+
+	return HydraChain.Abi.Methods["rotateBlsKey"].Encode(r)
+}
+
+func (r *RotateBlsKeyHydraChainFn) DecodeAbi(buf []byte) error {
+	if !BlsKeyRotationSupported() {
+		return ErrBlsKeyRotationUnsupported
+	}
+
+	return decodeMethod(HydraChain.Abi.Methods["rotateBlsKey"], buf, r)
+}
+
+type BlsKeyUpdatedEvent struct {
+	Validator types.Address `abi:"validator"`
+	BlsKey    [4]*big.Int   `abi:"blsKey"`
+}
+
+func (*BlsKeyUpdatedEvent) Sig() ethgo.Hash {
+	if !BlsKeyRotationSupported() {
+		return ethgo.ZeroHash
+	}
+
+	return HydraChain.Abi.Events["BlsKeyUpdated"].ID()
+}
+
+func (b *BlsKeyUpdatedEvent) Encode() ([]byte, error) {
+	if !BlsKeyRotationSupported() {
+		return nil, ErrBlsKeyRotationUnsupported
+	}
+
+	return HydraChain.Abi.Events["BlsKeyUpdated"].Inputs.Encode(b)
+}
+
+func (b *BlsKeyUpdatedEvent) ParseLog(log *ethgo.Log) (bool, error) {
+	if !BlsKeyRotationSupported() || !HydraChain.Abi.Events["BlsKeyUpdated"].Match(log) {
+		return false, nil
+	}
+
+	return true, decodeEvent(HydraChain.Abi.Events["BlsKeyUpdated"], log, b)
+}
+
 // ToABI converts StateSyncEvent to ABI
 func (sse *StateSyncedEvent) EncodeAbi() ([]byte, error) {
 	return stateSyncABIType.Encode([]interface{}{sse})
@@ -2,6 +2,7 @@ package polybft
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -14,16 +15,19 @@ import (
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/contracts"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/Hydra-Chain/go-ibft/messages"
 	"github.com/Hydra-Chain/go-ibft/messages/proto"
 	"github.com/armon/go-metrics"
 	hcf "github.com/hashicorp/go-hclog"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type blockBuilder interface {
-	Reset() error
+	// Reset initializes the builder, the execution of the block is traced as a child of the span of the context
+	Reset(ctx context.Context) error
 	WriteTx(*types.Transaction) error
 	Fill()
 	Build(func(h *types.Header)) (*types.FullBlock, error)
@@ -173,6 +177,17 @@ func (f *fsm) BuildProposal(currentRound uint64) ([]byte, error) {
 	defer metrics.SetGauge([]string{consensusMetricsPrefix, "block_building_time"},
 		float32(time.Now().UTC().Sub(start).Seconds()))
 
+	ctx, span := tracer.Start(tracing.BlockContext(f.Height()), "IBFT.buildProposal",
+		trace.WithAttributes(tracing.RoundKey.Int64(int64(currentRound))))
+	defer span.End()
+
+	proposal, err := f.buildProposal(ctx, currentRound)
+	tracing.RecordError(span, err)
+
+	return proposal, err
+}
+
+func (f *fsm) buildProposal(ctx context.Context, currentRound uint64) ([]byte, error) {
 	parent := f.parent
 
 	extraParent, err := GetIbftExtra(parent.ExtraData)
@@ -184,7 +199,7 @@ func (f *fsm) BuildProposal(currentRound uint64) ([]byte, error) {
 	// for non-epoch ending blocks, currentValidatorsHash is the same as the nextValidatorsHash
 	nextValidators := f.validators.Accounts()
 
-	if err := f.blockBuilder.Reset(); err != nil {
+	if err := f.blockBuilder.Reset(ctx); err != nil {
 		return nil, fmt.Errorf("failed to initialize block builder: %w", err)
 	}
 
@@ -461,6 +476,16 @@ func (f *fsm) ValidateCommit(signerAddr []byte, seal []byte, proposalHash []byte
 
 // Validate validates a raw proposal (used if non-proposer)
 func (f *fsm) Validate(proposal []byte) error {
+	ctx, span := tracer.Start(tracing.BlockContext(f.Height()), "IBFT.validateProposal")
+	defer span.End()
+
+	err := f.validate(ctx, proposal)
+	tracing.RecordError(span, err)
+
+	return err
+}
+
+func (f *fsm) validate(ctx context.Context, proposal []byte) error {
 	var block types.Block
 	if err := block.UnmarshalRLP(proposal); err != nil {
 		return fmt.Errorf("failed to validate, cannot decode block data. Error: %w", err)
@@ -539,7 +564,7 @@ func (f *fsm) Validate(proposal []byte) error {
 		f.logger.Trace("[FSM Validate]", "Block", block.Number(), "parent validators", validators)
 	}
 
-	stateBlock, err := f.backend.ProcessBlock(f.parent, &block)
+	stateBlock, err := f.backend.ProcessBlock(ctx, f.parent, &block)
 	if err != nil {
 		return err
 	}
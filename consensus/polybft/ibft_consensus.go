@@ -4,8 +4,13 @@ import (
 	"context"
 
 	"github.com/Hydra-Chain/go-ibft/core"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 )
 
+var tracer = tracing.Tracer("consensus/polybft")
+
 // IBFTConsensusWrapper is a convenience wrapper for the go-ibft package
 type IBFTConsensusWrapper struct {
 	*core.IBFT
@@ -29,8 +34,13 @@ func (c *IBFTConsensusWrapper) runSequence(height uint64) (<-chan struct{}, func
 	sequenceDone := make(chan struct{})
 	ctx, cancelSequence := context.WithCancel(context.Background())
 
+	// the spans of the height (proposal building and validation, block insertion) are children of the sequence
+	ctx, span := tracer.Start(ctx, "IBFT.sequence", trace.WithAttributes(tracing.BlockNumberKey.Int64(int64(height))))
+	tracing.SetBlockContext(ctx, height)
+
 	go func() {
 		c.IBFT.RunSequence(ctx, height)
+		span.End()
 		cancelSequence()
 		close(sequenceDone)
 	}()
@@ -1,6 +1,7 @@
 package polybft
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"time"
@@ -44,7 +45,9 @@ func (m *blockchainMock) NewBlockBuilder(parent *types.Header, coinbase types.Ad
 	return args.Get(0).(blockBuilder), args.Error(1) //nolint:forcetypeassert
 }
 
-func (m *blockchainMock) ProcessBlock(parent *types.Header, block *types.Block) (*types.FullBlock, error) {
+func (m *blockchainMock) ProcessBlock(
+	_ context.Context, parent *types.Header, block *types.Block,
+) (*types.FullBlock, error) {
 	args := m.Called(parent, block)
 
 	return args.Get(0).(*types.FullBlock), args.Error(1) //nolint:forcetypeassert
@@ -187,7 +190,7 @@ type blockBuilderMock struct {
 	mock.Mock
 }
 
-func (m *blockBuilderMock) Reset() error {
+func (m *blockBuilderMock) Reset(_ context.Context) error {
 	args := m.Called()
 	if len(args) == 0 {
 		return nil
@@ -13,17 +13,22 @@ import (
 	"github.com/hashicorp/go-hclog"
 	bolt "go.etcd.io/bbolt"
 
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/bls"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bitmap"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet/remote"
 	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/forkmanager"
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/syncer"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -460,14 +465,13 @@ func ForkManagerFactory(forks *chain.Forks) error {
 func (p *Polybft) Initialize() error {
 	p.logger.Info("initializing polybft...")
 
-	// read account
-	account, err := wallet.NewAccountFromSecret(p.config.SecretsManager)
+	// set key
+	key, err := p.newValidatorKey()
 	if err != nil {
-		return fmt.Errorf("failed to read account data. Error: %w", err)
+		return err
 	}
 
-	// set key
-	p.key = wallet.NewKey(account)
+	p.key = key
 
 	// create and set syncer
 	p.syncer = syncer.NewSyncer(
@@ -583,6 +587,7 @@ func (p *Polybft) initRuntime() error {
 		txPool:                p.txPool,
 		numBlockConfirmations: p.config.NumBlockConfirmations,
 		consensusConfig:       p.config.Config,
+		validatorSigners:      p.validatorSigners,
 	}
 
 	runtime, err := newConsensusRuntime(p.logger, runtimeConfig)
@@ -625,7 +630,8 @@ func (p *Polybft) startConsensusProtocol() {
 			case ev := <-eventCh:
 				// The blockchain notification system can eventually deliver
 				// stale block notifications. These should be ignored
-				if ev.Source == "syncer" &&
+				// Rewinding the head (reorg) cancels the running sequence as well
+				if (ev.Source == "syncer" || ev.Type == blockchain.EventReorg) &&
 					ev.NewChain[0].Number >= p.blockchain.CurrentHeader().Number {
 					p.logger.Info(
 						"sync block notification received",
@@ -738,6 +744,28 @@ func (p *Polybft) Close() error {
 	return nil
 }
 
+// RewindHead rolls back the consensus state and the runtime data to the given header,
+// which has just become the new head of the canonical chain (see blockchain.HeadRewinder)
+func (p *Polybft) RewindHead(header *types.Header) error {
+	epoch, err := getHeaderEpoch(header)
+	if err != nil {
+		return err
+	}
+
+	if err := p.state.rewind(header.Number, epoch); err != nil {
+		return fmt.Errorf("failed to rewind consensus state: %w", err)
+	}
+
+	p.validatorsCache.rewind(header.Number)
+
+	if p.runtime == nil {
+		// runtime is not started yet, it is initialized from the rewound state
+		return nil
+	}
+
+	return p.runtime.rewind(header)
+}
+
 // GetSyncProgression retrieves the current sync progression, if any
 func (p *Polybft) GetSyncProgression() *progress.Progression {
 	return p.syncer.GetSyncProgression()
@@ -803,6 +831,140 @@ func (p *Polybft) GetValidators(
 	return p.validatorsCache.GetSnapshot(blockNumber, parents, nil)
 }
 
+// newValidatorKey creates the validator key backed by the remote signer if it is configured,
+// or by the keys stored in the secrets manager otherwise
+func (p *Polybft) newValidatorKey() (*wallet.Key, error) {
+	validatorSigner, err := p.newValidatorSigner()
+	if err != nil {
+		return nil, err
+	}
+
+	return wallet.NewKeyFromSigner(validatorSigner), nil
+}
+
+func (p *Polybft) newValidatorSigner() (wallet.Signer, error) {
+	if p.config.RemoteSigner != nil {
+		remoteSigner, err := remote.NewSigner(p.config.RemoteSigner)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to the remote signer. Error: %w", err)
+		}
+
+		return remoteSigner, nil
+	}
+
+	// read account
+	account, err := wallet.NewAccountFromSecret(p.config.SecretsManager)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read account data. Error: %w", err)
+	}
+
+	return wallet.NewLocalSigner(account), nil
+}
+
+// validatorSigners returns the signers the validator key can switch to: the configured one and,
+// around a BLS key rotation, the ones holding the replaced key and the key of an unconfirmed rotation
+func (p *Polybft) validatorSigners() ([]wallet.Signer, error) {
+	validatorSigner, err := p.newValidatorSigner()
+	if err != nil {
+		return nil, err
+	}
+
+	signers := []wallet.Signer{validatorSigner}
+
+	if p.config.RemoteSigner != nil {
+		return signers, nil
+	}
+
+	rotationKeys := []struct {
+		name   string
+		getKey func(secrets.SecretsManager) (*bls.PrivateKey, error)
+	}{
+		{secrets.ValidatorBLSKeyPrevious, wallet.GetPreviousBlsFromSecret},
+		{secrets.ValidatorBLSKeyPending, wallet.GetPendingBlsFromSecret},
+	}
+
+	for _, rotationKey := range rotationKeys {
+		if !p.config.SecretsManager.HasSecret(rotationKey.name) {
+			continue
+		}
+
+		ecdsaKey, err := wallet.GetEcdsaFromSecret(p.config.SecretsManager)
+		if err != nil {
+			return nil, err
+		}
+
+		blsKey, err := rotationKey.getKey(p.config.SecretsManager)
+		if err != nil {
+			return nil, err
+		}
+
+		signers = append(signers, wallet.NewLocalSigner(&wallet.Account{Ecdsa: ecdsaKey, Bls: blsKey}))
+	}
+
+	return signers, nil
+}
+
+// ValidatorKey returns the key the validator signs with
+func (p *Polybft) ValidatorKey() *wallet.Key {
+	return p.key
+}
+
+// ValidatorStatus returns the address of the validator, whether it is in the validator set of the next block
+// and the number of the latest of the last blocks whose committed seals it signed, zero if it signed none of them
+func (p *Polybft) ValidatorStatus(blocks uint64) (types.Address, bool, uint64, error) {
+	if p.key == nil {
+		return types.ZeroAddress, false, 0, errors.New("validator key is not initialized")
+	}
+
+	address := types.Address(p.key.Address())
+	head := p.blockchain.CurrentHeader()
+
+	validators, err := p.GetValidators(head.Number, nil)
+	if err != nil {
+		return address, false, 0, fmt.Errorf("failed to get validators of block %d: %w", head.Number+1, err)
+	}
+
+	active := validators.ContainsAddress(address)
+
+	for number := head.Number; number > 0 && head.Number-number < blocks; number-- {
+		header, ok := p.blockchain.GetHeaderByNumber(number)
+		if !ok {
+			break
+		}
+
+		extra, err := GetIbftExtra(header.ExtraData)
+		if err != nil {
+			return address, active, 0, fmt.Errorf("failed to decode extra of block %d: %w", number, err)
+		}
+
+		if extra.Committed == nil {
+			continue
+		}
+
+		// the committed seals of a block are signed by the validators of its parent
+		signers, err := p.GetValidators(number-1, nil)
+		if err != nil {
+			return address, active, 0, fmt.Errorf("failed to get validators of block %d: %w", number, err)
+		}
+
+		seals := bitmap.Bitmap(extra.Committed.Bitmap)
+		if index := signers.Index(address); index >= 0 && seals.IsSet(uint64(index)) {
+			return address, active, number, nil
+		}
+	}
+
+	return address, active, 0, nil
+}
+
+// CurrentRound returns the height and the round the consensus is running, zeros if it isn't running
+func (p *Polybft) CurrentRound() (uint64, uint64) {
+	if p.runtime == nil {
+		return 0, 0
+	}
+
+	return p.runtime.currentRound()
+}
+
 func (p *Polybft) GetValidatorsWithTx(blockNumber uint64, parents []*types.Header,
 	dbTx *bolt.Tx) (validator.AccountSet, error) {
 	return p.validatorsCache.GetSnapshot(blockNumber, parents, dbTx)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/bitmap"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
@@ -287,6 +288,88 @@ func TestPolybft_GetSyncProgression(t *testing.T) {
 	assert.Equal(t, result, polybft.GetSyncProgression())
 }
 
+func TestPolybft_ValidatorStatus(t *testing.T) {
+	t.Parallel()
+
+	aliases := []string{"A", "B", "C", "D"}
+	validators := validator.NewTestValidatorsWithAliases(t, aliases)
+	validatorSet := validators.GetPublicIdentities(aliases...)
+
+	genesisDelta, err := validator.CreateValidatorSetDelta(nil, validatorSet)
+	require.NoError(t, err)
+
+	headersMap := &testHeadersMap{}
+	headersMap.addHeader(&types.Header{
+		Number:    0,
+		ExtraData: (&Extra{Validators: genesisDelta, Checkpoint: &CheckpointData{}}).MarshalRLPTo(nil),
+	})
+
+	// the validator A signs the blocks 1 to 3 and stops signing at block 4
+	for i := uint64(1); i <= 15; i++ {
+		var seals bitmap.Bitmap
+
+		seals.Set(1)
+		seals.Set(2)
+		seals.Set(3)
+
+		if i <= 3 {
+			seals.Set(0)
+		}
+
+		headersMap.addHeader(&types.Header{
+			Number: i,
+			ExtraData: (&Extra{
+				Validators: &validator.ValidatorSetDelta{},
+				Checkpoint: &CheckpointData{EpochNumber: 1},
+				Committed:  &Signature{Bitmap: seals},
+			}).MarshalRLPTo(nil),
+		})
+	}
+
+	blockchainMock := new(blockchainMock)
+	blockchainMock.On("GetHeaderByNumber", mock.Anything).Return(headersMap.getHeader)
+
+	polybft := &Polybft{
+		logger:     hclog.NewNullLogger(),
+		blockchain: blockchainMock,
+		validatorsCache: newValidatorsSnapshotCache(
+			hclog.NewNullLogger(),
+			newTestState(t),
+			blockchainMock,
+		),
+	}
+
+	require.NoError(t, polybft.validatorsCache.storeSnapshot(&validatorSnapshot{Epoch: 0, Snapshot: validatorSet}, nil))
+	require.NoError(t, polybft.validatorsCache.storeSnapshot(&validatorSnapshot{Epoch: 1, Snapshot: validatorSet}, nil))
+
+	status := func(alias string, head uint64) (bool, uint64) {
+		polybft.key = validators.GetValidator(alias).Key()
+
+		blockchainMock.On("CurrentHeader").Return(headersMap.getHeader(head)).Once()
+
+		address, active, lastSigned, err := polybft.ValidatorStatus(10)
+		require.NoError(t, err)
+		require.Equal(t, validators.GetValidator(alias).Address(), address)
+
+		return active, lastSigned
+	}
+
+	active, lastSigned := status("A", 5)
+	require.True(t, active)
+	require.Equal(t, uint64(3), lastSigned)
+
+	// the blocks 3 to 12 are checked
+	_, lastSigned = status("A", 12)
+	require.Equal(t, uint64(3), lastSigned)
+
+	// the blocks 4 to 13 are checked
+	_, lastSigned = status("A", 13)
+	require.Zero(t, lastSigned)
+
+	_, lastSigned = status("B", 13)
+	require.Equal(t, uint64(13), lastSigned)
+}
+
 func Test_Factory(t *testing.T) {
 	t.Parallel()
 
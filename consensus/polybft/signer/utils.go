@@ -37,6 +37,16 @@ var (
 // MakeKOSKSignature creates KOSK signature which prevents rogue attack
 func MakeKOSKSignature(
 	privateKey *bls.PrivateKey, address types.Address, chainID int64, domain []byte) (*bls.Signature, error) {
+	message, err := KOSKMessage(address, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return privateKey.Sign(message, domain)
+}
+
+// KOSKMessage returns the message signed by the KOSK signature of the given address
+func KOSKMessage(address types.Address, chainID int64) ([]byte, error) {
 	message, err := abi.Encode(
 		[]interface{}{address, big.NewInt(chainID)},
 		abi.MustNewType("tuple(address, uint256)"))
@@ -45,5 +55,5 @@ func MakeKOSKSignature(
 	}
 
 	// abi.Encode adds 12 zero bytes before actual address bytes
-	return privateKey.Sign(message[12:], domain)
+	return message[12:], nil
 }
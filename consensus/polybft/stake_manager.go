@@ -200,6 +200,36 @@ func (s *stakeManager) init(dbTx *bolt.Tx) error {
 		checkForBalanceChanges = false
 	}
 
+	// then, drop the BLS keys of the validators which rotated them
+	if contractsapi.BlsKeyRotationSupported() {
+		blsKeyEventsGetter := &eventsGetter[*contractsapi.BlsKeyUpdatedEvent]{
+			receiptsGetter: receiptsGetter{
+				blockchain: s.blockchain,
+			},
+			isValidLogFn: func(l *types.Log) bool {
+				return l.Address == s.hydraChainContract
+			},
+			parseEventFn: func(h *types.Header, l *ethgo.Log) (*contractsapi.BlsKeyUpdatedEvent, bool, error) {
+				var blsKeyUpdatedEvent contractsapi.BlsKeyUpdatedEvent
+				doesMatch, err := blsKeyUpdatedEvent.ParseLog(l)
+
+				return &blsKeyUpdatedEvent, doesMatch, err
+			},
+		}
+
+		blsKeyUpdatedEvents, err := blsKeyEventsGetter.getEventsFromBlocksRange(
+			fullValidatorSet.BlockNumber+1,
+			currentBlockNumber,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range blsKeyUpdatedEvents {
+			s.updateOnBlsKeyUpdatedEvent(&fullValidatorSet, event)
+		}
+	}
+
 	// check for balance changed events only if there are no voting power changes
 	if checkForBalanceChanges {
 		balanceChangedEventsGetter := &eventsGetter[*contractsapi.BalanceChangedEvent]{
@@ -365,7 +395,17 @@ func (s *stakeManager) UpdateValidatorSet(
 	for _, newValidator := range newValidatorSet {
 		// check if its already in existing validator set
 		if oldValidator, exists := oldActiveMap[newValidator.Address]; exists {
-			if oldValidator.VotingPower.Cmp(newValidator.VotingPower) != 0 {
+			if newValidator.BlsKey == nil {
+				// the validator rotated its BLS key, the new one takes effect from the next epoch
+				newValidator.BlsKey, err = s.getBlsKey(newValidator.Address)
+				if err != nil {
+					return nil, fmt.Errorf("could not retrieve validator data. Address: %v. Error: %w",
+						newValidator.Address, err)
+				}
+			}
+
+			if oldValidator.VotingPower.Cmp(newValidator.VotingPower) != 0 ||
+				!bytes.Equal(oldValidator.BlsKey.Marshal(), newValidator.BlsKey.Marshal()) {
 				updatedValidators = append(updatedValidators, newValidator)
 			}
 		} else {
@@ -460,11 +500,17 @@ func (s *stakeManager) GetLogFilters() map[types.Address][]types.Hash {
 	var (
 		balanceChangedEvent       contractsapi.BalanceChangedEvent
 		powerExponentUpdatedEvent contractsapi.PowerExponentUpdatedEvent
+		blsKeyUpdatedEvent        contractsapi.BlsKeyUpdatedEvent
 	)
 
+	hydraChainEvents := []types.Hash{types.Hash(powerExponentUpdatedEvent.Sig())}
+	if contractsapi.BlsKeyRotationSupported() {
+		hydraChainEvents = append(hydraChainEvents, types.Hash(blsKeyUpdatedEvent.Sig()))
+	}
+
 	return map[types.Address][]types.Hash{
 		s.hydraStakingContract: {types.Hash(balanceChangedEvent.Sig())},
-		s.hydraChainContract:   {types.Hash(powerExponentUpdatedEvent.Sig())},
+		s.hydraChainContract:   hydraChainEvents,
 	}
 }
 
@@ -502,7 +548,18 @@ func (s *stakeManager) ProcessLog(header *types.Header, log *ethgo.Log, dbTx *bo
 				return err
 			}
 		} else {
-			return fmt.Errorf("unknown event")
+			// If not PowerExponentUpdated, check for BlsKeyUpdated event
+			var blsKeyUpdatedEvent contractsapi.BlsKeyUpdatedEvent
+			doesMatch, err = blsKeyUpdatedEvent.ParseLog(log)
+			if err != nil {
+				return err
+			}
+
+			if !doesMatch {
+				return fmt.Errorf("unknown event")
+			}
+
+			s.updateOnBlsKeyUpdatedEvent(&fullValidatorSet, &blsKeyUpdatedEvent)
 		}
 	}
 
@@ -521,6 +578,19 @@ func (s *stakeManager) ProcessLog(header *types.Header, log *ethgo.Log, dbTx *bo
 	return s.state.StakeStore.insertFullValidatorSet(fullValidatorSet, dbTx)
 }
 
+// Helper function to drop the BLS key of a validator which rotated it, so that
+// the newly registered key is read from HydraChain when the next validator set is calculated
+func (s *stakeManager) updateOnBlsKeyUpdatedEvent(
+	fullValidatorSet *validatorSetState,
+	blsKeyUpdatedEvent *contractsapi.BlsKeyUpdatedEvent,
+) {
+	s.logger.Debug("BlsKeyUpdated event", "validator", blsKeyUpdatedEvent.Validator)
+
+	if data, exists := fullValidatorSet.Validators[blsKeyUpdatedEvent.Validator]; exists {
+		data.BlsKey = nil
+	}
+}
+
 // Helper function to update voting power exponent and validators voting power on Power Exponent update event
 func (s *stakeManager) updateOnPowerExponentEvent(
 	fullValidatorSet *validatorSetState,
@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/0xPolygon/polygon-edge/bls"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
@@ -489,6 +490,44 @@ func TestStakeManager_UpdateValidatorSet(t *testing.T) {
 		require.Len(t, updateDelta.Removed, 1)
 	})
 
+	t.Run("UpdateValidatorSet - rotated BLS key", func(t *testing.T) {
+		rotatedKey, err := bls.GenerateBlsKey()
+		require.NoError(t, err)
+
+		fullValidatorSet := validators.GetPublicIdentities().Copy()
+		validatorToUpdate := fullValidatorSet[1]
+
+		// a BlsKeyUpdated event drops the key of the validator which rotated it
+		stakeMap := newValidatorStakeMap(fullValidatorSet)
+		stakeManager.updateOnBlsKeyUpdatedEvent(
+			&validatorSetState{Validators: stakeMap},
+			&contractsapi.BlsKeyUpdatedEvent{Validator: validatorToUpdate.Address},
+		)
+		require.Nil(t, stakeMap[validatorToUpdate.Address].BlsKey)
+
+		require.NoError(t, state.StakeStore.insertFullValidatorSet(validatorSetState{
+			Validators: stakeMap,
+		}, nil))
+
+		header := &types.Header{Number: 10}
+		provider := new(stateProviderMock)
+		bcMock.On("CurrentHeader").Return(header).Once()
+		bcMock.On("GetStateProviderForBlock", header).Return(provider, nil).Once()
+		bcMock.On("GetSystemState", provider).Return(&blsKeySystemStateMock{
+			systemStateMock: new(systemStateMock),
+			blsKey:          rotatedKey.PublicKey(),
+		}).Once()
+
+		updateDelta, err := stakeManager.UpdateValidatorSet(epoch+6, validators.GetPublicIdentities())
+		require.NoError(t, err)
+		require.Len(t, updateDelta.Added, 0)
+		require.Len(t, updateDelta.Updated, 1)
+		require.Len(t, updateDelta.Removed, 0)
+		require.Equal(t, validatorToUpdate.Address, updateDelta.Updated[0].Address)
+		require.Equal(t, rotatedKey.PublicKey().Marshal(), updateDelta.Updated[0].BlsKey.Marshal())
+		bcMock.AssertExpectations(t)
+	})
+
 	t.Run("UpdateValidatorSet - max validator set size reached", func(t *testing.T) {
 		// because we now have 5 validators, and the new validator has more stake
 		stakeManager.maxValidatorSetSize = 4
@@ -501,7 +540,7 @@ func TestStakeManager_UpdateValidatorSet(t *testing.T) {
 			Validators: newValidatorStakeMap(fullValidatorSet),
 		}, nil))
 
-		updateDelta, err := stakeManager.UpdateValidatorSet(epoch+6,
+		updateDelta, err := stakeManager.UpdateValidatorSet(epoch+7,
 			validators.GetPublicIdentities(aliases[1:]...))
 
 		require.NoError(t, err)
@@ -561,6 +600,29 @@ func TestStakeCounter_ShouldBeDeterministic(t *testing.T) {
 	}
 }
 
+func TestStakeManager_GetLogFilters(t *testing.T) {
+	t.Parallel()
+
+	var (
+		hydraStakingAddr = types.StringToAddress("0xf005")
+		hydraChainAddr   = types.StringToAddress("0xf006")
+
+		balanceChangedEvent       contractsapi.BalanceChangedEvent
+		powerExponentUpdatedEvent contractsapi.PowerExponentUpdatedEvent
+	)
+
+	stakeManager := &stakeManager{
+		hydraStakingContract: hydraStakingAddr,
+		hydraChainContract:   hydraChainAddr,
+	}
+
+	// the BlsKeyUpdated event isn't subscribed to while HydraChain has no BLS key rotation
+	require.Equal(t, map[types.Address][]types.Hash{
+		hydraStakingAddr: {types.Hash(balanceChangedEvent.Sig())},
+		hydraChainAddr:   {types.Hash(powerExponentUpdatedEvent.Sig())},
+	}, stakeManager.GetLogFilters())
+}
+
 func TestStakeManager_UpdateOnInit(t *testing.T) {
 	t.Parallel()
 
@@ -834,3 +896,13 @@ func (d *dummyStakeTxRelayer) SendTransactionLocal(txn *ethgo.Transaction) (*eth
 func (d *dummyStakeTxRelayer) Client() *jsonrpc.Client {
 	return nil
 }
+
+// blsKeySystemStateMock returns the given BLS key for any validator
+type blsKeySystemStateMock struct {
+	*systemStateMock
+	blsKey *bls.PublicKey
+}
+
+func (s *blsKeySystemStateMock) GetValidatorBlsKey(types.Address) (*bls.PublicKey, error) {
+	return s.blsKey, nil
+}
@@ -0,0 +1,210 @@
+package polybft
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/go-hclog"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// RewindState rolls the consensus state kept in the given consensus directory back to the given header,
+// so that the node can resume from it after the blockchain head is rewound. The node must be stopped.
+// An error wrapping os.ErrNotExist is returned if there is no consensus state in the directory
+func RewindState(consensusDir string, header *types.Header) error {
+	path := filepath.Join(consensusDir, stateFileName)
+	if _, err := os.Stat(path); err != nil {
+		return err
+	}
+
+	epoch, err := getHeaderEpoch(header)
+	if err != nil {
+		return err
+	}
+
+	s, err := newState(path, hclog.NewNullLogger(), make(chan struct{}))
+	if err != nil {
+		return err
+	}
+
+	defer s.db.Close()
+
+	return s.rewind(header.Number, epoch)
+}
+
+// getHeaderEpoch returns the epoch of the given block from its checkpoint data
+func getHeaderEpoch(header *types.Header) (uint64, error) {
+	extra, err := GetIbftExtra(header.ExtraData)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode extra of block %d: %w", header.Number, err)
+	}
+
+	if extra.Checkpoint == nil {
+		return 0, nil
+	}
+
+	return extra.Checkpoint.EpochNumber, nil
+}
+
+// rewind removes all the consensus data produced after the given block of the given epoch.
+// Data which can't be partially rolled back (proposer snapshot, full validator set) is removed
+// and rebuilt from the chain on the next start
+func (s *State) rewind(blockNumber, epoch uint64) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := s.CheckpointStore.rewind(blockNumber, tx); err != nil {
+			return err
+		}
+
+		if err := s.EpochStore.rewind(blockNumber, epoch, tx); err != nil {
+			return err
+		}
+
+		if err := s.ProposerSnapshotStore.rewind(blockNumber, tx); err != nil {
+			return err
+		}
+
+		if err := s.StakeStore.rewind(blockNumber, tx); err != nil {
+			return err
+		}
+
+		lastProcessed, err := s.getLastProcessedEventsBlock(tx)
+		if err != nil {
+			return err
+		}
+
+		if lastProcessed > blockNumber {
+			return s.insertLastProcessedEventsBlock(blockNumber, tx)
+		}
+
+		return nil
+	})
+}
+
+// rewind removes the exit events emitted after the given block
+func (s *CheckpointStore) rewind(blockNumber uint64, tx *bolt.Tx) error {
+	var keys, exitIDs [][]byte
+
+	// key is (epoch+id+blockNumber)
+	err := tx.Bucket(exitEventsBucket).ForEach(func(k, _ []byte) error {
+		if len(k) == 24 && common.EncodeBytesToUint64(k[16:]) > blockNumber {
+			// keys are only valid until the bucket is modified
+			keys = append(keys, append([]byte{}, k...))
+			exitIDs = append(exitIDs, append([]byte{}, k[8:16]...))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, k := range keys {
+		if err := tx.Bucket(exitEventsBucket).Delete(k); err != nil {
+			return err
+		}
+
+		if err := tx.Bucket(exitEventToEpochLookupBucket).Delete(exitIDs[i]); err != nil {
+			return err
+		}
+	}
+
+	lastSaved, err := s.getLastSaved(tx)
+	if err != nil {
+		if errors.Is(err, errNoLastSavedEntry) {
+			return nil
+		}
+
+		return err
+	}
+
+	if lastSaved <= blockNumber {
+		return nil
+	}
+
+	return tx.Bucket(exitEventLastProcessedBlockBucket).Put(lastProcessedBlockKey, common.EncodeUint64ToBytes(blockNumber))
+}
+
+// rewind removes the epochs after the given epoch and
+// the validator snapshots of the epochs ending after the given block
+func (s *EpochStore) rewind(blockNumber, epoch uint64, tx *bolt.Tx) error {
+	var epochs, snapshots [][]byte
+
+	err := tx.Bucket(epochsBucket).ForEach(func(k, _ []byte) error {
+		if common.EncodeBytesToUint64(k) > epoch {
+			epochs = append(epochs, append([]byte{}, k...))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Bucket(validatorSnapshotsBucket).ForEach(func(k, v []byte) error {
+		var snapshot *validatorSnapshot
+		if err := json.Unmarshal(v, &snapshot); err != nil {
+			return err
+		}
+
+		if snapshot.EpochEndingBlock > blockNumber {
+			snapshots = append(snapshots, append([]byte{}, k...))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, k := range epochs {
+		if err := tx.Bucket(epochsBucket).DeleteBucket(k); err != nil {
+			return err
+		}
+	}
+
+	for _, k := range snapshots {
+		if err := tx.Bucket(validatorSnapshotsBucket).Delete(k); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rewind removes the proposer snapshot if it was calculated for a block after the given one
+func (s *ProposerSnapshotStore) rewind(blockNumber uint64, tx *bolt.Tx) error {
+	snapshot, err := s.getProposerSnapshot(tx)
+	if err != nil {
+		return err
+	}
+
+	if snapshot == nil || snapshot.Height <= blockNumber+1 {
+		return nil
+	}
+
+	return tx.Bucket(proposerSnapshotBucket).Delete(proposerSnapshotKey)
+}
+
+// rewind removes the full validator set if it was updated after the given block
+func (s *StakeStore) rewind(blockNumber uint64, tx *bolt.Tx) error {
+	fullValidatorSet, err := s.getFullValidatorSet(tx)
+	if err != nil {
+		if errors.Is(err, errNoFullValidatorSet) {
+			return nil
+		}
+
+		return err
+	}
+
+	if fullValidatorSet.BlockNumber <= blockNumber {
+		return nil
+	}
+
+	return tx.Bucket(validatorSetBucket).Delete(fullValidatorSetKey)
+}
@@ -0,0 +1,66 @@
+package polybft
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+)
+
+func TestState_Rewind(t *testing.T) {
+	t.Parallel()
+
+	state := newTestState(t)
+
+	// exit events in blocks 5 (epoch 1) and 15 (epoch 2)
+	for i, block := range []uint64{5, 15} {
+		require.NoError(t, state.CheckpointStore.insertExitEvent(&ExitEvent{
+			L2StateSyncedEvent: &contractsapi.L2StateSyncedEvent{ID: big.NewInt(int64(i + 1))},
+			EpochNumber:        uint64(i + 1),
+			BlockNumber:        block,
+		}, nil))
+	}
+
+	for epoch := uint64(1); epoch <= 3; epoch++ {
+		require.NoError(t, state.EpochStore.insertEpoch(epoch, nil))
+		require.NoError(t, state.EpochStore.insertValidatorSnapshot(&validatorSnapshot{
+			Epoch:            epoch,
+			EpochEndingBlock: epoch * 10,
+		}, nil))
+	}
+
+	require.NoError(t, state.ProposerSnapshotStore.writeProposerSnapshot(&ProposerSnapshot{Height: 21}, nil))
+	require.NoError(t, state.StakeStore.insertFullValidatorSet(validatorSetState{BlockNumber: 20, EpochID: 2}, nil))
+	require.NoError(t, state.insertLastProcessedEventsBlock(20, nil))
+
+	// rewind to block 12 of epoch 2
+	require.NoError(t, state.rewind(12, 2))
+
+	_, err := state.CheckpointStore.getExitEvent(1)
+	require.NoError(t, err)
+
+	_, err = state.CheckpointStore.getExitEvent(2)
+	require.Error(t, err)
+
+	assert.True(t, state.EpochStore.isEpochInserted(1))
+	assert.True(t, state.EpochStore.isEpochInserted(2))
+	assert.False(t, state.EpochStore.isEpochInserted(3))
+
+	snapshot, err := state.EpochStore.getLastSnapshot(nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), snapshot.Epoch)
+
+	proposerSnapshot, err := state.ProposerSnapshotStore.getProposerSnapshot(nil)
+	require.NoError(t, err)
+	assert.Nil(t, proposerSnapshot)
+
+	_, err = state.StakeStore.getFullValidatorSet(nil)
+	require.ErrorIs(t, err, errNoFullValidatorSet)
+
+	lastProcessed, err := state.getLastProcessedEventsBlock(nil)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(12), lastProcessed)
+}
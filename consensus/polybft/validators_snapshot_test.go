@@ -182,6 +182,26 @@ func TestValidatorsSnapshotCache_Cleanup(t *testing.T) {
 	}
 }
 
+func TestValidatorsSnapshotCache_Rewind(t *testing.T) {
+	t.Parallel()
+	require := require.New(t)
+
+	cache := newValidatorsSnapshotCache(hclog.NewNullLogger(), newTestState(t), new(blockchainMock))
+	snapshot := validator.NewTestValidators(t, 3).GetPublicIdentities()
+
+	for i := uint64(0); i < 5; i++ {
+		require.NoError(cache.storeSnapshot(&validatorSnapshot{i, i * 10, snapshot}, nil))
+	}
+
+	cache.rewind(25)
+
+	require.Len(cache.snapshots, 3)
+
+	for epoch := range cache.snapshots {
+		require.LessOrEqual(epoch, uint64(2))
+	}
+}
+
 func TestValidatorsSnapshotCache_ComputeSnapshot_UnknownBlock(t *testing.T) {
 	t.Parallel()
 	assertions := assert.New(t)
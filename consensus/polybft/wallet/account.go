@@ -72,7 +72,21 @@ func GetEcdsaFromSecret(secretsManager secrets.SecretsManager) (*wallet.Key, err
 
 // GetBlsFromSecret retrieves BLS key by using provided secretsManager
 func GetBlsFromSecret(secretsManager secrets.SecretsManager) (*bls.PrivateKey, error) {
-	encodedKey, err := secretsManager.GetSecret(secrets.ValidatorBLSKey)
+	return getBlsFromSecret(secretsManager, secrets.ValidatorBLSKey)
+}
+
+// GetPreviousBlsFromSecret retrieves the BLS key replaced by the last key rotation
+func GetPreviousBlsFromSecret(secretsManager secrets.SecretsManager) (*bls.PrivateKey, error) {
+	return getBlsFromSecret(secretsManager, secrets.ValidatorBLSKeyPrevious)
+}
+
+// GetPendingBlsFromSecret retrieves the BLS key of a key rotation which is not confirmed yet
+func GetPendingBlsFromSecret(secretsManager secrets.SecretsManager) (*bls.PrivateKey, error) {
+	return getBlsFromSecret(secretsManager, secrets.ValidatorBLSKeyPending)
+}
+
+func getBlsFromSecret(secretsManager secrets.SecretsManager, name string) (*bls.PrivateKey, error) {
+	encodedKey, err := secretsManager.GetSecret(name)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve bls key: %w", err)
 	}
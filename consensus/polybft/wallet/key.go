@@ -1,35 +1,73 @@
 package wallet
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/Hydra-Chain/go-ibft/messages/proto"
 	"github.com/umbracle/ethgo"
 	protobuf "google.golang.org/protobuf/proto"
 
+	"github.com/0xPolygon/polygon-edge/bls"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
 	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
 type Key struct {
-	raw *Account
+	lock   sync.RWMutex
+	signer Signer
 }
 
+// NewKey creates a key which signs with the keys of the given account
 func NewKey(raw *Account) *Key {
+	return NewKeyFromSigner(NewLocalSigner(raw))
+}
+
+// NewKeyFromSigner creates a key which delegates signing to the given signer
+func NewKeyFromSigner(signer Signer) *Key {
 	return &Key{
-		raw: raw,
+		signer: signer,
+	}
+}
+
+// SwapSigner replaces the signer of the key, which is needed once a rotated BLS key takes effect.
+// The new signer has to sign on behalf of the same validator address
+func (k *Key) SwapSigner(newSigner Signer) error {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+
+	if newSigner.Address() != k.signer.Address() {
+		return fmt.Errorf("signer address %s does not match the key address %s",
+			newSigner.Address(), k.signer.Address())
 	}
+
+	k.signer = newSigner
+
+	return nil
+}
+
+func (k *Key) getSigner() Signer {
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+
+	return k.signer
 }
 
 // String returns hex encoded ECDSA address
 func (k *Key) String() string {
-	return k.raw.Ecdsa.Address().String()
+	return k.Address().String()
 }
 
 // Address returns ECDSA address
 func (k *Key) Address() ethgo.Address {
-	return k.raw.Ecdsa.Address()
+	return ethgo.Address(k.getSigner().Address())
+}
+
+// BLSPublicKey returns the BLS public key
+func (k *Key) BLSPublicKey() *bls.PublicKey {
+	return k.getSigner().BLSPublicKey()
 }
 
 // Sign signs the provided digest with BLS key
@@ -40,12 +78,32 @@ func (k *Key) Sign(digest []byte) ([]byte, error) {
 
 // SignWithDomain signs the provided digest with BLS key and provided domain
 func (k *Key) SignWithDomain(digest, domain []byte) ([]byte, error) {
-	signature, err := k.raw.Bls.Sign(digest, domain)
+	return k.getSigner().Sign(&SignRequest{
+		Kind:    SignKindBLS,
+		Domain:  domain,
+		Payload: digest,
+	})
+}
+
+// SignCommittedSeal signs the proposal hash committed in the given view with BLS key.
+// The signer is sent the COMMIT message of the view, so it knows which view it signs for
+func (k *Key) SignCommittedSeal(proposalHash []byte, view *proto.View) ([]byte, error) {
+	commitRaw, err := protobuf.Marshal(&proto.Message{
+		View: view,
+		Type: proto.MessageType_COMMIT,
+		Payload: &proto.Message_CommitData{
+			CommitData: &proto.CommitMessage{ProposalHash: proposalHash},
+		},
+	})
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("cannot marshal commit message: %w", err)
 	}
 
-	return signature.Marshal()
+	return k.getSigner().Sign(&SignRequest{
+		Kind:    SignKindCommittedSeal,
+		Domain:  signer.DomainCheckpointManager,
+		Payload: commitRaw,
+	})
 }
 
 // SignIBFTMessage signs the IBFT consensus message with ECDSA key
@@ -55,7 +113,10 @@ func (k *Key) SignIBFTMessage(msg *proto.Message) (*proto.Message, error) {
 		return nil, fmt.Errorf("cannot marshal message: %w", err)
 	}
 
-	if msg.Signature, err = k.raw.Ecdsa.Sign(crypto.Keccak256(msgRaw)); err != nil {
+	if msg.Signature, err = k.getSigner().Sign(&SignRequest{
+		Kind:    SignKindIBFTMessage,
+		Payload: msgRaw,
+	}); err != nil {
 		return nil, fmt.Errorf("cannot create message signature: %w", err)
 	}
 
@@ -73,7 +134,11 @@ func RecoverAddressFromSignature(sig, rawContent []byte) (types.Address, error)
 	return crypto.PubKeyToAddress(pub), nil
 }
 
-// ECDSASigner implements ethgo.Key interface and it is used for signing using provided ECDSA key
+// errDigestSigning is returned when the ECDSA signer is asked to sign a digest,
+// the transactions are passed whole to the signer, which hashes them itself
+var errDigestSigning = errors.New("the ecdsa signer signs only the transactions, not their digests")
+
+// ECDSASigner implements ethgo.Key interface and it is used for signing the transactions using provided ECDSA key
 type ECDSASigner struct {
 	*Key
 }
@@ -82,6 +147,34 @@ func NewEcdsaSigner(ecdsaKey *Key) *ECDSASigner {
 	return &ECDSASigner{Key: ecdsaKey}
 }
 
+// Sign always fails, the transactions are signed with SignTx
 func (k *ECDSASigner) Sign(b []byte) ([]byte, error) {
-	return k.raw.Ecdsa.Sign(b)
+	return nil, errDigestSigning
+}
+
+// SignTx signs the transaction for the given chain id, the signer hashes the transaction itself
+func (k *ECDSASigner) SignTx(txn *ethgo.Transaction, chainID uint64) (*ethgo.Transaction, error) {
+	raw, err := txn.MarshalRLPTo(nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal transaction: %w", err)
+	}
+
+	signedRaw, err := k.getSigner().Sign(&SignRequest{
+		Kind:    SignKindTransaction,
+		ChainID: chainID,
+		Payload: raw,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	signed := &ethgo.Transaction{}
+	if err := signed.UnmarshalRLP(signedRaw); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal signed transaction: %w", err)
+	}
+
+	// the fields which aren't encoded
+	signed.From = txn.From
+
+	return signed, nil
 }
@@ -1,10 +1,13 @@
 package wallet
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/Hydra-Chain/go-ibft/messages/proto"
 	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+	ethgow "github.com/umbracle/ethgo/wallet"
 
 	"github.com/0xPolygon/polygon-edge/bls"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
@@ -47,10 +50,62 @@ func Test_Sign(t *testing.T) {
 		sig, err := bls.UnmarshalSignature(ser)
 		require.NoError(t, err)
 
-		require.True(t, sig.Verify(key.raw.Bls.PublicKey(), msg, signer.DomainCheckpointManager))
+		require.True(t, sig.Verify(key.BLSPublicKey(), msg, signer.DomainCheckpointManager))
 	}
 }
 
+func Test_SignCommittedSeal(t *testing.T) {
+	t.Parallel()
+
+	key := NewKey(generateTestAccount(t))
+	proposalHash := []byte("proposal hash")
+
+	seal, err := key.SignCommittedSeal(proposalHash, &proto.View{Height: 1, Round: 2})
+	require.NoError(t, err)
+
+	sig, err := bls.UnmarshalSignature(seal)
+	require.NoError(t, err)
+	require.True(t, sig.Verify(key.BLSPublicKey(), proposalHash, signer.DomainCheckpointManager))
+
+	// the payload of a committed seal has to be a COMMIT message
+	_, err = key.getSigner().Sign(&SignRequest{
+		Kind:    SignKindCommittedSeal,
+		Domain:  signer.DomainCheckpointManager,
+		Payload: proposalHash,
+	})
+	require.ErrorIs(t, err, errInvalidCommittedSeal)
+}
+
+func Test_ECDSASigner(t *testing.T) {
+	t.Parallel()
+
+	const chainID = 100
+
+	key := NewKey(generateTestAccount(t))
+	ecdsaSigner := NewEcdsaSigner(key)
+
+	// the digests are never signed, the callers sign the whole transactions with SignTx
+	_, err := ecdsaSigner.Sign(make([]byte, 32))
+	require.ErrorIs(t, err, errDigestSigning)
+
+	to := ethgo.Address{0x1}
+	txn := &ethgo.Transaction{
+		From:     key.Address(),
+		To:       &to,
+		Value:    big.NewInt(1),
+		Gas:      21000,
+		GasPrice: 1,
+	}
+
+	signed, err := ecdsaSigner.SignTx(txn, chainID)
+	require.NoError(t, err)
+
+	sender, err := ethgow.NewEIP155Signer(chainID).RecoverSender(signed)
+	require.NoError(t, err)
+	require.Equal(t, key.Address(), sender)
+	require.Equal(t, txn.From, signed.From)
+}
+
 func Test_String(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,119 @@
+package remote
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/bls"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var _ wallet.Signer = (*Signer)(nil)
+
+// Signer is a wallet.Signer which delegates signing to a remote signing service over mTLS
+type Signer struct {
+	url    string
+	client *http.Client
+
+	address      types.Address
+	blsPublicKey *bls.PublicKey
+}
+
+// NewSigner connects to the signing service and retrieves the validator identity
+func NewSigner(config *Config) (*Signer, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := config.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Signer{
+		url: strings.TrimSuffix(config.URL, "/"),
+		client: &http.Client{
+			Timeout:   config.timeout(),
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}
+
+	identity := &identityResponse{}
+	if err := s.do(http.MethodGet, identityPath, nil, identity); err != nil {
+		return nil, fmt.Errorf("failed to retrieve the validator identity: %w", err)
+	}
+
+	if identity.BLSPublicKey == nil {
+		return nil, fmt.Errorf("signing service returned no BLS public key")
+	}
+
+	s.address = identity.Address
+	s.blsPublicKey = identity.BLSPublicKey
+
+	return s, nil
+}
+
+// Address returns the validator (ECDSA) address
+func (s *Signer) Address() types.Address {
+	return s.address
+}
+
+// BLSPublicKey returns the validator BLS public key
+func (s *Signer) BLSPublicKey() *bls.PublicKey {
+	return s.blsPublicKey
+}
+
+// Sign sends the request to the signing service
+func (s *Signer) Sign(req *wallet.SignRequest) ([]byte, error) {
+	resp := &signResponse{}
+	if err := s.do(http.MethodPost, signPath, req, resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Signature, nil
+}
+
+func (s *Signer) do(method, path string, body, result interface{}) error {
+	var reqBody bytes.Buffer
+
+	if body != nil {
+		if err := json.NewEncoder(&reqBody).Encode(body); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(method, s.url+path, &reqBody)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusConflict {
+			return ErrSlashingProtection
+		}
+
+		errResp := &errorResponse{}
+		_ = json.NewDecoder(resp.Body).Decode(errResp)
+
+		if resp.StatusCode == http.StatusForbidden {
+			return fmt.Errorf("%w (%s)", ErrRequestNotAllowed, errResp.Error)
+		}
+
+		return fmt.Errorf("signing service returned %d: %s", resp.StatusCode, errResp.Error)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(result)
+}
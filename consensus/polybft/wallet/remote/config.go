@@ -0,0 +1,81 @@
+package remote
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/common"
+)
+
+const defaultTimeout = 5 * time.Second
+
+var (
+	errMissingURL         = errors.New("remote signer url is not set")
+	errMissingCertificate = errors.New("remote signer mTLS requires a CA certificate, a certificate and a key")
+)
+
+// Config is the configuration of a remote signer client
+type Config struct {
+	// URL is the base URL of the signing service (https://host:port)
+	URL string `json:"url"`
+
+	// CACert is the path to the CA certificate the signing service certificate is verified against
+	CACert string `json:"ca_cert"`
+
+	// Cert and Key are the paths to the client certificate and its private key
+	Cert string `json:"cert"`
+	Key  string `json:"key"`
+
+	// Timeout is the timeout of a single signing request
+	Timeout common.Duration `json:"timeout,omitempty"`
+}
+
+// ReadConfig reads the remote signer configuration from a JSON file
+func ReadConfig(path string) (*Config, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &Config{}
+	if err := json.Unmarshal(raw, config); err != nil {
+		return nil, err
+	}
+
+	return config, config.Validate()
+}
+
+// Validate checks that the configuration is complete
+func (c *Config) Validate() error {
+	if c.URL == "" {
+		return errMissingURL
+	}
+
+	if c.CACert == "" || c.Cert == "" || c.Key == "" {
+		return errMissingCertificate
+	}
+
+	return nil
+}
+
+func (c *Config) timeout() time.Duration {
+	if c.Timeout.Duration == 0 {
+		return defaultTimeout
+	}
+
+	return c.Timeout.Duration
+}
+
+// TLSConfig returns the mTLS configuration of the remote signer client
+func (c *Config) TLSConfig() (*tls.Config, error) {
+	return common.ClientMTLSConfig(c.CACert, c.Cert, c.Key)
+}
+
+// ServerTLSConfig returns the mTLS configuration of a signing service,
+// which accepts only clients with a certificate issued by the given CA
+func ServerTLSConfig(caCertPath, certPath, keyPath string) (*tls.Config, error) {
+	return common.ServerMTLSConfig(caCertPath, certPath, keyPath)
+}
@@ -0,0 +1,217 @@
+package remote
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/Hydra-Chain/go-ibft/messages/proto"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+	ethgow "github.com/umbracle/ethgo/wallet"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/0xPolygon/polygon-edge/bls"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func newTestSlashingProtection(t *testing.T) *SlashingProtection {
+	t.Helper()
+
+	protection, err := NewSlashingProtection(filepath.Join(t.TempDir(), "slashing.db"))
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, protection.Close())
+	})
+
+	return protection
+}
+
+func ibftMessagePayload(t *testing.T, msgType proto.MessageType, height, round uint64, hash []byte) []byte {
+	t.Helper()
+
+	msg := &proto.Message{
+		View: &proto.View{Height: height, Round: round},
+		Type: msgType,
+	}
+
+	switch msgType {
+	case proto.MessageType_PREPARE:
+		msg.Payload = &proto.Message_PrepareData{PrepareData: &proto.PrepareMessage{ProposalHash: hash}}
+	case proto.MessageType_COMMIT:
+		msg.Payload = &proto.Message_CommitData{CommitData: &proto.CommitMessage{ProposalHash: hash}}
+	case proto.MessageType_ROUND_CHANGE:
+		msg.Payload = &proto.Message_RoundChangeData{RoundChangeData: &proto.RoundChangeMessage{}}
+	}
+
+	raw, err := protobuf.Marshal(msg)
+	require.NoError(t, err)
+
+	return raw
+}
+
+func TestSlashingProtection_Check(t *testing.T) {
+	t.Parallel()
+
+	protection := newTestSlashingProtection(t)
+
+	seal := func(height, round uint64, payload string) *wallet.SignRequest {
+		return &wallet.SignRequest{
+			Kind:    wallet.SignKindCommittedSeal,
+			Domain:  signer.DomainCheckpointManager,
+			Payload: ibftMessagePayload(t, proto.MessageType_COMMIT, height, round, []byte(payload)),
+		}
+	}
+
+	require.NoError(t, protection.Check(seal(1, 0, "a")))
+	// signing the same payload again is allowed
+	require.NoError(t, protection.Check(seal(1, 0, "a")))
+	require.ErrorIs(t, protection.Check(seal(1, 0, "b")), ErrSlashingProtection)
+	// a different round or height is a different view
+	require.NoError(t, protection.Check(seal(1, 1, "b")))
+	require.NoError(t, protection.Check(seal(2, 0, "b")))
+
+	prepare := func(hash string) *wallet.SignRequest {
+		return &wallet.SignRequest{
+			Kind:    wallet.SignKindIBFTMessage,
+			Payload: ibftMessagePayload(t, proto.MessageType_PREPARE, 1, 0, []byte(hash)),
+		}
+	}
+
+	require.NoError(t, protection.Check(prepare("a")))
+	require.ErrorIs(t, protection.Check(prepare("b")), ErrSlashingProtection)
+
+	// round changes and requests without a view are not protected
+	for _, req := range []*wallet.SignRequest{
+		{Kind: wallet.SignKindIBFTMessage, Payload: ibftMessagePayload(t, proto.MessageType_ROUND_CHANGE, 1, 0, nil)},
+		{Kind: wallet.SignKindTransaction, Payload: []byte("a")},
+		{Kind: wallet.SignKindTransaction, Payload: []byte("b")},
+		{Kind: wallet.SignKindBLS, Domain: signer.DomainHydraChain, Payload: []byte("a")},
+		{Kind: wallet.SignKindBLS, Domain: signer.DomainCommonSigning, Payload: []byte("b")},
+	} {
+		require.NoError(t, protection.Check(req))
+	}
+
+	require.Error(t, protection.Check(&wallet.SignRequest{Kind: wallet.SignKindIBFTMessage, Payload: []byte{0xff}}))
+
+	// the view of a committed seal is taken from its COMMIT message, which has to be provided
+	for _, payload := range [][]byte{
+		[]byte("a"),
+		ibftMessagePayload(t, proto.MessageType_PREPARE, 3, 0, []byte("a")),
+		ibftMessagePayload(t, proto.MessageType_COMMIT, 3, 0, nil),
+	} {
+		require.Error(t, protection.Check(&wallet.SignRequest{
+			Kind:    wallet.SignKindCommittedSeal,
+			Domain:  signer.DomainCheckpointManager,
+			Payload: payload,
+		}))
+	}
+
+	// the committed seals can't be signed as BLS digests, nor with another domain
+	for _, req := range []*wallet.SignRequest{
+		{Kind: wallet.SignKindBLS, Domain: signer.DomainCheckpointManager, Payload: []byte("b")},
+		{Kind: wallet.SignKindBLS, Domain: []byte("unknown"), Payload: []byte("b")},
+		{Kind: wallet.SignKindBLS, Payload: []byte("b")},
+		{Kind: wallet.SignKindCommittedSeal, Domain: signer.DomainHydraChain, Payload: seal(1, 0, "b").Payload},
+		{Kind: "unknown", Payload: []byte("b")},
+	} {
+		require.ErrorIs(t, protection.Check(req), ErrRequestNotAllowed)
+	}
+}
+
+func TestSigner_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	account, err := wallet.GenerateAccount()
+	require.NoError(t, err)
+
+	remoteSigner, err := NewSigner(NewTestServer(t, account))
+	require.NoError(t, err)
+
+	require.Equal(t, account.Address(), remoteSigner.Address())
+	require.Equal(t, account.Bls.PublicKey().Marshal(), remoteSigner.BLSPublicKey().Marshal())
+
+	key := wallet.NewKeyFromSigner(remoteSigner)
+
+	// IBFT messages are signed with the ECDSA key
+	msgNoSig := &proto.Message{
+		View:    &proto.View{Height: 1, Round: 0},
+		From:    key.Address().Bytes(),
+		Type:    proto.MessageType_COMMIT,
+		Payload: &proto.Message_CommitData{},
+	}
+
+	msg, err := key.SignIBFTMessage(msgNoSig)
+	require.NoError(t, err)
+
+	payload, err := msgNoSig.PayloadNoSig()
+	require.NoError(t, err)
+
+	address, err := wallet.RecoverAddressFromSignature(msg.Signature, payload)
+	require.NoError(t, err)
+	require.Equal(t, types.Address(key.Address()), address)
+
+	// committed seals are signed with the BLS key
+	hash := types.StringToHash("0x1").Bytes()
+	view := &proto.View{Height: 1, Round: 0}
+
+	seal, err := key.SignCommittedSeal(hash, view)
+	require.NoError(t, err)
+
+	signature, err := bls.UnmarshalSignature(seal)
+	require.NoError(t, err)
+	require.True(t, signature.Verify(key.BLSPublicKey(), hash, signer.DomainCheckpointManager))
+
+	// the same seal can be requested again, a conflicting one is refused
+	_, err = key.SignCommittedSeal(hash, view)
+	require.NoError(t, err)
+
+	_, err = key.SignCommittedSeal(types.StringToHash("0x2").Bytes(), view)
+	require.ErrorIs(t, err, ErrSlashingProtection)
+
+	// a conflicting seal can't be signed as a BLS digest either
+	_, err = key.SignWithDomain(types.StringToHash("0x2").Bytes(), signer.DomainCheckpointManager)
+	require.ErrorIs(t, err, ErrRequestNotAllowed)
+
+	// the transactions are sent whole and hashed by the signing service
+	to := ethgo.Address{0x1}
+	txn := &ethgo.Transaction{
+		To:       &to,
+		Value:    big.NewInt(1),
+		Gas:      21000,
+		GasPrice: 1,
+		Nonce:    5,
+	}
+
+	ecdsaSigner := wallet.NewEcdsaSigner(key)
+
+	_, err = ecdsaSigner.Sign(types.StringToHash("0x3").Bytes())
+	require.Error(t, err)
+
+	signed, err := ecdsaSigner.SignTx(txn, 100)
+	require.NoError(t, err)
+
+	sender, err := ethgow.NewEIP155Signer(100).RecoverSender(signed)
+	require.NoError(t, err)
+	require.Equal(t, key.Address(), sender)
+	require.Equal(t, txn.Nonce, signed.Nonce)
+	require.Equal(t, txn.Value, signed.Value)
+}
+
+func TestSigner_RejectsUnknownClient(t *testing.T) {
+	t.Parallel()
+
+	account, err := wallet.GenerateAccount()
+	require.NoError(t, err)
+
+	config := NewTestServer(t, account)
+	// a client certificate issued by another CA is not accepted
+	other := NewTestServer(t, account)
+	config.Cert, config.Key = other.Cert, other.Key
+
+	_, err = NewSigner(config)
+	require.Error(t, err)
+}
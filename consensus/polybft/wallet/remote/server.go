@@ -0,0 +1,159 @@
+package remote
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/bls"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	identityPath = "/v1/identity"
+	signPath     = "/v1/sign"
+
+	// maxRequestSize is the maximum size of a sign request body
+	maxRequestSize = 1 << 20
+)
+
+// identityResponse is the response of the identity endpoint
+type identityResponse struct {
+	Address      types.Address  `json:"address"`
+	BLSPublicKey *bls.PublicKey `json:"blsPublicKey"`
+}
+
+// signResponse is the response of the sign endpoint
+type signResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// errorResponse is returned by the signing service when a request fails
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// Server is a signing service which signs requests of remote signer clients with a local signer.
+// Every consensus payload is checked against the slashing protection database before signing
+type Server struct {
+	logger     hclog.Logger
+	signer     wallet.Signer
+	protection *SlashingProtection
+	server     *http.Server
+}
+
+// NewServer creates a signing service
+func NewServer(logger hclog.Logger, signer wallet.Signer, protection *SlashingProtection) *Server {
+	s := &Server{
+		logger:     logger.Named("remote-signer"),
+		signer:     signer,
+		protection: protection,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(identityPath, s.handleIdentity)
+	mux.HandleFunc(signPath, s.handleSign)
+
+	s.server = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	return s
+}
+
+// Serve serves the signing service on the listener. Only clients presenting a certificate
+// accepted by the TLS configuration are served
+func (s *Server) Serve(listener net.Listener, tlsConfig *tls.Config) error {
+	s.logger.Info("signing service started", "addr", listener.Addr().String(), "address", s.signer.Address())
+
+	err := s.server.Serve(tls.NewListener(listener, tlsConfig))
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+
+	return err
+}
+
+// Close stops the signing service and closes the slashing protection database
+func (s *Server) Close() error {
+	if err := s.server.Close(); err != nil {
+		return err
+	}
+
+	return s.protection.Close()
+}
+
+func (s *Server) handleIdentity(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &identityResponse{
+		Address:      s.signer.Address(),
+		BLSPublicKey: s.signer.BLSPublicKey(),
+	})
+}
+
+func (s *Server) handleSign(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+
+		return
+	}
+
+	req := &wallet.SignRequest{}
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+
+		return
+	}
+
+	if err := s.protection.Check(req); err != nil {
+		if errors.Is(err, ErrSlashingProtection) {
+			s.logger.Warn("refused to sign", "kind", req.Kind, "err", err)
+			writeError(w, http.StatusConflict, err)
+
+			return
+		}
+
+		if errors.Is(err, ErrRequestNotAllowed) {
+			s.logger.Warn("refused to sign", "kind", req.Kind, "err", err)
+			writeError(w, http.StatusForbidden, err)
+
+			return
+		}
+
+		writeError(w, http.StatusBadRequest, err)
+
+		return
+	}
+
+	signature, err := s.signer.Sign(req)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &signResponse{Signature: signature})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, &errorResponse{Error: err.Error()})
+}
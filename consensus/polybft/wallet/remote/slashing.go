@@ -0,0 +1,157 @@
+package remote
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/Hydra-Chain/go-ibft/messages/proto"
+	bolt "go.etcd.io/bbolt"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+)
+
+var (
+	// ErrSlashingProtection is returned when a payload conflicts with one already signed
+	// for the same height and round
+	ErrSlashingProtection = errors.New("refused to sign a conflicting payload for the same height and round")
+
+	// ErrRequestNotAllowed is returned for the requests a signing service never signs
+	// (unknown kinds and BLS domains, committed seals of another domain)
+	ErrRequestNotAllowed = errors.New("refused to sign the request")
+
+	signaturesBucket = []byte("signatures")
+)
+
+// SlashingProtection is a persistent record of the consensus payloads signed by a validator.
+// It refuses to sign two different payloads of the same kind for the same height and round
+type SlashingProtection struct {
+	db *bolt.DB
+}
+
+// NewSlashingProtection opens (or creates) the slashing protection database at the given path
+func NewSlashingProtection(path string) (*SlashingProtection, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(signaturesBucket)
+
+		return err
+	}); err != nil {
+		return nil, fmt.Errorf("failed to create bucket=%s: %w", string(signaturesBucket), err)
+	}
+
+	return &SlashingProtection{db: db}, nil
+}
+
+// Check records the request payload and returns ErrSlashingProtection if a different payload
+// was already signed for the same height and round. Requests which are not bound to a consensus
+// view (transactions, BLS digests of the allowed domains and non-voting IBFT messages) are allowed,
+// the other requests are refused with ErrRequestNotAllowed
+func (s *SlashingProtection) Check(req *wallet.SignRequest) error {
+	if err := checkAllowed(req); err != nil {
+		return err
+	}
+
+	key, view, err := slashingKey(req)
+	if err != nil || key == nil {
+		return err
+	}
+
+	payloadHash := crypto.Keccak256(req.Payload)
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(signaturesBucket)
+
+		if signed := bucket.Get(key); signed != nil {
+			if !bytes.Equal(signed, payloadHash) {
+				return fmt.Errorf("%w: height=%d round=%d", ErrSlashingProtection, view.GetHeight(), view.GetRound())
+			}
+
+			return nil
+		}
+
+		return bucket.Put(key, payloadHash)
+	})
+}
+
+// Close closes the slashing protection database
+func (s *SlashingProtection) Close() error {
+	return s.db.Close()
+}
+
+// checkAllowed returns ErrRequestNotAllowed if the request kind is unknown or its BLS domain isn't allowed.
+// The transactions are always allowed, the signer hashes them itself
+func checkAllowed(req *wallet.SignRequest) error {
+	switch req.Kind {
+	case wallet.SignKindIBFTMessage, wallet.SignKindTransaction:
+		return nil
+	case wallet.SignKindCommittedSeal:
+		if !bytes.Equal(req.Domain, signer.DomainCheckpointManager) {
+			return fmt.Errorf("%w: committed seal of an unknown domain", ErrRequestNotAllowed)
+		}
+
+		return nil
+	case wallet.SignKindBLS:
+		for _, domain := range wallet.AllowedBLSDomains {
+			if bytes.Equal(req.Domain, domain) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("%w: bls domain %x", ErrRequestNotAllowed, req.Domain)
+	default:
+		return fmt.Errorf("%w: unknown kind %s", ErrRequestNotAllowed, req.Kind)
+	}
+}
+
+// slashingKey returns the database key of a request bound to a consensus view along with the view,
+// or nil if the request is not subject to slashing protection. The view is taken from the signed payload
+func slashingKey(req *wallet.SignRequest) ([]byte, *proto.View, error) {
+	var (
+		msgType proto.MessageType
+		view    *proto.View
+	)
+
+	switch req.Kind {
+	case wallet.SignKindCommittedSeal:
+		commitView, _, err := wallet.UnmarshalCommittedSeal(req.Payload)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		view = commitView
+	case wallet.SignKindIBFTMessage:
+		msg := &proto.Message{}
+		if err := protobuf.Unmarshal(req.Payload, msg); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal the IBFT message: %w", err)
+		}
+
+		switch msg.Type {
+		case proto.MessageType_PREPREPARE, proto.MessageType_PREPARE, proto.MessageType_COMMIT:
+		default:
+			// round change messages are re-sent with updated certificates
+			return nil, nil, nil
+		}
+
+		msgType = msg.Type
+		view = msg.View
+	default:
+		return nil, nil, nil
+	}
+
+	key := make([]byte, 0, len(req.Kind)+24)
+	key = append(key, req.Kind...)
+	key = append(key, common.EncodeUint64ToBytes(uint64(msgType))...)
+	key = append(key, common.EncodeUint64ToBytes(view.GetHeight())...)
+	key = append(key, common.EncodeUint64ToBytes(view.GetRound())...)
+
+	return key, view, nil
+}
@@ -0,0 +1,113 @@
+package remote
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+)
+
+// NewTestServer starts a local signing service for the given account on a random port
+// and returns the configuration of a client which is allowed to connect to it.
+// The certificates and the slashing protection database are created in a temporary directory
+func NewTestServer(tb testing.TB, account *wallet.Account) *Config {
+	tb.Helper()
+
+	dir := tb.TempDir()
+
+	caCert, caKey := generateCertificate(tb, dir, "ca", nil, nil)
+	generateCertificate(tb, dir, "server", caCert, caKey)
+	generateCertificate(tb, dir, "client", caCert, caKey)
+
+	tlsConfig, err := ServerTLSConfig(
+		filepath.Join(dir, "ca.crt"), filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key"))
+	require.NoError(tb, err)
+
+	protection, err := NewSlashingProtection(filepath.Join(dir, "slashing.db"))
+	require.NoError(tb, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(tb, err)
+
+	server := NewServer(hclog.NewNullLogger(), wallet.NewLocalSigner(account), protection)
+
+	go func() {
+		_ = server.Serve(listener, tlsConfig)
+	}()
+
+	tb.Cleanup(func() {
+		_ = server.Close()
+	})
+
+	return &Config{
+		URL:    "https://" + listener.Addr().String(),
+		CACert: filepath.Join(dir, "ca.crt"),
+		Cert:   filepath.Join(dir, "client.crt"),
+		Key:    filepath.Join(dir, "client.key"),
+	}
+}
+
+// generateCertificate writes a certificate and its key to <dir>/<name>.crt and <dir>/<name>.key.
+// The certificate is a self-signed CA if no parent is given
+func generateCertificate(
+	tb testing.TB, dir, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey,
+) (*x509.Certificate, *ecdsa.PrivateKey) {
+	tb.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(tb, err)
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	require.NoError(tb, err)
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(tb, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(tb, err)
+
+	require.NoError(tb, os.WriteFile(filepath.Join(dir, name+".crt"),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(tb, os.WriteFile(filepath.Join(dir, name+".key"),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(tb, err)
+
+	// make sure the written pair is loadable
+	_, err = tls.LoadX509KeyPair(filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key"))
+	require.NoError(tb, err)
+
+	return cert, key
+}
@@ -0,0 +1,150 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Hydra-Chain/go-ibft/messages/proto"
+	"github.com/umbracle/ethgo"
+	ethgow "github.com/umbracle/ethgo/wallet"
+	protobuf "google.golang.org/protobuf/proto"
+
+	"github.com/0xPolygon/polygon-edge/bls"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/signer"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// SignKind is the kind of the payload a signer is asked to sign
+type SignKind string
+
+const (
+	// SignKindIBFTMessage is a marshaled IBFT consensus message, signed with the ECDSA key
+	SignKindIBFTMessage SignKind = "ibft-message"
+
+	// SignKindCommittedSeal is an unsigned IBFT COMMIT message, whose proposal hash is signed with the BLS key.
+	// The signing services take the view of the committed seal from the message
+	SignKindCommittedSeal SignKind = "committed-seal"
+
+	// SignKindTransaction is an unsigned RLP encoded transaction, which the signer hashes (EIP-155)
+	// for the chain id of the request and signs with the ECDSA key. The signed transaction is returned
+	SignKindTransaction SignKind = "transaction"
+
+	// SignKindBLS is a digest signed with the BLS key and the request domain,
+	// the signing services sign only the domains of AllowedBLSDomains
+	SignKindBLS SignKind = "bls"
+)
+
+var (
+	errUnknownSignKind      = errors.New("unknown sign kind")
+	errInvalidCommittedSeal = errors.New("committed seal payload is not a COMMIT message")
+)
+
+// AllowedBLSDomains are the domains of the SignKindBLS requests. The committed seals (DomainCheckpointManager)
+// are signed only as SignKindCommittedSeal, which is bound to the consensus view
+var AllowedBLSDomains = [][]byte{signer.DomainHydraChain, signer.DomainCommonSigning}
+
+// SignRequest is a request to sign a payload with one of the validator keys
+type SignRequest struct {
+	Kind SignKind `json:"kind"`
+
+	// Domain is the BLS signing domain
+	Domain []byte `json:"domain,omitempty"`
+
+	// ChainID is the chain the transaction is signed for (transactions only)
+	ChainID uint64 `json:"chainId,omitempty"`
+
+	Payload []byte `json:"payload"`
+}
+
+// Signer signs payloads on behalf of a validator. The private keys are never exposed by it,
+// which allows them to be kept in a separate signing service
+type Signer interface {
+	// Address returns the validator (ECDSA) address
+	Address() types.Address
+
+	// BLSPublicKey returns the validator BLS public key
+	BLSPublicKey() *bls.PublicKey
+
+	// Sign signs the payload of the request with the key matching the request kind.
+	// It returns the signature, or the signed transaction (RLP encoded) of a SignKindTransaction request
+	Sign(req *SignRequest) ([]byte, error)
+}
+
+var _ Signer = (*LocalSigner)(nil)
+
+// LocalSigner signs with the validator keys held in process memory
+type LocalSigner struct {
+	account *Account
+}
+
+// NewLocalSigner creates a signer backed by the given account
+func NewLocalSigner(account *Account) *LocalSigner {
+	return &LocalSigner{account: account}
+}
+
+// Address returns the validator (ECDSA) address
+func (s *LocalSigner) Address() types.Address {
+	return s.account.Address()
+}
+
+// BLSPublicKey returns the validator BLS public key
+func (s *LocalSigner) BLSPublicKey() *bls.PublicKey {
+	return s.account.Bls.PublicKey()
+}
+
+// Sign signs the payload of the request with the key matching the request kind
+func (s *LocalSigner) Sign(req *SignRequest) ([]byte, error) {
+	switch req.Kind {
+	case SignKindIBFTMessage:
+		return s.account.Ecdsa.Sign(crypto.Keccak256(req.Payload))
+	case SignKindTransaction:
+		txn := &ethgo.Transaction{}
+		if err := txn.UnmarshalRLP(req.Payload); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal the transaction: %w", err)
+		}
+
+		signed, err := ethgow.NewEIP155Signer(req.ChainID).SignTx(txn, s.account.Ecdsa)
+		if err != nil {
+			return nil, err
+		}
+
+		return signed.MarshalRLPTo(nil)
+	case SignKindCommittedSeal:
+		_, proposalHash, err := UnmarshalCommittedSeal(req.Payload)
+		if err != nil {
+			return nil, err
+		}
+
+		return s.signBLS(proposalHash, req.Domain)
+	case SignKindBLS:
+		return s.signBLS(req.Payload, req.Domain)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownSignKind, req.Kind)
+	}
+}
+
+func (s *LocalSigner) signBLS(digest, domain []byte) ([]byte, error) {
+	signature, err := s.account.Bls.Sign(digest, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return signature.Marshal()
+}
+
+// UnmarshalCommittedSeal returns the view and the proposal hash
+// of the COMMIT message carried by a SignKindCommittedSeal request
+func UnmarshalCommittedSeal(payload []byte) (*proto.View, []byte, error) {
+	msg := &proto.Message{}
+	if err := protobuf.Unmarshal(payload, msg); err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", errInvalidCommittedSeal, err)
+	}
+
+	commitData := msg.GetCommitData()
+	if msg.Type != proto.MessageType_COMMIT || msg.View == nil || len(commitData.GetProposalHash()) == 0 {
+		return nil, nil, errInvalidCommittedSeal
+	}
+
+	return msg.View, commitData.GetProposalHash(), nil
+}
@@ -0,0 +1,366 @@
+// Package devnet runs a local Hydra network whose validators are child processes of the hydra binary
+// listening on loopback ports. It is used by the devnet command and can be embedded in end-to-end tests.
+package devnet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// BinaryEnv is the environment variable with the path of the hydra binary running the nodes.
+	// It is used when the binary isn't configured, e.g. by the tests which can't run their own executable
+	BinaryEnv = "HYDRA_BINARY"
+
+	// CoinGeckoAPIKeyEnv is the environment variable with the CoinGecko API key,
+	// which is used when the key isn't configured
+	CoinGeckoAPIKeyEnv = "COINGECKO_API_KEY"
+
+	// MinValidators is the smallest validator set which doesn't stall when a validator misses
+	// the commit of a block, since the signatures of the parent block must reach the quorum
+	MinValidators = 4
+
+	DefaultValidators    = 4
+	DefaultEpochSize     = uint64(10)
+	DefaultBlockTime     = time.Second
+	DefaultBlockGasLimit = uint64(30_000_000)
+	DefaultLogLevel      = "INFO"
+
+	nodeDirPrefix       = "node-"
+	genesisFileName     = "genesis.json"
+	secretsConfigName   = "secretsManagerConfig.json"
+	nodeLogName         = "node.log"
+	blockPollInterval   = 500 * time.Millisecond
+	addrPollInterval    = 100 * time.Millisecond
+	loopbackAnyPort     = "127.0.0.1:0"
+	nodeShutdownTimeout = 10 * time.Second
+)
+
+var (
+	// ErrNotEnoughValidators is returned when the devnet has less than MinValidators validators
+	ErrNotEnoughValidators = fmt.Errorf("at least %d validators are required to produce blocks", MinValidators)
+
+	// ErrNoCoinGeckoAPIKey is returned when the CoinGecko API key is neither configured nor in the environment
+	ErrNoCoinGeckoAPIKey = fmt.Errorf("the CoinGecko API key is required, set it or the %s variable", CoinGeckoAPIKeyEnv)
+)
+
+// Config is the configuration of a devnet
+type Config struct {
+	// Binary is the hydra binary running the nodes,
+	// the HYDRA_BINARY environment variable or the current executable by default
+	Binary string
+	// Dir is the directory of the secrets, the genesis and the logs of the nodes.
+	// A temporary directory removed on Stop is used by default
+	Dir string
+
+	Validators    int
+	ChainID       uint64
+	EpochSize     uint64
+	BlockTime     time.Duration
+	BlockGasLimit uint64
+	// Premine are the premined accounts in the genesis format <address>[:<balance>]
+	Premine  []string
+	LogLevel string
+	// CoinGeckoAPIKey is the API key of the price history of the genesis and of the price oracle of the nodes,
+	// the COINGECKO_API_KEY environment variable by default
+	CoinGeckoAPIKey string
+}
+
+func (c *Config) setDefaults() error {
+	if c.Binary == "" {
+		c.Binary = os.Getenv(BinaryEnv)
+	}
+
+	if c.Binary == "" {
+		executable, err := os.Executable()
+		if err != nil {
+			return fmt.Errorf("failed to find the hydra binary: %w", err)
+		}
+
+		c.Binary = executable
+	}
+
+	if c.CoinGeckoAPIKey == "" {
+		c.CoinGeckoAPIKey = os.Getenv(CoinGeckoAPIKeyEnv)
+	}
+
+	if c.CoinGeckoAPIKey == "" {
+		return ErrNoCoinGeckoAPIKey
+	}
+
+	if c.Validators == 0 {
+		c.Validators = DefaultValidators
+	}
+
+	if c.Validators < MinValidators {
+		return ErrNotEnoughValidators
+	}
+
+	if c.ChainID == 0 {
+		c.ChainID = command.DefaultChainID
+	}
+
+	if c.EpochSize == 0 {
+		c.EpochSize = DefaultEpochSize
+	}
+
+	if c.BlockTime == 0 {
+		c.BlockTime = DefaultBlockTime
+	}
+
+	if c.BlockGasLimit == 0 {
+		c.BlockGasLimit = DefaultBlockGasLimit
+	}
+
+	if c.LogLevel == "" {
+		c.LogLevel = DefaultLogLevel
+	}
+
+	return nil
+}
+
+// Devnet is a running local network
+type Devnet struct {
+	config  Config
+	dir     string
+	tempDir bool
+	nodes   []*Node
+}
+
+// Start generates the secrets of the validators and the genesis, starts the nodes and waits for the end
+// of the first epoch. The network is stopped if it doesn't start
+func Start(ctx context.Context, config Config) (*Devnet, error) {
+	if err := config.setDefaults(); err != nil {
+		return nil, err
+	}
+
+	d := &Devnet{config: config, dir: config.Dir}
+
+	if d.dir == "" {
+		dir, err := os.MkdirTemp("", "hydra-devnet-")
+		if err != nil {
+			return nil, err
+		}
+
+		d.dir, d.tempDir = dir, true
+	} else if err := os.MkdirAll(d.dir, 0700); err != nil {
+		return nil, err
+	}
+
+	if err := d.start(ctx); err != nil {
+		_ = d.Stop()
+
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func (d *Devnet) start(ctx context.Context) error {
+	for i := 0; i < d.config.Validators; i++ {
+		node, err := d.initNode(ctx, i+1)
+		if err != nil {
+			return err
+		}
+
+		d.nodes = append(d.nodes, node)
+	}
+
+	if err := d.generateGenesis(ctx); err != nil {
+		return err
+	}
+
+	// the nodes are started one by one, since every node connects to the libp2p addresses
+	// the nodes before it reported
+	staticPeers := make([]string, 0, len(d.nodes))
+
+	for _, node := range d.nodes {
+		if err := node.start(d.config.Binary, d.GenesisPath(), d.config.LogLevel, staticPeers); err != nil {
+			return fmt.Errorf("failed to start %s: %w", node.Name, err)
+		}
+
+		if err := node.waitForAddrs(ctx); err != nil {
+			return err
+		}
+
+		staticPeers = append(staticPeers, node.LibP2PAddr)
+	}
+
+	// the first epoch ends with the first commit of the validator set
+	return d.WaitForBlock(ctx, d.config.EpochSize)
+}
+
+// initNode generates the secrets of a validator and its secrets manager configuration
+func (d *Devnet) initNode(ctx context.Context, index int) (*Node, error) {
+	node := &Node{
+		Name: fmt.Sprintf("%s%d", nodeDirPrefix, index),
+	}
+
+	node.DataDir = filepath.Join(d.dir, node.Name)
+
+	output, err := d.run(ctx,
+		"secrets", "init",
+		"--data-dir", node.DataDir,
+		"--chain-id", strconv.FormatUint(d.config.ChainID, 10),
+		"--insecure",
+		"--json",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the secrets of %s: %w", node.Name, err)
+	}
+
+	var results []struct {
+		Address types.Address `json:"address"`
+		NodeID  string        `json:"node_id"`
+	}
+
+	if err := json.Unmarshal(output, &results); err != nil || len(results) != 1 {
+		return nil, fmt.Errorf("failed to read the secrets of %s: %s", node.Name, output)
+	}
+
+	node.Address = results[0].Address
+	node.NodeID = results[0].NodeID
+
+	// the price oracle of the node requires the CoinGecko key
+	secretsConfig := &secrets.SecretsManagerConfig{
+		Type: secrets.Local,
+		Name: node.Name,
+		Extra: map[string]interface{}{
+			secrets.CoinGeckoAPIKey: d.config.CoinGeckoAPIKey,
+		},
+	}
+
+	if err := secretsConfig.WriteConfig(node.secretsConfigPath()); err != nil {
+		return nil, fmt.Errorf("failed to write the secrets configuration of %s: %w", node.Name, err)
+	}
+
+	return node, nil
+}
+
+func (d *Devnet) generateGenesis(ctx context.Context) error {
+	// the first validator is the governance, so it owns the native token and administers the proxies
+	admin := d.nodes[0].Address.String()
+
+	args := []string{
+		"genesis",
+		"--consensus", "polybft",
+		"--dir", d.GenesisPath(),
+		"--name", "devnet",
+		"--chain-id", strconv.FormatUint(d.config.ChainID, 10),
+		"--validators-path", d.dir,
+		"--validators-prefix", nodeDirPrefix,
+		"--epoch-size", strconv.FormatUint(d.config.EpochSize, 10),
+		"--block-time", d.config.BlockTime.String(),
+		"--block-gas-limit", strconv.FormatUint(d.config.BlockGasLimit, 10),
+		"--native-token-config", "Hydra:HYDRA:18:true:" + admin,
+		"--proxy-contracts-admin", admin,
+		// the price history is fetched with the CoinGecko key of the secrets configuration
+		"--secrets-config", d.nodes[0].secretsConfigPath(),
+	}
+
+	for _, premine := range d.config.Premine {
+		args = append(args, "--premine", premine)
+	}
+
+	if _, err := d.run(ctx, args...); err != nil {
+		return fmt.Errorf("failed to generate the genesis: %w", err)
+	}
+
+	return nil
+}
+
+// run runs a hydra command and returns its standard output
+func (d *Devnet) run(ctx context.Context, args ...string) ([]byte, error) {
+	output, err := exec.CommandContext(ctx, d.config.Binary, args...).Output() //nolint:gosec
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) != 0 {
+			return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// WaitForBlock waits until all the nodes reach the given block
+func (d *Devnet) WaitForBlock(ctx context.Context, number uint64) error {
+	ticker := time.NewTicker(blockPollInterval)
+	defer ticker.Stop()
+
+	for {
+		reached := true
+
+		for _, node := range d.nodes {
+			if err := node.exitErr(); err != nil {
+				return err
+			}
+
+			if current, err := node.BlockNumber(); err != nil || current < number {
+				reached = false
+			}
+		}
+
+		if reached {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("block %d not reached by all the nodes: %w", number, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// Stop stops the nodes and removes the temporary directory of the devnet
+func (d *Devnet) Stop() error {
+	var errs []error
+
+	for _, node := range d.nodes {
+		if err := node.stop(nodeShutdownTimeout); err != nil {
+			errs = append(errs, fmt.Errorf("failed to stop %s: %w", node.Name, err))
+		}
+	}
+
+	if d.tempDir {
+		if err := os.RemoveAll(d.dir); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Nodes returns the validator nodes of the devnet
+func (d *Devnet) Nodes() []*Node {
+	return d.nodes
+}
+
+// Dir returns the directory of the secrets, the genesis and the logs of the nodes
+func (d *Devnet) Dir() string {
+	return d.dir
+}
+
+// GenesisPath returns the path of the devnet genesis
+func (d *Devnet) GenesisPath() string {
+	return filepath.Join(d.dir, genesisFileName)
+}
+
+// ChainID returns the chain ID of the devnet
+func (d *Devnet) ChainID() uint64 {
+	return d.config.ChainID
+}
@@ -0,0 +1,50 @@
+package devnet
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_setDefaults(t *testing.T) {
+	t.Setenv(CoinGeckoAPIKeyEnv, "")
+
+	config := Config{Binary: "hydra"}
+	require.ErrorIs(t, config.setDefaults(), ErrNoCoinGeckoAPIKey)
+
+	config = Config{Binary: "hydra", CoinGeckoAPIKey: "key"}
+	require.NoError(t, config.setDefaults())
+	require.Equal(t, DefaultValidators, config.Validators)
+	require.Equal(t, DefaultEpochSize, config.EpochSize)
+	require.Equal(t, DefaultBlockTime, config.BlockTime)
+
+	config = Config{Binary: "hydra", CoinGeckoAPIKey: "key", Validators: MinValidators - 1}
+	require.ErrorIs(t, config.setDefaults(), ErrNotEnoughValidators)
+}
+
+func TestDevnet(t *testing.T) {
+	for _, env := range []string{BinaryEnv, CoinGeckoAPIKeyEnv} {
+		if os.Getenv(env) == "" {
+			t.Skipf("%s is not set", env)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Minute)
+	defer cancel()
+
+	epochSize := uint64(5)
+
+	network, err := Start(ctx, Config{EpochSize: epochSize})
+	require.NoError(t, err)
+
+	defer func() {
+		require.NoError(t, network.Stop())
+		require.NoDirExists(t, network.Dir())
+	}()
+
+	require.Len(t, network.Nodes(), DefaultValidators)
+	require.NoError(t, network.WaitForBlock(ctx, 2*epochSize))
+}
@@ -0,0 +1,214 @@
+package devnet
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/umbracle/ethgo/jsonrpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server"
+	"github.com/0xPolygon/polygon-edge/server/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Node is a validator node of the devnet running in a child process
+type Node struct {
+	Name    string
+	DataDir string
+	Address types.Address
+	NodeID  string
+
+	// the nodes listen on the port 0, so the addresses are reported by the node once it runs
+	LibP2PAddr  string
+	JSONRPCAddr string
+
+	cmd    *exec.Cmd
+	client *jsonrpc.Client
+	exited chan struct{}
+
+	lock    sync.Mutex
+	stopped bool
+	err     error
+}
+
+// JSONRPCURL returns the URL of the JSON-RPC endpoint of the node
+func (n *Node) JSONRPCURL() string {
+	return fmt.Sprintf("http://%s", n.JSONRPCAddr)
+}
+
+// IPCPath returns the unix socket of the JSON-RPC and the admin services of the node
+func (n *Node) IPCPath() string {
+	return filepath.Join(n.DataDir, server.IPCFileName)
+}
+
+// LogPath returns the file the output of the node is written to
+func (n *Node) LogPath() string {
+	return filepath.Join(n.DataDir, nodeLogName)
+}
+
+// BlockNumber returns the latest block of the node
+func (n *Node) BlockNumber() (uint64, error) {
+	if n.client == nil {
+		client, err := jsonrpc.NewClient(n.JSONRPCURL())
+		if err != nil {
+			return 0, err
+		}
+
+		n.client = client
+	}
+
+	return n.client.Eth().BlockNumber()
+}
+
+func (n *Node) secretsConfigPath() string {
+	return filepath.Join(n.DataDir, secretsConfigName)
+}
+
+// start runs the node on free loopback ports. The node doesn't discover the other nodes,
+// it keeps connected to the static peers, the nodes started before it
+func (n *Node) start(binary, genesisPath, logLevel string, staticPeers []string) error {
+	logFile, err := os.Create(n.LogPath())
+	if err != nil {
+		return err
+	}
+
+	args := []string{
+		"server",
+		"--data-dir", n.DataDir,
+		"--chain", genesisPath,
+		"--secrets-config", n.secretsConfigPath(),
+		"--libp2p", loopbackAnyPort,
+		"--jsonrpc", loopbackAnyPort,
+		"--" + command.NoDiscoverFlag,
+		"--log-level", logLevel,
+		"--seal",
+	}
+
+	for _, peer := range staticPeers {
+		args = append(args, "--static-peers", peer)
+	}
+
+	n.cmd = exec.Command(binary, args...) //nolint:gosec
+	n.cmd.Stdout = logFile
+	n.cmd.Stderr = logFile
+	detach(n.cmd)
+
+	if err := n.cmd.Start(); err != nil {
+		_ = logFile.Close()
+
+		return err
+	}
+
+	n.exited = make(chan struct{})
+
+	go func() {
+		err := n.cmd.Wait()
+		_ = logFile.Close()
+
+		n.lock.Lock()
+		n.err = err
+		n.lock.Unlock()
+
+		close(n.exited)
+	}()
+
+	return nil
+}
+
+// waitForAddrs waits until the node reports the addresses its libp2p and JSON-RPC servers listen on
+func (n *Node) waitForAddrs(ctx context.Context) error {
+	conn, err := helper.GetGRPCConnection(helper.GRPCEndpoint{IPCPath: n.IPCPath()})
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	client := proto.NewSystemClient(conn)
+
+	ticker := time.NewTicker(addrPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := n.exitErr(); err != nil {
+			return err
+		}
+
+		// the status fails until the node listens on its ipc socket
+		if status, err := client.GetStatus(ctx, &emptypb.Empty{}); err == nil &&
+			status.P2PAddr != "" && status.JsonrpcAddr != "" {
+			n.LibP2PAddr, n.JSONRPCAddr = status.P2PAddr, status.JsonrpcAddr
+
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s didn't report its addresses: %w", n.Name, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// exitErr returns an error if the node exited before it was stopped
+func (n *Node) exitErr() error {
+	select {
+	case <-n.exited:
+	default:
+		return nil
+	}
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.stopped {
+		return nil
+	}
+
+	return fmt.Errorf("%s exited (%v), see %s", n.Name, n.err, n.LogPath())
+}
+
+// stop interrupts the node and kills it if it doesn't shut down in time
+func (n *Node) stop(timeout time.Duration) error {
+	if n.client != nil {
+		_ = n.client.Close()
+	}
+
+	if n.cmd == nil || n.cmd.Process == nil {
+		return nil
+	}
+
+	n.lock.Lock()
+	n.stopped = true
+	n.lock.Unlock()
+
+	select {
+	case <-n.exited:
+		return nil
+	default:
+	}
+
+	if err := n.cmd.Process.Signal(os.Interrupt); err == nil {
+		select {
+		case <-n.exited:
+			return nil
+		case <-time.After(timeout):
+		}
+	}
+
+	if err := n.cmd.Process.Kill(); err != nil {
+		return err
+	}
+
+	<-n.exited
+
+	return nil
+}
@@ -0,0 +1,15 @@
+//go:build !windows
+// +build !windows
+
+package devnet
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// detach runs the node in its own process group, so the Ctrl+C of the terminal is handled by the devnet,
+// which stops the nodes, instead of interrupting the nodes directly
+func detach(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
@@ -0,0 +1,11 @@
+//go:build windows
+// +build windows
+
+package devnet
+
+import (
+	"os/exec"
+)
+
+// detach is a no-op, the nodes are stopped with the devnet
+func detach(cmd *exec.Cmd) {}
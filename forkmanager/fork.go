@@ -45,6 +45,10 @@ type ForkParams struct {
 
 	// BlockTimeDrift defines the time slot in which a new block can be created
 	BlockTimeDrift *uint64 `json:"blockTimeDrift,omitempty"`
+
+	// TxOrderingPolicy defines how the txpool orders executable transactions
+	// when a block is built (price, fifo, fair or priority)
+	TxOrderingPolicy *string `json:"txOrderingPolicy,omitempty"`
 }
 
 // Copy creates a deep copy of ForkParams
@@ -55,13 +59,21 @@ func (fp *ForkParams) Copy() *ForkParams {
 	blockTime := *fp.BlockTime
 	blockTimeDrift := *fp.BlockTimeDrift
 
-	return &ForkParams{
+	params := &ForkParams{
 		MaxValidatorSetSize: &maxValSetSize,
 		EpochSize:           &epochSize,
 		SprintSize:          &sprintSize,
 		BlockTime:           &blockTime,
 		BlockTimeDrift:      &blockTimeDrift,
 	}
+
+	// tx ordering policy is optional and falls back to the txpool default
+	if fp.TxOrderingPolicy != nil {
+		txOrderingPolicy := *fp.TxOrderingPolicy
+		params.TxOrderingPolicy = &txOrderingPolicy
+	}
+
+	return params
 }
 
 // forkHandler defines one custom handler
@@ -41,6 +41,7 @@ func (fm *forkManager) Clear() {
 
 	fm.forkMap = map[string]*Fork{}
 	fm.handlersMap = map[HandlerDesc][]forkHandler{}
+	fm.params = nil
 }
 
 // RegisterFork registers fork by its name
@@ -157,9 +157,15 @@ func (g *GasHelper) MaxPriorityFeePerGas() (*big.Int, error) {
 				continue
 			}
 
-			sender, err := signer.Sender(tx)
-			if err != nil {
-				return fmt.Errorf("could not get sender of transaction: %s. Error: %w", tx.Hash, err)
+			// the sender of the sealed transactions is usually recovered already,
+			// the ones impersonated with the dev consensus can't be recovered at all
+			sender := tx.From
+			if sender == types.ZeroAddress {
+				var err error
+
+				if sender, err = signer.Sender(tx); err != nil {
+					return fmt.Errorf("could not get sender of transaction: %s. Error: %w", tx.Hash, err)
+				}
 			}
 
 			if sender != blockMiner {
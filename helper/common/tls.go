@@ -0,0 +1,61 @@
+package common
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+var errInvalidCACert = errors.New("failed to parse the CA certificate")
+
+// ClientMTLSConfig returns the mTLS configuration of a client,
+// which verifies the server certificate against the given CA
+func ClientMTLSConfig(caCertPath, certPath, keyPath string) (*tls.Config, error) {
+	cert, caPool, err := loadCertificates(caCertPath, certPath, keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      caPool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// ServerMTLSConfig returns the mTLS configuration of a server,
+// which accepts only clients with a certificate issued by the given CA
+func ServerMTLSConfig(caCertPath, certPath, keyPath string) (*tls.Config, error) {
+	cert, caPool, err := loadCertificates(caCertPath, certPath, keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    caPool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func loadCertificates(caCertPath, certPath, keyPath string) (tls.Certificate, *x509.CertPool, error) {
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to load the certificate: %w", err)
+	}
+
+	caCert, err := os.ReadFile(caCertPath)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed to read the CA certificate: %w", err)
+	}
+
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caCert) {
+		return tls.Certificate{}, nil, errInvalidCACert
+	}
+
+	return cert, caPool, nil
+}
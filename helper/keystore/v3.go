@@ -0,0 +1,286 @@
+package keystore
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// KDF is the key derivation function used to encrypt a keystore v3 file
+type KDF string
+
+const (
+	KDFScrypt KDF = "scrypt"
+	KDFPBKDF2 KDF = "pbkdf2"
+
+	keystoreVersion = 3
+	cipherAES128CTR = "aes-128-ctr"
+	prfHMACSHA256   = "hmac-sha256"
+
+	// key derivation parameters of go-ethereum's standard keystore
+	scryptN     = 1 << 18
+	scryptR     = 8
+	scryptP     = 1
+	pbkdf2C     = 262144
+	derivedKLen = 32
+
+	// the upper bounds of the key derivation parameters read from a file, so a crafted keystore
+	// can't exhaust the memory or the CPU. scrypt takes 128 * n * r bytes, 1 GiB at most
+	maxScryptN     = 1 << 20
+	maxScryptR     = 8
+	maxScryptP     = 16
+	maxPBKDF2C     = 1 << 22
+	maxDerivedKLen = 64
+)
+
+var (
+	ErrDecrypt            = errors.New("could not decrypt key with given password")
+	errUnsupportedVersion = errors.New("unsupported keystore version")
+	errUnsupportedCipher  = errors.New("unsupported keystore cipher")
+	errUnsupportedKDF     = errors.New("unsupported keystore key derivation function")
+	errUnsupportedPRF     = errors.New("unsupported pbkdf2 pseudo-random function")
+	errInvalidIV          = errors.New("invalid keystore cipher iv length")
+	errInvalidKDFParam    = errors.New("invalid keystore key derivation parameter")
+)
+
+// keyJSONV3 is the Web3 Secret Storage (keystore v3) file format
+type keyJSONV3 struct {
+	Address string     `json:"address"`
+	Crypto  cryptoJSON `json:"crypto"`
+	ID      string     `json:"id"`
+	Version int        `json:"version"`
+}
+
+type cryptoJSON struct {
+	Cipher       string                 `json:"cipher"`
+	CipherText   string                 `json:"ciphertext"`
+	CipherParams cipherParamsJSON       `json:"cipherparams"`
+	KDF          KDF                    `json:"kdf"`
+	KDFParams    map[string]interface{} `json:"kdfparams"`
+	MAC          string                 `json:"mac"`
+}
+
+type cipherParamsJSON struct {
+	IV string `json:"iv"`
+}
+
+// EncryptV3 encrypts the raw private key of the given address into a keystore v3 JSON
+// compatible with go-ethereum's keystore and the wallets using it
+func EncryptV3(privateKey []byte, address types.Address, password string, kdf KDF) ([]byte, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	var kdfParams map[string]interface{}
+
+	switch kdf {
+	case KDFScrypt:
+		kdfParams = map[string]interface{}{
+			"n":     scryptN,
+			"r":     scryptR,
+			"p":     scryptP,
+			"dklen": derivedKLen,
+			"salt":  hex.EncodeToString(salt),
+		}
+	case KDFPBKDF2:
+		kdfParams = map[string]interface{}{
+			"c":     pbkdf2C,
+			"prf":   prfHMACSHA256,
+			"dklen": derivedKLen,
+			"salt":  hex.EncodeToString(salt),
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedKDF, kdf)
+	}
+
+	derivedKey, err := deriveKey(kdf, kdfParams, password)
+	if err != nil {
+		return nil, err
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+
+	cipherText, err := aesCTRXOR(derivedKey[:16], privateKey, iv)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&keyJSONV3{
+		Address: hex.EncodeToString(address.Bytes()),
+		Crypto: cryptoJSON{
+			Cipher:       cipherAES128CTR,
+			CipherText:   hex.EncodeToString(cipherText),
+			CipherParams: cipherParamsJSON{IV: hex.EncodeToString(iv)},
+			KDF:          kdf,
+			KDFParams:    kdfParams,
+			MAC:          hex.EncodeToString(keccak256(derivedKey[16:32], cipherText)),
+		},
+		ID:      uuid.New().String(),
+		Version: keystoreVersion,
+	})
+}
+
+// DecryptV3 decrypts the keystore v3 JSON and returns the raw private key and the address stored in the file
+func DecryptV3(keyJSON []byte, password string) ([]byte, types.Address, error) {
+	var k keyJSONV3
+	if err := json.Unmarshal(keyJSON, &k); err != nil {
+		return nil, types.ZeroAddress, err
+	}
+
+	if k.Version != keystoreVersion {
+		return nil, types.ZeroAddress, fmt.Errorf("%w: %d", errUnsupportedVersion, k.Version)
+	}
+
+	if k.Crypto.Cipher != cipherAES128CTR {
+		return nil, types.ZeroAddress, fmt.Errorf("%w: %s", errUnsupportedCipher, k.Crypto.Cipher)
+	}
+
+	mac, err := hex.DecodeString(k.Crypto.MAC)
+	if err != nil {
+		return nil, types.ZeroAddress, err
+	}
+
+	iv, err := hex.DecodeString(k.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, types.ZeroAddress, err
+	}
+
+	if len(iv) != aes.BlockSize {
+		return nil, types.ZeroAddress, fmt.Errorf("%w: %d", errInvalidIV, len(iv))
+	}
+
+	cipherText, err := hex.DecodeString(k.Crypto.CipherText)
+	if err != nil {
+		return nil, types.ZeroAddress, err
+	}
+
+	derivedKey, err := deriveKey(k.Crypto.KDF, k.Crypto.KDFParams, password)
+	if err != nil {
+		return nil, types.ZeroAddress, err
+	}
+
+	if !bytes.Equal(keccak256(derivedKey[16:32], cipherText), mac) {
+		return nil, types.ZeroAddress, ErrDecrypt
+	}
+
+	privateKey, err := aesCTRXOR(derivedKey[:16], cipherText, iv)
+	if err != nil {
+		return nil, types.ZeroAddress, err
+	}
+
+	return privateKey, types.StringToAddress(k.Address), nil
+}
+
+func deriveKey(kdf KDF, params map[string]interface{}, password string) ([]byte, error) {
+	salt, err := hex.DecodeString(stringParam(params, "salt"))
+	if err != nil {
+		return nil, err
+	}
+
+	dkLen := intParam(params, "dklen")
+	if dkLen < derivedKLen {
+		return nil, fmt.Errorf("derived key length %d is too short", dkLen)
+	}
+
+	if dkLen > maxDerivedKLen {
+		return nil, fmt.Errorf("derived key length %d is too long", dkLen)
+	}
+
+	switch kdf {
+	case KDFScrypt:
+		n, r, p := intParam(params, "n"), intParam(params, "r"), intParam(params, "p")
+
+		if err := checkKDFParam("n", n, maxScryptN); err != nil {
+			return nil, err
+		}
+
+		if err := checkKDFParam("r", r, maxScryptR); err != nil {
+			return nil, err
+		}
+
+		if err := checkKDFParam("p", p, maxScryptP); err != nil {
+			return nil, err
+		}
+
+		return scrypt.Key([]byte(password), salt, n, r, p, dkLen)
+	case KDFPBKDF2:
+		if prf := stringParam(params, "prf"); prf != prfHMACSHA256 {
+			return nil, fmt.Errorf("%w: %s", errUnsupportedPRF, prf)
+		}
+
+		c := intParam(params, "c")
+		if err := checkKDFParam("c", c, maxPBKDF2C); err != nil {
+			return nil, err
+		}
+
+		return pbkdf2.Key([]byte(password), salt, c, dkLen, sha256.New), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedKDF, kdf)
+	}
+}
+
+// checkKDFParam returns an error if the key derivation parameter isn't positive or exceeds its bound
+func checkKDFParam(name string, value, max int) error {
+	if value <= 0 || value > max {
+		return fmt.Errorf("%w: %s = %d, the maximum is %d", errInvalidKDFParam, name, value, max)
+	}
+
+	return nil
+}
+
+func aesCTRXOR(key, input, iv []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make([]byte, len(input))
+	cipher.NewCTR(block, iv).XORKeyStream(output, input)
+
+	return output, nil
+}
+
+func keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, b := range data {
+		h.Write(b)
+	}
+
+	return h.Sum(nil)
+}
+
+// intParam returns the numeric KDF parameter, which is decoded from JSON as float64
+func intParam(params map[string]interface{}, name string) int {
+	switch v := params[name].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	default:
+		return 0
+	}
+}
+
+func stringParam(params map[string]interface{}, name string) string {
+	v, _ := params[name].(string)
+
+	return strings.TrimPrefix(v, "0x")
+}
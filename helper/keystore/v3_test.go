@@ -0,0 +1,125 @@
+package keystore
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	testPassword   = "testpassword"
+	testPrivateKey = "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"
+)
+
+// test vectors of the Web3 Secret Storage definition, as produced by go-ethereum's keystore
+var v3TestVectors = map[string]string{
+	"pbkdf2": `{
+		"crypto": {
+			"cipher": "aes-128-ctr",
+			"cipherparams": {"iv": "6087dab2f9fdbbfaddc31a909735c1e6"},
+			"ciphertext": "5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46",
+			"kdf": "pbkdf2",
+			"kdfparams": {
+				"c": 262144,
+				"dklen": 32,
+				"prf": "hmac-sha256",
+				"salt": "ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"
+			},
+			"mac": "517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"
+		},
+		"id": "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+		"version": 3
+	}`,
+	"scrypt": `{
+		"crypto": {
+			"cipher": "aes-128-ctr",
+			"cipherparams": {"iv": "83dbcc02d8ccb40e466191a123791e0e"},
+			"ciphertext": "d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c",
+			"kdf": "scrypt",
+			"kdfparams": {
+				"dklen": 32,
+				"n": 262144,
+				"p": 8,
+				"r": 1,
+				"salt": "ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"
+			},
+			"mac": "2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"
+		},
+		"id": "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+		"version": 3
+	}`,
+}
+
+func TestDecryptV3_TestVectors(t *testing.T) {
+	t.Parallel()
+
+	for name, keyJSON := range v3TestVectors {
+		keyJSON := keyJSON
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			privateKey, _, err := DecryptV3([]byte(keyJSON), testPassword)
+			require.NoError(t, err)
+			require.Equal(t, testPrivateKey, hex.EncodeToString(privateKey))
+
+			_, _, err = DecryptV3([]byte(keyJSON), "wrong")
+			require.ErrorIs(t, err, ErrDecrypt)
+		})
+	}
+}
+
+func TestEncryptV3_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := hex.DecodeString(testPrivateKey)
+	require.NoError(t, err)
+
+	address := types.StringToAddress("0x008aeeda4d805471df9b2a5b0f38a0c3bcba786b")
+
+	for _, kdf := range []KDF{KDFScrypt, KDFPBKDF2} {
+		keyJSON, err := EncryptV3(privateKey, address, testPassword, kdf)
+		require.NoError(t, err)
+
+		decrypted, decryptedAddress, err := DecryptV3(keyJSON, testPassword)
+		require.NoError(t, err)
+		require.Equal(t, privateKey, decrypted)
+		require.Equal(t, address, decryptedAddress)
+	}
+
+	_, err = EncryptV3(privateKey, address, testPassword, "argon2")
+	require.ErrorIs(t, err, errUnsupportedKDF)
+}
+
+func TestDecryptV3_InvalidIV(t *testing.T) {
+	t.Parallel()
+
+	keyJSON := strings.Replace(v3TestVectors["pbkdf2"], "6087dab2f9fdbbfaddc31a909735c1e6", "6087dab2", 1)
+
+	_, _, err := DecryptV3([]byte(keyJSON), testPassword)
+	require.ErrorIs(t, err, errInvalidIV)
+}
+
+func TestDecryptV3_KDFParamsOutOfBounds(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"scrypt n":     strings.Replace(v3TestVectors["scrypt"], `"n": 262144`, `"n": 1073741824`, 1),
+		"scrypt r":     strings.Replace(v3TestVectors["scrypt"], `"r": 1`, `"r": 1024`, 1),
+		"scrypt p":     strings.Replace(v3TestVectors["scrypt"], `"p": 8`, `"p": 0`, 1),
+		"pbkdf2 c":     strings.Replace(v3TestVectors["pbkdf2"], `"c": 262144`, `"c": 1e18`, 1),
+		"pbkdf2 dklen": strings.Replace(v3TestVectors["pbkdf2"], `"dklen": 32`, `"dklen": 1e9`, 1),
+	}
+
+	for name, keyJSON := range cases {
+		_, _, err := DecryptV3([]byte(keyJSON), testPassword)
+		require.Error(t, err, name)
+	}
+
+	_, _, err := DecryptV3([]byte(cases["scrypt n"]), testPassword)
+	require.ErrorIs(t, err, errInvalidKDFParam)
+}
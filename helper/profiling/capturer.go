@@ -0,0 +1,267 @@
+package profiling
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// ReasonBlockLatency is the reason of the captures triggered by a slow block
+	ReasonBlockLatency = "block-latency"
+
+	// ReasonRoundChange is the reason of the captures triggered by the round changes of a height
+	ReasonRoundChange = "round-change"
+
+	// checkInterval is the interval the thresholds are checked at
+	checkInterval = time.Second
+
+	// captureCooldown is the minimum time between two captures,
+	// so a long stall doesn't rotate out the profiles of its beginning
+	captureCooldown = 5 * time.Minute
+)
+
+// Config is the configuration of the automatic profile capture
+type Config struct {
+	// Dir is the directory the profiles are captured to
+	Dir string
+
+	// BlockLatency triggers a capture when no block is added to the chain for longer, zero disables it
+	BlockLatency time.Duration
+
+	// Round triggers a capture when the consensus of a height reaches the round, zero disables it
+	Round uint64
+
+	// CPUDuration is the duration of the CPU profile
+	CPUDuration time.Duration
+
+	// MaxCaptures is the number of the captures kept, the oldest ones are removed
+	MaxCaptures int
+}
+
+// Enabled returns true if any of the thresholds is set
+func (c *Config) Enabled() bool {
+	return c.BlockLatency > 0 || c.Round > 0
+}
+
+// HeadStore returns the head of the chain
+type HeadStore interface {
+	Header() *types.Header
+}
+
+// RoundStore returns the height and the round the consensus is running
+type RoundStore interface {
+	CurrentRound() (uint64, uint64)
+}
+
+// Capturer captures the CPU, heap and goroutine profiles of the node
+// when the block production is slower than the thresholds
+type Capturer struct {
+	logger hclog.Logger
+	config *Config
+
+	head   HeadStore
+	rounds RoundStore
+
+	// headNumber is the number of the head and headTime the time the node added it
+	headNumber uint64
+	headTime   time.Time
+
+	lastCapture time.Time
+
+	closeCh chan struct{}
+	doneCh  chan struct{}
+}
+
+// NewCapturer creates the capturer, the rounds aren't checked if the round store is nil
+func NewCapturer(logger hclog.Logger, config *Config, head HeadStore, rounds RoundStore) (*Capturer, error) {
+	if config.MaxCaptures <= 0 {
+		return nil, fmt.Errorf("invalid number of captures %d", config.MaxCaptures)
+	}
+
+	if err := os.MkdirAll(config.Dir, 0750); err != nil {
+		return nil, fmt.Errorf("failed to create the profiles directory: %w", err)
+	}
+
+	return &Capturer{
+		logger:  logger.Named("profiling"),
+		config:  config,
+		head:    head,
+		rounds:  rounds,
+		closeCh: make(chan struct{}),
+		doneCh:  make(chan struct{}),
+	}, nil
+}
+
+// Start starts checking the thresholds
+func (c *Capturer) Start() {
+	c.removeIncomplete()
+
+	c.headNumber, c.headTime = c.head.Header().Number, time.Now()
+
+	go c.run()
+}
+
+// Close stops checking the thresholds, it waits for the capture in progress
+func (c *Capturer) Close() {
+	close(c.closeCh)
+	<-c.doneCh
+}
+
+func (c *Capturer) run() {
+	defer close(c.doneCh)
+
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.closeCh:
+			return
+		case now := <-ticker.C:
+			if reason, height := c.check(now); reason != "" {
+				c.capture(now, reason, height)
+			}
+		}
+	}
+}
+
+// check returns the reason of a capture and the height the node is producing, an empty reason if none is due
+func (c *Capturer) check(now time.Time) (string, uint64) {
+	if head := c.head.Header(); head.Number != c.headNumber {
+		c.headNumber, c.headTime = head.Number, now
+	}
+
+	if !c.lastCapture.IsZero() && now.Sub(c.lastCapture) < captureCooldown {
+		return "", 0
+	}
+
+	if c.config.BlockLatency > 0 && now.Sub(c.headTime) > c.config.BlockLatency {
+		return ReasonBlockLatency, c.headNumber + 1
+	}
+
+	if c.config.Round > 0 && c.rounds != nil {
+		// the round of a height which is already added is stale
+		if height, round := c.rounds.CurrentRound(); height > c.headNumber && round >= c.config.Round {
+			return ReasonRoundChange, height
+		}
+	}
+
+	return "", 0
+}
+
+// capture writes the profiles to a new capture and rotates the captures
+func (c *Capturer) capture(now time.Time, reason string, height uint64) {
+	c.lastCapture = now
+
+	name := captureName(now, reason, height)
+	c.logger.Warn("capturing profiles", "reason", reason, "height", height, "name", name)
+
+	// the profiles are written to a hidden directory, which isn't listed until it is complete
+	tmpDir := filepath.Join(c.config.Dir, "."+name)
+	if err := os.MkdirAll(tmpDir, 0750); err != nil {
+		c.logger.Error("failed to create the capture directory", "err", err)
+
+		return
+	}
+
+	for _, profile := range []string{"goroutine", "heap"} {
+		if err := writeProfile(filepath.Join(tmpDir, profile+".pprof"), profile); err != nil {
+			c.logger.Error("failed to write the profile", "profile", profile, "err", err)
+		}
+	}
+
+	if err := c.writeCPUProfile(filepath.Join(tmpDir, CPUProfile)); err != nil {
+		c.logger.Error("failed to write the cpu profile", "err", err)
+	}
+
+	if err := os.Rename(tmpDir, filepath.Join(c.config.Dir, name)); err != nil {
+		c.logger.Error("failed to complete the capture", "err", err)
+
+		return
+	}
+
+	c.logger.Info("profiles captured", "name", name)
+
+	c.rotate()
+}
+
+// writeCPUProfile profiles the CPU for the configured duration, it stops earlier if the capturer is closed
+func (c *Capturer) writeCPUProfile(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	// the profiling fails if a profile is already running, e.g. one requested from the pprof endpoint
+	if err := pprof.StartCPUProfile(file); err != nil {
+		_ = file.Close()
+		_ = os.Remove(path)
+
+		return err
+	}
+
+	select {
+	case <-time.After(c.config.CPUDuration):
+	case <-c.closeCh:
+	}
+
+	pprof.StopCPUProfile()
+
+	return file.Close()
+}
+
+// rotate removes the oldest captures over the maximum
+func (c *Capturer) rotate() {
+	captures, err := List(c.config.Dir)
+	if err != nil {
+		c.logger.Error("failed to list the captures", "err", err)
+
+		return
+	}
+
+	for i := 0; i < len(captures)-c.config.MaxCaptures; i++ {
+		if err := os.RemoveAll(filepath.Join(c.config.Dir, captures[i].Name)); err != nil {
+			c.logger.Error("failed to remove the capture", "name", captures[i].Name, "err", err)
+		}
+	}
+}
+
+// removeIncomplete removes the captures the node didn't complete before it stopped
+func (c *Capturer) removeIncomplete() {
+	entries, err := os.ReadDir(c.config.Dir)
+	if err != nil {
+		c.logger.Error("failed to read the profiles directory", "err", err)
+
+		return
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasPrefix(entry.Name(), ".") {
+			_ = os.RemoveAll(filepath.Join(c.config.Dir, entry.Name()))
+		}
+	}
+}
+
+// writeProfile writes the named runtime profile to the file
+func writeProfile(path, name string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := pprof.Lookup(name).WriteTo(file, 0); err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	return file.Close()
+}
@@ -0,0 +1,155 @@
+package profiling
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DirName is the directory of the data dir the profiles are captured to
+	DirName = "profiles"
+
+	// CPUProfile, HeapProfile and GoroutineProfile are the files of a capture
+	CPUProfile       = "cpu.pprof"
+	HeapProfile      = "heap.pprof"
+	GoroutineProfile = "goroutine.pprof"
+
+	// timeFormat is the format of the time in the names of the captures, the names are sorted by time
+	timeFormat = "20060102T150405Z"
+)
+
+var errInvalidName = errors.New("invalid profile name")
+
+// Capture is a set of profiles captured at the same time
+type Capture struct {
+	// Name is the name of the directory of the capture, e.g. 20240102T150405Z-block-latency-1200
+	Name string
+
+	// Reason is the threshold which triggered the capture
+	Reason string
+
+	// Height is the height of the block the node was producing
+	Height uint64
+
+	Time  time.Time
+	Files []File
+}
+
+// File is a profile of a capture
+type File struct {
+	Name string
+	Size int64
+}
+
+// Handler returns the handler of the pprof endpoints, which are served under /debug/pprof/
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	return mux
+}
+
+// List returns the captures of the directory, the oldest first.
+// There are no captures if the directory doesn't exist
+func List(dir string) ([]*Capture, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to read the profiles directory: %w", err)
+	}
+
+	captures := make([]*Capture, 0, len(entries))
+
+	for _, entry := range entries {
+		capture, ok := parseCaptureName(entry.Name())
+		if !entry.IsDir() || !ok {
+			continue
+		}
+
+		files, err := os.ReadDir(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the capture %s: %w", entry.Name(), err)
+		}
+
+		for _, file := range files {
+			info, err := file.Info()
+			if err != nil || !info.Mode().IsRegular() {
+				continue
+			}
+
+			capture.Files = append(capture.Files, File{Name: file.Name(), Size: info.Size()})
+		}
+
+		captures = append(captures, capture)
+	}
+
+	sort.Slice(captures, func(i, j int) bool {
+		return captures[i].Name < captures[j].Name
+	})
+
+	return captures, nil
+}
+
+// Open opens the profile of a capture of the directory
+func Open(dir, capture, file string) (*os.File, error) {
+	if !validName(capture) || !validName(file) {
+		return nil, errInvalidName
+	}
+
+	if _, ok := parseCaptureName(capture); !ok {
+		return nil, errInvalidName
+	}
+
+	return os.Open(filepath.Join(dir, capture, file))
+}
+
+// captureName returns the name of the directory of a capture
+func captureName(at time.Time, reason string, height uint64) string {
+	return fmt.Sprintf("%s-%s-%d", at.UTC().Format(timeFormat), reason, height)
+}
+
+// parseCaptureName parses the name of the directory of a capture,
+// the directories of the captures in progress aren't valid captures
+func parseCaptureName(name string) (*Capture, bool) {
+	first, last := strings.Index(name, "-"), strings.LastIndex(name, "-")
+	if first <= 0 || last <= first+1 {
+		return nil, false
+	}
+
+	at, err := time.Parse(timeFormat, name[:first])
+	if err != nil {
+		return nil, false
+	}
+
+	height, err := strconv.ParseUint(name[last+1:], 10, 64)
+	if err != nil {
+		return nil, false
+	}
+
+	return &Capture{
+		Name:   name,
+		Reason: name[first+1 : last],
+		Height: height,
+		Time:   at,
+	}, true
+}
+
+// validName checks the name is a single path element which isn't hidden
+func validName(name string) bool {
+	return name != "" && !strings.HasPrefix(name, ".") && filepath.Base(name) == name
+}
@@ -0,0 +1,168 @@
+package profiling
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type headStoreMock struct {
+	number uint64
+}
+
+func (m *headStoreMock) Header() *types.Header {
+	return &types.Header{Number: m.number}
+}
+
+type roundStoreMock struct {
+	height, round uint64
+}
+
+func (m *roundStoreMock) CurrentRound() (uint64, uint64) {
+	return m.height, m.round
+}
+
+func newTestCapturer(t *testing.T, config *Config, head HeadStore, rounds RoundStore) *Capturer {
+	t.Helper()
+
+	config.Dir = t.TempDir()
+	config.CPUDuration = 10 * time.Millisecond
+
+	if config.MaxCaptures == 0 {
+		config.MaxCaptures = 2
+	}
+
+	capturer, err := NewCapturer(hclog.NewNullLogger(), config, head, rounds)
+	require.NoError(t, err)
+
+	return capturer
+}
+
+func TestCapturer_Check(t *testing.T) {
+	t.Parallel()
+
+	head := &headStoreMock{number: 10}
+	rounds := &roundStoreMock{height: 11}
+	capturer := newTestCapturer(t, &Config{BlockLatency: 5 * time.Second, Round: 2}, head, rounds)
+
+	start := time.Now()
+	capturer.headNumber, capturer.headTime = head.number, start
+
+	reason, _ := capturer.check(start.Add(4 * time.Second))
+	require.Empty(t, reason)
+
+	// the chain stalls
+	reason, height := capturer.check(start.Add(6 * time.Second))
+	require.Equal(t, ReasonBlockLatency, reason)
+	require.Equal(t, uint64(11), height)
+
+	// a new block resets the latency
+	head.number = 11
+	reason, _ = capturer.check(start.Add(7 * time.Second))
+	require.Empty(t, reason)
+
+	// the round of the added height is stale
+	rounds.round = 3
+	reason, _ = capturer.check(start.Add(8 * time.Second))
+	require.Empty(t, reason)
+
+	rounds.height = 12
+	reason, height = capturer.check(start.Add(9 * time.Second))
+	require.Equal(t, ReasonRoundChange, reason)
+	require.Equal(t, uint64(12), height)
+
+	// no capture is due until the cooldown passes
+	capturer.lastCapture = start.Add(9 * time.Second)
+	reason, _ = capturer.check(start.Add(9*time.Second + captureCooldown/2))
+	require.Empty(t, reason)
+
+	reason, _ = capturer.check(start.Add(10*time.Second + captureCooldown))
+	require.Equal(t, ReasonBlockLatency, reason)
+}
+
+func TestCapturer_CaptureAndRotate(t *testing.T) {
+	t.Parallel()
+
+	capturer := newTestCapturer(t, &Config{BlockLatency: time.Second}, &headStoreMock{}, nil)
+	dir := capturer.config.Dir
+
+	// a capture the node didn't complete
+	require.NoError(t, os.Mkdir(filepath.Join(dir, ".20240101T000000Z-block-latency-1"), 0750))
+
+	capturer.Start()
+	capturer.Close()
+
+	captures, err := List(dir)
+	require.NoError(t, err)
+	require.Empty(t, captures)
+
+	start := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		capturer.capture(start.Add(time.Duration(i)*time.Minute), ReasonRoundChange, uint64(i+1))
+	}
+
+	captures, err = List(dir)
+	require.NoError(t, err)
+	require.Len(t, captures, 2)
+
+	// the oldest capture is removed
+	require.Equal(t, "20240102T150505Z-round-change-2", captures[0].Name)
+	require.Equal(t, ReasonRoundChange, captures[0].Reason)
+	require.Equal(t, uint64(2), captures[0].Height)
+	require.Equal(t, start.Add(time.Minute), captures[0].Time)
+
+	names := make([]string, 0, len(captures[1].Files))
+	for _, file := range captures[1].Files {
+		require.Positive(t, file.Size)
+
+		names = append(names, file.Name)
+	}
+
+	require.ElementsMatch(t, []string{CPUProfile, HeapProfile, GoroutineProfile}, names)
+
+	file, err := Open(dir, captures[1].Name, HeapProfile)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	for _, name := range []string{"", "..", "../" + captures[1].Name, "." + captures[1].Name, "other"} {
+		_, err = Open(dir, name, HeapProfile)
+		require.ErrorIs(t, err, errInvalidName, name)
+	}
+
+	_, err = Open(dir, captures[1].Name, "../../"+HeapProfile)
+	require.ErrorIs(t, err, errInvalidName)
+}
+
+func TestList_MissingDir(t *testing.T) {
+	t.Parallel()
+
+	captures, err := List(filepath.Join(t.TempDir(), DirName))
+	require.NoError(t, err)
+	require.Empty(t, captures)
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(Handler())
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/debug/pprof/goroutine?debug=1")
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Contains(t, string(body), "goroutine profile")
+}
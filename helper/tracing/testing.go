@@ -0,0 +1,97 @@
+package tracing
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+)
+
+// TestCollector is an in-memory OTLP gRPC collector, which keeps the exported spans
+type TestCollector struct {
+	collectortrace.UnimplementedTraceServiceServer
+
+	// Endpoint is the host:port the collector listens on, without TLS
+	Endpoint string
+
+	lock     sync.Mutex
+	spans    []*tracepb.Span
+	resource map[string]string
+}
+
+// NewTestCollector starts a collector on a random port, which is stopped when the test ends
+func NewTestCollector(tb testing.TB) *TestCollector {
+	tb.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(tb, err)
+
+	collector := &TestCollector{
+		Endpoint: listener.Addr().String(),
+		resource: map[string]string{},
+	}
+
+	server := grpc.NewServer()
+	collectortrace.RegisterTraceServiceServer(server, collector)
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	tb.Cleanup(server.Stop)
+
+	return collector
+}
+
+// Export implements the trace service
+func (c *TestCollector) Export(
+	_ context.Context,
+	req *collectortrace.ExportTraceServiceRequest,
+) (*collectortrace.ExportTraceServiceResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, resourceSpans := range req.ResourceSpans {
+		for _, attr := range resourceSpans.GetResource().GetAttributes() {
+			c.resource[attr.Key] = attr.Value.GetStringValue()
+		}
+
+		for _, scopeSpans := range resourceSpans.ScopeSpans {
+			c.spans = append(c.spans, scopeSpans.Spans...)
+		}
+	}
+
+	return &collectortrace.ExportTraceServiceResponse{}, nil
+}
+
+// Spans returns the exported spans
+func (c *TestCollector) Spans() []*tracepb.Span {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return append([]*tracepb.Span(nil), c.spans...)
+}
+
+// Span returns the first exported span with the given name, nil if there is none
+func (c *TestCollector) Span(name string) *tracepb.Span {
+	for _, span := range c.Spans() {
+		if span.Name == name {
+			return span
+		}
+	}
+
+	return nil
+}
+
+// ResourceAttribute returns the string attribute of the resource the spans were exported with
+func (c *TestCollector) ResourceAttribute(key string) string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.resource[key]
+}
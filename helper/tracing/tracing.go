@@ -0,0 +1,191 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	lru "github.com/hashicorp/golang-lru"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/versioning"
+)
+
+const (
+	// instrumentationPrefix is the prefix of the names of the tracers
+	instrumentationPrefix = "github.com/0xPolygon/polygon-edge/"
+
+	// txContextsSize is the number of the transactions whose span contexts are kept
+	txContextsSize = 16384
+
+	// blockContextsSize is the number of the heights whose span contexts are kept
+	blockContextsSize = 128
+)
+
+var (
+	// TxHashKey is the attribute of the hash of a transaction
+	TxHashKey = attribute.Key("tx.hash")
+
+	// BlockNumberKey is the attribute of the number of a block
+	BlockNumberKey = attribute.Key("block.number")
+
+	// RoundKey is the attribute of the IBFT round
+	RoundKey = attribute.Key("ibft.round")
+)
+
+var (
+	// txContexts are the span contexts of the sampled transactions received by the node, by hash.
+	// The spans of the block building and the block insertion are linked to them
+	txContexts = newCache(txContextsSize)
+
+	// blockContexts are the contexts of the spans of the consensus sequences, by height
+	blockContexts = newCache(blockContextsSize)
+)
+
+// Config is the configuration of the tracing
+type Config struct {
+	// Endpoint is the host:port of the OTLP gRPC collector, the tracing is disabled if it's empty
+	Endpoint string
+
+	// Insecure disables the TLS of the connection to the collector
+	Insecure bool
+
+	// SampleRatio is the ratio of the traces started by the node which are sampled,
+	// the traces started by the clients follow the decision of the client
+	SampleRatio float64
+
+	// ServiceName is the name the spans are exported with
+	ServiceName string
+}
+
+// Setup sets the global tracer provider, which exports the spans to the OTLP endpoint
+// of the configuration, and the W3C trace context propagator.
+// The spans aren't recorded if no endpoint is given.
+// It returns the function which flushes the spans and stops the exporter
+func Setup(ctx context.Context, config *Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	if config.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	if config.SampleRatio < 0 || config.SampleRatio > 1 {
+		return nil, fmt.Errorf("invalid sample ratio %v, it has to be between 0 and 1", config.SampleRatio)
+	}
+
+	options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(config.Endpoint)}
+	if config.Insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the OTLP exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName(config.ServiceName),
+		semconv.ServiceVersion(versioning.Version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the tracing resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	)
+
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of a package of the module, e.g. "txpool"
+func Tracer(name string) trace.Tracer {
+	return otel.Tracer(instrumentationPrefix + name)
+}
+
+// Inject writes the trace context of the span in the context to the carrier
+func Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+}
+
+// Extract returns the context with the remote span whose trace context is read from the carrier
+func Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
+
+// RecordError marks the span as failed with the error, if any
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// SetTxContext keeps the span context of the transaction, if it is sampled
+func SetTxContext(hash types.Hash, spanCtx trace.SpanContext) {
+	if !spanCtx.IsSampled() {
+		return
+	}
+
+	txContexts.Add(hash, spanCtx)
+}
+
+// TxLinks returns the links to the span contexts of the given transactions, which are known to the node
+func TxLinks(txs ...*types.Transaction) []trace.Link {
+	var links []trace.Link
+
+	for _, tx := range txs {
+		if spanCtx, ok := txContexts.Get(tx.Hash); ok {
+			links = append(links, trace.Link{
+				SpanContext: spanCtx.(trace.SpanContext), //nolint:forcetypeassert
+				Attributes:  []attribute.KeyValue{TxHashKey.String(tx.Hash.String())},
+			})
+		}
+	}
+
+	return links
+}
+
+// SetBlockContext keeps the span of the context as the parent of the spans of the given height
+func SetBlockContext(ctx context.Context, number uint64) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+
+	// the span is detached from the cancellation of the context
+	blockContexts.Add(number, trace.ContextWithSpan(context.Background(), trace.SpanFromContext(ctx)))
+}
+
+// BlockContext returns the context with the parent span of the given height,
+// an empty context if the height isn't traced
+func BlockContext(number uint64) context.Context {
+	if ctx, ok := blockContexts.Get(number); ok {
+		return ctx.(context.Context) //nolint:forcetypeassert
+	}
+
+	return context.Background()
+}
+
+// newCache creates a cache of the given size, it panics if the size isn't positive
+func newCache(size int) *lru.Cache {
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+
+	return cache
+}
@@ -0,0 +1,100 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestSetup_ExportsToCollector(t *testing.T) {
+	collector := NewTestCollector(t)
+
+	shutdown, err := Setup(context.Background(), &Config{
+		Endpoint:    collector.Endpoint,
+		Insecure:    true,
+		SampleRatio: 1,
+		ServiceName: "hydra-test",
+	})
+	require.NoError(t, err)
+
+	tracer := Tracer("tracing")
+
+	ctx, parent := tracer.Start(context.Background(), "parent")
+
+	// the trace context is propagated through the carrier, e.g. the gossip message of a transaction
+	carrier := propagation.MapCarrier{}
+	Inject(ctx, carrier)
+	require.Contains(t, carrier, "traceparent")
+
+	_, child := tracer.Start(Extract(context.Background(), carrier), "child")
+	child.End()
+	parent.End()
+
+	// the shutdown flushes the spans
+	require.NoError(t, shutdown(context.Background()))
+
+	parentSpan, childSpan := collector.Span("parent"), collector.Span("child")
+	require.NotNil(t, parentSpan)
+	require.NotNil(t, childSpan)
+	require.Equal(t, parentSpan.TraceId, childSpan.TraceId)
+	require.Equal(t, parentSpan.SpanId, childSpan.ParentSpanId)
+	require.Equal(t, "hydra-test", collector.ResourceAttribute("service.name"))
+}
+
+func TestSetup_Errors(t *testing.T) {
+	shutdown, err := Setup(context.Background(), &Config{})
+	require.NoError(t, err)
+	require.NoError(t, shutdown(context.Background()))
+
+	_, err = Setup(context.Background(), &Config{Endpoint: "127.0.0.1:4317", SampleRatio: 2})
+	require.ErrorContains(t, err, "invalid sample ratio")
+}
+
+func TestTxLinks(t *testing.T) {
+	t.Parallel()
+
+	newSpanContext := func(flags trace.TraceFlags) trace.SpanContext {
+		return trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1},
+			SpanID:     trace.SpanID{2},
+			TraceFlags: flags,
+		})
+	}
+
+	sampledTx := &types.Transaction{Hash: types.StringToHash("0x1")}
+	unsampledTx := &types.Transaction{Hash: types.StringToHash("0x2")}
+	unknownTx := &types.Transaction{Hash: types.StringToHash("0x3")}
+
+	SetTxContext(sampledTx.Hash, newSpanContext(trace.FlagsSampled))
+	SetTxContext(unsampledTx.Hash, newSpanContext(0))
+
+	links := TxLinks(sampledTx, unsampledTx, unknownTx)
+	require.Len(t, links, 1)
+	require.Equal(t, newSpanContext(trace.FlagsSampled), links[0].SpanContext)
+}
+
+func TestBlockContext(t *testing.T) {
+	t.Parallel()
+
+	// untraced heights have no parent span
+	require.False(t, trace.SpanContextFromContext(BlockContext(1000)).IsValid())
+
+	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{3},
+		SpanID:     trace.SpanID{4},
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	ctx, cancel := context.WithCancel(trace.ContextWithSpanContext(context.Background(), spanCtx))
+	SetBlockContext(ctx, 1001)
+	cancel()
+
+	blockCtx := BlockContext(1001)
+	require.Equal(t, spanCtx, trace.SpanContextFromContext(blockCtx))
+	require.NoError(t, blockCtx.Err())
+}
@@ -0,0 +1,126 @@
+package jsonrpc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// unsafeMethods modify the node (e.g. rewind the chain), the public listener serves them
+// only if they are enabled explicitly by the methods of its policy
+var unsafeMethods = []string{"debug_setHead"}
+
+// AccessPolicy defines the methods served by a JSON-RPC listener
+type AccessPolicy struct {
+	// Namespaces are the enabled namespaces (e.g. eth, net, debug).
+	// All the methods are enabled if neither namespaces nor methods are set
+	Namespaces []string
+
+	// Methods are the methods enabled in addition to the namespaces (e.g. debug_traceTransaction)
+	Methods []string
+
+	// DenyMethods are the disabled methods, even if their namespace is enabled.
+	// A trailing * matches all the methods with the prefix (e.g. debug_trace*)
+	DenyMethods []string
+}
+
+// allows returns true if the method can be called through the listener
+func (p *AccessPolicy) allows(method string) bool {
+	if p == nil {
+		return true
+	}
+
+	for _, denied := range p.DenyMethods {
+		if matchesMethod(denied, method) {
+			return false
+		}
+	}
+
+	if len(p.Namespaces) == 0 && len(p.Methods) == 0 {
+		return true
+	}
+
+	namespace, _, _ := strings.Cut(method, "_")
+
+	for _, enabled := range p.Namespaces {
+		if enabled == namespace {
+			return true
+		}
+	}
+
+	for _, enabled := range p.Methods {
+		if enabled == method {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withUnsafeMethodsDenied returns the policy which denies the unsafe methods
+// which aren't enabled explicitly by its methods
+func (p AccessPolicy) withUnsafeMethodsDenied() AccessPolicy {
+	denied := append([]string{}, p.DenyMethods...)
+
+	for _, method := range unsafeMethods {
+		enabled := false
+
+		for _, m := range p.Methods {
+			if m == method {
+				enabled = true
+
+				break
+			}
+		}
+
+		if !enabled {
+			denied = append(denied, method)
+		}
+	}
+
+	p.DenyMethods = denied
+
+	return p
+}
+
+// matchesMethod returns true if the method matches the pattern,
+// which is either a method name or a prefix followed by *
+func matchesMethod(pattern, method string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(method, prefix)
+	}
+
+	return pattern == method
+}
+
+// validate returns an error if the policy enables a namespace or a method which isn't registered
+func (p *AccessPolicy) validate(serviceMap map[string]*serviceData) error {
+	for _, namespace := range p.Namespaces {
+		if _, ok := serviceMap[namespace]; !ok {
+			return fmt.Errorf("namespace %s is not available", namespace)
+		}
+	}
+
+	for _, method := range p.Methods {
+		if isSubscriptionMethod(method) {
+			continue
+		}
+
+		namespace, name, _ := strings.Cut(method, "_")
+
+		service, ok := serviceMap[namespace]
+		if !ok {
+			return fmt.Errorf("method %s is not available", method)
+		}
+
+		if _, ok := service.funcMap[name]; !ok {
+			return fmt.Errorf("method %s is not available", method)
+		}
+	}
+
+	return nil
+}
+
+// isSubscriptionMethod returns true for the methods handled only by the websocket connections
+func isSubscriptionMethod(method string) bool {
+	return method == "eth_subscribe" || method == "eth_unsubscribe"
+}
@@ -0,0 +1,166 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessPolicy_Allows(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		policy  *AccessPolicy
+		allowed []string
+		denied  []string
+	}{
+		{
+			name:    "no policy",
+			policy:  nil,
+			allowed: []string{"eth_chainId", "debug_traceTransaction"},
+		},
+		{
+			name:    "all namespaces",
+			policy:  &AccessPolicy{},
+			allowed: []string{"eth_chainId", "debug_traceTransaction"},
+		},
+		{
+			name:    "namespaces",
+			policy:  &AccessPolicy{Namespaces: []string{"eth", "net"}},
+			allowed: []string{"eth_chainId", "net_version"},
+			denied:  []string{"debug_traceTransaction", "web3_clientVersion"},
+		},
+		{
+			name: "additional methods",
+			policy: &AccessPolicy{
+				Namespaces: []string{"eth"},
+				Methods:    []string{"debug_traceTransaction"},
+			},
+			allowed: []string{"eth_chainId", "debug_traceTransaction"},
+			denied:  []string{"debug_traceCall"},
+		},
+		{
+			name:    "methods only",
+			policy:  &AccessPolicy{Methods: []string{"eth_chainId", "debug_traceTransaction"}},
+			allowed: []string{"eth_chainId", "debug_traceTransaction"},
+			denied:  []string{"eth_sendRawTransaction", "debug_traceCall", "net_version"},
+		},
+		{
+			name: "denied methods",
+			policy: &AccessPolicy{
+				DenyMethods: []string{"eth_sendRawTransaction", "debug_trace*"},
+			},
+			allowed: []string{"eth_chainId", "debug_getRawBlock"},
+			denied:  []string{"eth_sendRawTransaction", "debug_traceTransaction", "debug_traceCall"},
+		},
+		{
+			name: "denied methods take precedence",
+			policy: &AccessPolicy{
+				Namespaces:  []string{"eth"},
+				Methods:     []string{"debug_traceTransaction"},
+				DenyMethods: []string{"eth_send*", "debug_traceTransaction"},
+			},
+			allowed: []string{"eth_chainId"},
+			denied:  []string{"eth_sendRawTransaction", "debug_traceTransaction"},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, method := range c.allowed {
+				require.True(t, c.policy.allows(method), method)
+			}
+
+			for _, method := range c.denied {
+				require.False(t, c.policy.allows(method), method)
+			}
+		})
+	}
+}
+
+func TestAccessPolicy_WithUnsafeMethodsDenied(t *testing.T) {
+	t.Parallel()
+
+	policy := (&AccessPolicy{}).withUnsafeMethodsDenied()
+	require.True(t, policy.allows("debug_traceTransaction"))
+	require.False(t, policy.allows("debug_setHead"))
+
+	// the debug namespace doesn't enable the unsafe methods
+	policy = (&AccessPolicy{Namespaces: []string{"debug"}}).withUnsafeMethodsDenied()
+	require.False(t, policy.allows("debug_setHead"))
+
+	policy = (&AccessPolicy{
+		Namespaces: []string{"eth"},
+		Methods:    []string{"debug_setHead"},
+	}).withUnsafeMethodsDenied()
+	require.True(t, policy.allows("debug_setHead"))
+	require.True(t, policy.allows("eth_chainId"))
+
+	// enabling the unsafe method alone restricts the listener to it
+	policy = (&AccessPolicy{Methods: []string{"debug_setHead"}}).withUnsafeMethodsDenied()
+	require.True(t, policy.allows("debug_setHead"))
+	require.False(t, policy.allows("eth_chainId"))
+}
+
+func TestDispatcher_WithPolicy(t *testing.T) {
+	t.Parallel()
+
+	dispatcher := newTestDispatcher(t, hclog.NewNullLogger(), newMockStore(), &dispatcherParams{chainID: 1})
+
+	t.Run("unknown namespace", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := dispatcher.withPolicy(AccessPolicy{Namespaces: []string{"admin"}})
+		require.ErrorContains(t, err, "namespace admin is not available")
+	})
+
+	t.Run("unknown method", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := dispatcher.withPolicy(AccessPolicy{Methods: []string{"debug_unknown"}})
+		require.ErrorContains(t, err, "method debug_unknown is not available")
+	})
+
+	t.Run("restricted methods", func(t *testing.T) {
+		t.Parallel()
+
+		restricted, err := dispatcher.withPolicy(AccessPolicy{
+			Namespaces:  []string{"eth", "web3"},
+			DenyMethods: []string{"web3_sha3", "eth_subscribe"},
+		})
+		require.NoError(t, err)
+
+		res, rpcErr := restricted.handleReq(context.Background(), Request{Method: "eth_chainId", Params: []byte("[]")})
+		require.Nil(t, rpcErr)
+		require.Equal(t, `"0x1"`, string(res))
+
+		for _, method := range []string{"net_version", "web3_sha3"} {
+			_, rpcErr = restricted.handleReq(context.Background(), Request{Method: method, Params: []byte("[]")})
+			require.ErrorContains(t, rpcErr, "does not exist/is not available")
+		}
+
+		// the original dispatcher serves all the methods
+		_, rpcErr = dispatcher.handleReq(context.Background(), Request{Method: "net_version", Params: []byte("[]")})
+		require.Nil(t, rpcErr)
+
+		// the subscriptions are restricted as well
+		resp, err := restricted.HandleWs(
+			context.Background(),
+			[]byte(`{"jsonrpc":"2.0","id":1,"method":"eth_subscribe","params":["newHeads"]}`),
+			&mockWsConn{},
+		)
+		require.NoError(t, err)
+
+		var response ErrorResponse
+		require.NoError(t, json.Unmarshal(resp, &response))
+		require.Contains(t, response.Error.Message, "does not exist/is not available")
+	})
+}
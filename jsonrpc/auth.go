@@ -0,0 +1,277 @@
+package jsonrpc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// jwtIssuedAtTolerance is the maximum difference between the issued at claim of a JWT
+	// and the local time, the same as the one of the engine API
+	jwtIssuedAtTolerance = 60 * time.Second
+
+	// apiKeyHeader and apiKeyQueryParam hold the API key of a client,
+	// the query parameter is used by the websocket clients which can't set headers (e.g. browsers)
+	apiKeyHeader     = "X-API-Key"
+	apiKeyQueryParam = "apikey"
+)
+
+var (
+	errMissingCredentials = errors.New("missing credentials")
+	errInvalidAPIKey      = errors.New("invalid api key")
+	errInvalidToken       = errors.New("invalid token")
+)
+
+// AuthConfig enables the authentication of the JSON-RPC clients.
+// Every client belongs to a tier, which restricts the methods it can call and its rate limit
+type AuthConfig struct {
+	// JWTSecret enables the HS256 JWT authentication (Authorization: Bearer <token>)
+	JWTSecret []byte
+
+	// JWTTier is the tier of the clients authenticated with a JWT
+	JWTTier string
+
+	// APIKeys maps the API keys to their tiers. A key is passed in the X-API-Key header,
+	// the apikey query parameter or as a bearer token
+	APIKeys map[string]string
+
+	// AnonymousTier is the tier of the clients without credentials, they are rejected if it is empty
+	AnonymousTier string
+
+	// Tiers are the tiers by name
+	Tiers map[string]Tier
+}
+
+// Tier defines the methods a client can call and its rate limit
+type Tier struct {
+	AccessPolicy
+	RateLimit
+}
+
+// Validate checks that the tiers of the clients are defined
+func (c *AuthConfig) Validate() error {
+	if len(c.JWTSecret) > 0 && c.JWTTier == "" {
+		return errors.New("the tier of the JWT clients is not set")
+	}
+
+	tiers := map[string]string{
+		"JWT":       c.JWTTier,
+		"anonymous": c.AnonymousTier,
+	}
+
+	for clients, tier := range tiers {
+		if _, ok := c.Tiers[tier]; tier != "" && !ok {
+			return fmt.Errorf("tier %s of the %s clients is not defined", tier, clients)
+		}
+	}
+
+	for _, tier := range c.APIKeys {
+		if _, ok := c.Tiers[tier]; !ok {
+			return fmt.Errorf("tier %s of an API key is not defined", tier)
+		}
+	}
+
+	for name, tier := range c.Tiers {
+		if err := tier.RateLimit.validate(); err != nil {
+			return fmt.Errorf("tier %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// authClient is an authenticated client
+type authClient struct {
+	// id identifies the client for the rate limit: the hash of its API key,
+	// the subject of its JWT or its IP address
+	id   string
+	tier string
+}
+
+// authenticator authenticates the clients of a listener
+type authenticator struct {
+	config *AuthConfig
+
+	// apiKeys maps the hashes of the API keys to their tiers
+	apiKeys map[string]string
+
+	// dispatchers serve the methods allowed for the tiers
+	dispatchers map[string]dispatcher
+}
+
+// newAuthenticator creates the authenticator of the listener served by the dispatcher
+func newAuthenticator(config *AuthConfig, d *Dispatcher) (*authenticator, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	a := &authenticator{
+		config:      config,
+		apiKeys:     make(map[string]string, len(config.APIKeys)),
+		dispatchers: make(map[string]dispatcher, len(config.Tiers)),
+	}
+
+	// the keys are compared by their hashes, so the lookup doesn't leak them through its timing
+	for key, tier := range config.APIKeys {
+		a.apiKeys[hashAPIKey(key)] = tier
+	}
+
+	for name, tier := range config.Tiers {
+		restricted, err := d.withPolicy(tier.AccessPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("tier %s: %w", name, err)
+		}
+
+		a.dispatchers[name] = restricted
+	}
+
+	return a, nil
+}
+
+func hashAPIKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// authenticate returns the client of the request, authenticated with a JWT, an API key
+// or anonymous if the anonymous clients are allowed
+func (a *authenticator) authenticate(req *http.Request) (*authClient, error) {
+	token, hasToken := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+
+	// the bearer token is either a JWT or an API key
+	if hasToken && len(a.config.JWTSecret) > 0 && strings.Count(token, ".") == 2 {
+		subject, err := verifyJWT(token, a.config.JWTSecret, time.Now())
+		if err != nil {
+			return nil, err
+		}
+
+		return &authClient{id: "jwt:" + subject, tier: a.config.JWTTier}, nil
+	}
+
+	key := req.Header.Get(apiKeyHeader)
+	if key == "" {
+		key = req.URL.Query().Get(apiKeyQueryParam)
+	}
+
+	if key == "" && hasToken {
+		key = token
+	}
+
+	if key != "" {
+		hash := hashAPIKey(key)
+
+		tier, ok := a.apiKeys[hash]
+		if !ok {
+			return nil, errInvalidAPIKey
+		}
+
+		return &authClient{id: "key:" + hash, tier: tier}, nil
+	}
+
+	if a.config.AnonymousTier == "" {
+		return nil, errMissingCredentials
+	}
+
+	return &authClient{id: "ip:" + clientIP(req), tier: a.config.AnonymousTier}, nil
+}
+
+// clientIP returns the IP address of the client of the request
+func clientIP(req *http.Request) string {
+	ip, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+
+	return ip
+}
+
+// rateLimit returns the rate limit of the tier of the client
+func (a *authenticator) rateLimit(client *authClient) RateLimit {
+	return a.config.Tiers[client.tier].RateLimit
+}
+
+// verifyJWT verifies the HS256 signature and the claims of the token and returns its subject.
+// The issued at claim is required and has to be close to the local time, as in the engine API,
+// and the subject is required
+func verifyJWT(token string, secret []byte, now time.Time) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", errInvalidToken
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return "", err
+	}
+
+	if header.Alg != "HS256" {
+		return "", fmt.Errorf("%w: unsupported algorithm %s", errInvalidToken, header.Alg)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", errInvalidToken, err)
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(parts[0] + "." + parts[1]))
+
+	if !hmac.Equal(signature, mac.Sum(nil)) {
+		return "", fmt.Errorf("%w: invalid signature", errInvalidToken)
+	}
+
+	var claims struct {
+		IssuedAt  *int64 `json:"iat"`
+		ExpiresAt *int64 `json:"exp"`
+		Subject   string `json:"sub"`
+	}
+
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return "", err
+	}
+
+	if claims.IssuedAt == nil {
+		return "", fmt.Errorf("%w: missing issued at claim", errInvalidToken)
+	}
+
+	if issuedAt := time.Unix(*claims.IssuedAt, 0); issuedAt.Sub(now).Abs() > jwtIssuedAtTolerance {
+		return "", fmt.Errorf("%w: stale issued at claim", errInvalidToken)
+	}
+
+	if claims.ExpiresAt != nil && !now.Before(time.Unix(*claims.ExpiresAt, 0)) {
+		return "", fmt.Errorf("%w: expired", errInvalidToken)
+	}
+
+	// the subject identifies the client, the tokens without it would share a rate limit
+	if claims.Subject == "" {
+		return "", fmt.Errorf("%w: missing subject claim", errInvalidToken)
+	}
+
+	return claims.Subject, nil
+}
+
+func decodeJWTPart(part string, v interface{}) error {
+	raw, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errInvalidToken, err)
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("%w: %w", errInvalidToken, err)
+	}
+
+	return nil
+}
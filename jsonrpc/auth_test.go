@@ -0,0 +1,360 @@
+package jsonrpc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+)
+
+var testJWTSecret = []byte("0123456789abcdef0123456789abcdef")
+
+// signTestJWT returns a HS256 JWT with the given claims
+func signTestJWT(t *testing.T, secret []byte, claims map[string]interface{}) string {
+	t.Helper()
+
+	encode := func(v interface{}) string {
+		raw, err := json.Marshal(v)
+		require.NoError(t, err)
+
+		return base64.RawURLEncoding.EncodeToString(raw)
+	}
+
+	unsigned := encode(map[string]string{"alg": "HS256", "typ": "JWT"}) + "." + encode(claims)
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyJWT(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	cases := []struct {
+		name   string
+		token  string
+		errMsg string
+	}{
+		{
+			name:  "valid",
+			token: signTestJWT(t, testJWTSecret, map[string]interface{}{"iat": now.Unix(), "sub": "operator"}),
+		},
+		{
+			name:   "invalid signature",
+			token:  signTestJWT(t, []byte("other secret"), map[string]interface{}{"iat": now.Unix()}),
+			errMsg: "invalid signature",
+		},
+		{
+			name:   "missing issued at",
+			token:  signTestJWT(t, testJWTSecret, map[string]interface{}{}),
+			errMsg: "missing issued at claim",
+		},
+		{
+			name:   "stale issued at",
+			token:  signTestJWT(t, testJWTSecret, map[string]interface{}{"iat": now.Add(-2 * time.Minute).Unix()}),
+			errMsg: "stale issued at claim",
+		},
+		{
+			name: "expired",
+			token: signTestJWT(t, testJWTSecret, map[string]interface{}{
+				"iat": now.Unix(),
+				"exp": now.Add(-time.Second).Unix(),
+			}),
+			errMsg: "expired",
+		},
+		{
+			name:   "missing subject",
+			token:  signTestJWT(t, testJWTSecret, map[string]interface{}{"iat": now.Unix()}),
+			errMsg: "missing subject claim",
+		},
+		{
+			name:   "unsupported algorithm",
+			token:  base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`)) + ".e30.",
+			errMsg: "unsupported algorithm none",
+		},
+		{
+			name:   "malformed",
+			token:  "not a token",
+			errMsg: "invalid token",
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			subject, err := verifyJWT(c.token, testJWTSecret, now)
+			if c.errMsg != "" {
+				require.ErrorContains(t, err, c.errMsg)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "operator", subject)
+		})
+	}
+}
+
+func TestAuthConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	tiers := map[string]Tier{"public": {}}
+
+	require.NoError(t, (&AuthConfig{JWTSecret: testJWTSecret, JWTTier: "public", Tiers: tiers}).Validate())
+	require.ErrorContains(t, (&AuthConfig{JWTSecret: testJWTSecret, Tiers: tiers}).Validate(),
+		"the tier of the JWT clients is not set")
+	require.ErrorContains(t, (&AuthConfig{AnonymousTier: "admin", Tiers: tiers}).Validate(),
+		"tier admin of the anonymous clients is not defined")
+	require.ErrorContains(t, (&AuthConfig{APIKeys: map[string]string{"key": "admin"}, Tiers: tiers}).Validate(),
+		"tier admin of an API key is not defined")
+	require.ErrorContains(t, (&AuthConfig{Tiers: map[string]Tier{"public": {RateLimit: RateLimit{Rate: -1}}}}).Validate(),
+		"tier public: negative rate limit")
+}
+
+// newTestAuthJSONRPC starts a JSON-RPC server with the given authentication and returns its address
+func newTestAuthJSONRPC(t *testing.T, auth *AuthConfig) string {
+	t.Helper()
+
+	port, err := tests.GetFreePort()
+	require.NoError(t, err)
+
+	addr := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: port}
+
+	_, err = NewJSONRPC(hclog.NewNullLogger(), &Config{
+		Store:   newMockStore(),
+		Addr:    addr,
+		ChainID: 100,
+		Auth:    auth,
+	})
+	require.NoError(t, err)
+
+	return addr.String()
+}
+
+// postRequest sends the JSON-RPC request with the given headers and returns the status and the response
+func postRequest(t *testing.T, url, method string, headers map[string]string) (int, string) {
+	t.Helper()
+
+	body := fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"%s","params":[]}`, method)
+
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	require.NoError(t, err)
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return resp.StatusCode, string(data)
+}
+
+func TestJSONRPC_Auth_HTTP(t *testing.T) {
+	t.Parallel()
+
+	addr := newTestAuthJSONRPC(t, &AuthConfig{
+		JWTSecret: testJWTSecret,
+		JWTTier:   "admin",
+		APIKeys:   map[string]string{"public-key": "public"},
+		Tiers: map[string]Tier{
+			"admin":  {},
+			"public": {AccessPolicy: AccessPolicy{Namespaces: []string{"eth"}}},
+		},
+	})
+	url := "http://" + addr
+
+	jwt := signTestJWT(t, testJWTSecret, map[string]interface{}{"iat": time.Now().Unix(), "sub": "operator"})
+
+	cases := []struct {
+		name     string
+		method   string
+		headers  map[string]string
+		status   int
+		response string
+	}{
+		{
+			name:     "missing credentials",
+			method:   "eth_chainId",
+			status:   http.StatusUnauthorized,
+			response: "unauthorized: missing credentials",
+		},
+		{
+			name:     "invalid api key",
+			method:   "eth_chainId",
+			headers:  map[string]string{apiKeyHeader: "other-key"},
+			status:   http.StatusUnauthorized,
+			response: "unauthorized: invalid api key",
+		},
+		{
+			name:     "invalid jwt",
+			method:   "eth_chainId",
+			headers:  map[string]string{"Authorization": "Bearer " + signTestJWT(t, []byte("x"), nil)},
+			status:   http.StatusUnauthorized,
+			response: "unauthorized: invalid token",
+		},
+		{
+			name:     "api key header",
+			method:   "eth_chainId",
+			headers:  map[string]string{apiKeyHeader: "public-key"},
+			status:   http.StatusOK,
+			response: `"result":"0x64"`,
+		},
+		{
+			name:     "api key bearer token",
+			method:   "eth_chainId",
+			headers:  map[string]string{"Authorization": "Bearer public-key"},
+			status:   http.StatusOK,
+			response: `"result":"0x64"`,
+		},
+		{
+			name:     "namespace of another tier",
+			method:   "web3_clientVersion",
+			headers:  map[string]string{apiKeyHeader: "public-key"},
+			status:   http.StatusOK,
+			response: "the method web3_clientVersion does not exist/is not available",
+		},
+		{
+			name:     "jwt",
+			method:   "web3_clientVersion",
+			headers:  map[string]string{"Authorization": "Bearer " + jwt},
+			status:   http.StatusOK,
+			response: `"result":"`,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			status, response := postRequest(t, url, c.method, c.headers)
+			require.Equal(t, c.status, status)
+			require.Contains(t, response, c.response)
+		})
+	}
+}
+
+func TestJSONRPC_Auth_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	addr := newTestAuthJSONRPC(t, &AuthConfig{
+		APIKeys:       map[string]string{"key-1": "limited", "key-2": "limited"},
+		AnonymousTier: "anonymous",
+		Tiers: map[string]Tier{
+			"limited":   {RateLimit: RateLimit{Rate: 0.001, Burst: 2}},
+			"anonymous": {AccessPolicy: AccessPolicy{Namespaces: []string{"net"}}},
+		},
+	})
+	url := "http://" + addr
+
+	for i := 0; i < 2; i++ {
+		status, _ := postRequest(t, url, "eth_chainId", map[string]string{apiKeyHeader: "key-1"})
+		require.Equal(t, http.StatusOK, status)
+	}
+
+	status, response := postRequest(t, url, "eth_chainId", map[string]string{apiKeyHeader: "key-1"})
+	require.Equal(t, http.StatusTooManyRequests, status)
+	require.Contains(t, response, `"code":-32005`)
+
+	// the clients of the same tier have their own limits
+	status, _ = postRequest(t, url, "eth_chainId", map[string]string{apiKeyHeader: "key-2"})
+	require.Equal(t, http.StatusOK, status)
+
+	// the anonymous clients are served with the methods of their tier
+	status, response = postRequest(t, url, "net_version", nil)
+	require.Equal(t, http.StatusOK, status)
+	require.Contains(t, response, `"result":"100"`)
+
+	_, response = postRequest(t, url, "eth_chainId", nil)
+	require.Contains(t, response, "the method eth_chainId does not exist/is not available")
+}
+
+func TestJSONRPC_Auth_WebSocket(t *testing.T) {
+	t.Parallel()
+
+	addr := newTestAuthJSONRPC(t, &AuthConfig{
+		JWTSecret: testJWTSecret,
+		JWTTier:   "admin",
+		APIKeys:   map[string]string{"public-key": "public"},
+		Tiers: map[string]Tier{
+			"admin":  {},
+			"public": {AccessPolicy: AccessPolicy{Namespaces: []string{"eth"}}},
+		},
+	})
+	url := "ws://" + addr + "/ws"
+
+	call := func(t *testing.T, conn *websocket.Conn, method string) string {
+		t.Helper()
+
+		require.NoError(t, conn.WriteMessage(
+			websocket.TextMessage,
+			[]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"method":"%s","params":[]}`, method)),
+		))
+
+		_, resp, err := conn.ReadMessage()
+		require.NoError(t, err)
+
+		return string(resp)
+	}
+
+	t.Run("upgrade without credentials", func(t *testing.T) {
+		t.Parallel()
+
+		_, resp, err := websocket.DefaultDialer.Dial(url, nil)
+		require.ErrorIs(t, err, websocket.ErrBadHandshake)
+		require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("api key query parameter", func(t *testing.T) {
+		t.Parallel()
+
+		conn, _, err := websocket.DefaultDialer.Dial(url+"?"+apiKeyQueryParam+"=public-key", nil)
+		require.NoError(t, err)
+
+		defer conn.Close()
+
+		require.Contains(t, call(t, conn, "eth_chainId"), `"result":"0x64"`)
+		require.Contains(t, call(t, conn, "web3_clientVersion"), "does not exist/is not available")
+	})
+
+	t.Run("jwt", func(t *testing.T) {
+		t.Parallel()
+
+		jwt := signTestJWT(t, testJWTSecret, map[string]interface{}{"iat": time.Now().Unix(), "sub": "operator"})
+
+		conn, _, err := websocket.DefaultDialer.Dial(url, http.Header{"Authorization": {"Bearer " + jwt}})
+		require.NoError(t, err)
+
+		defer conn.Close()
+
+		require.Contains(t, call(t, conn, "web3_clientVersion"), `"result":"`)
+	})
+}
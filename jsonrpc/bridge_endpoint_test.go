@@ -1,6 +1,7 @@
 package jsonrpc
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -30,7 +31,7 @@ func TestBridgeEndpoint(t *testing.T) {
 		"id": 1
 	}`)
 
-	data, err := dispatcher.HandleWs(msg, mockConnection)
+	data, err := dispatcher.HandleWs(context.Background(), msg, mockConnection)
 	require.NoError(t, err)
 
 	resp := new(SuccessResponse)
@@ -44,7 +45,7 @@ func TestBridgeEndpoint(t *testing.T) {
 		"id": 1
 	}`)
 
-	data, err = dispatcher.HandleWs(msg, mockConnection)
+	data, err = dispatcher.HandleWs(context.Background(), msg, mockConnection)
 	require.NoError(t, err)
 
 	resp = new(SuccessResponse)
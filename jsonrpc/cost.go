@@ -0,0 +1,261 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// logsBlocksPerCostUnit is the block span of eth_getLogs which costs one more unit
+	logsBlocksPerCostUnit = 100
+
+	// traceGasPerCostUnit is the traced gas of debug_trace* which costs one more unit
+	traceGasPerCostUnit = 1_000_000
+)
+
+// costFunc returns the additional cost of the request, derived from its params
+type costFunc func(d *Dispatcher, params []json.RawMessage) uint64
+
+// paramCosts are the methods whose cost depends on the amount of work requested by their params.
+// They are priced before the request is admitted, so they must not access the store
+var paramCosts = map[string]costFunc{
+	"eth_getLogs":     logsRangeCost,
+	"debug_traceCall": traceCallGasCost,
+}
+
+// dataCosts are the methods whose cost depends on the data the request refers to.
+// They are charged after the request is admitted, as they look the data up
+var dataCosts = map[string]costFunc{
+	"eth_getLogs":              logsTagCost,
+	"debug_traceBlockByNumber": traceBlockByNumberCost,
+	"debug_traceBlockByHash":   traceBlockByHashCost,
+	"debug_traceBlock":         traceBlockCost,
+	"debug_traceTransaction":   traceTransactionCost,
+	"debug_traceCall":          traceCallGasLimitCost,
+}
+
+// Cost returns the cost of the request body in rate limit units, the sum of the costs of a batch.
+// It is priced from the methods and the params only, the additional cost of the data the request
+// refers to is returned by DataCost. The invalid requests cost one unit, they are rejected by the handlers
+func (d *Dispatcher) Cost(reqBody []byte) int {
+	reqs, ok := parseCostRequests(reqBody)
+	if !ok {
+		return 1
+	}
+
+	cost := 0
+	for _, req := range reqs {
+		cost += int(d.methodCost(req.Method) + requestCost(d, req, paramCosts))
+	}
+
+	return cost
+}
+
+// DataCost returns the additional cost of the data the request body refers to in rate limit units,
+// the sum of the costs of a batch. It looks the data up, so it is charged once the request is admitted
+func (d *Dispatcher) DataCost(reqBody []byte) int {
+	if d.store == nil {
+		return 0
+	}
+
+	reqs, ok := parseCostRequests(reqBody)
+	if !ok {
+		return 0
+	}
+
+	cost := 0
+	for _, req := range reqs {
+		cost += int(requestCost(d, req, dataCosts))
+	}
+
+	return cost
+}
+
+// parseCostRequests returns the requests of the body, a single one or a batch
+func parseCostRequests(reqBody []byte) ([]Request, bool) {
+	reqBody = bytes.TrimLeft(reqBody, " \t\r\n")
+
+	if len(reqBody) > 0 && reqBody[0] == '[' {
+		var batchReq BatchRequest
+		if err := json.Unmarshal(reqBody, &batchReq); err != nil || len(batchReq) == 0 {
+			return nil, false
+		}
+
+		return batchReq, true
+	}
+
+	var req Request
+	if err := json.Unmarshal(reqBody, &req); err != nil {
+		return nil, false
+	}
+
+	return []Request{req}, true
+}
+
+// requestCost returns the additional cost of the request by the cost function of its method
+func requestCost(d *Dispatcher, req Request, costs map[string]costFunc) uint64 {
+	costFn, ok := costs[req.Method]
+	if !ok {
+		return 0
+	}
+
+	var params []json.RawMessage
+	if err := json.Unmarshal(req.Params, &params); err != nil || len(params) == 0 {
+		return 0
+	}
+
+	return costFn(d, params)
+}
+
+// methodCost returns the cost of the method set by the exact name or by the longest matching prefix
+func (d *Dispatcher) methodCost(method string) uint64 {
+	if cost, ok := d.params.methodCosts[method]; ok {
+		return cost
+	}
+
+	var (
+		cost      uint64 = 1
+		prefixLen        = -1
+	)
+
+	for pattern, patternCost := range d.params.methodCosts {
+		prefix, ok := strings.CutSuffix(pattern, "*")
+		if ok && strings.HasPrefix(method, prefix) && len(prefix) > prefixLen {
+			cost, prefixLen = patternCost, len(prefix)
+		}
+	}
+
+	return cost
+}
+
+// logsRangeCost costs the block span of the log query if both of its bounds are block numbers
+func logsRangeCost(_ *Dispatcher, params []json.RawMessage) uint64 {
+	var query LogQuery
+	if err := json.Unmarshal(params[0], &query); err != nil || query.BlockHash != nil {
+		return 0
+	}
+
+	if query.fromBlock < 0 || query.toBlock < 0 || query.toBlock < query.fromBlock {
+		return 0
+	}
+
+	return uint64(query.toBlock-query.fromBlock+1) / logsBlocksPerCostUnit
+}
+
+// logsTagCost costs the block span of the log query if any of its bounds is a tag (e.g. latest)
+func logsTagCost(d *Dispatcher, params []json.RawMessage) uint64 {
+	var query LogQuery
+	if err := json.Unmarshal(params[0], &query); err != nil || query.BlockHash != nil {
+		return 0
+	}
+
+	// the span between two block numbers is already costed by logsRangeCost
+	if query.fromBlock >= 0 && query.toBlock >= 0 {
+		return 0
+	}
+
+	from, err := GetNumericBlockNumber(query.fromBlock, d.store)
+	if err != nil {
+		return 0
+	}
+
+	to, err := GetNumericBlockNumber(query.toBlock, d.store)
+	if err != nil || to < from {
+		return 0
+	}
+
+	return (to - from + 1) / logsBlocksPerCostUnit
+}
+
+// traceBlockByNumberCost costs the gas used by the block
+func traceBlockByNumberCost(d *Dispatcher, params []json.RawMessage) uint64 {
+	var blockNumber BlockNumber
+	if err := json.Unmarshal(params[0], &blockNumber); err != nil {
+		return 0
+	}
+
+	num, err := GetNumericBlockNumber(blockNumber, d.store)
+	if err != nil {
+		return 0
+	}
+
+	header, ok := d.store.GetHeaderByNumber(num)
+	if !ok {
+		return 0
+	}
+
+	return header.GasUsed / traceGasPerCostUnit
+}
+
+// traceBlockByHashCost costs the gas used by the block
+func traceBlockByHashCost(d *Dispatcher, params []json.RawMessage) uint64 {
+	var hash types.Hash
+	if err := json.Unmarshal(params[0], &hash); err != nil {
+		return 0
+	}
+
+	block, ok := d.store.GetBlockByHash(hash, false)
+	if !ok {
+		return 0
+	}
+
+	return block.Header.GasUsed / traceGasPerCostUnit
+}
+
+// traceBlockCost costs the gas used by the encoded block
+func traceBlockCost(_ *Dispatcher, params []json.RawMessage) uint64 {
+	var input argBytes
+	if err := json.Unmarshal(params[0], &input); err != nil {
+		return 0
+	}
+
+	block := &types.Block{}
+	if err := block.UnmarshalRLP(input); err != nil {
+		return 0
+	}
+
+	return block.Header.GasUsed / traceGasPerCostUnit
+}
+
+// traceTransactionCost costs the gas limit of the transaction
+func traceTransactionCost(d *Dispatcher, params []json.RawMessage) uint64 {
+	var hash types.Hash
+	if err := json.Unmarshal(params[0], &hash); err != nil {
+		return 0
+	}
+
+	tx, _ := GetTxAndBlockByTxHash(hash, d.store)
+	if tx == nil {
+		return 0
+	}
+
+	return tx.Gas / traceGasPerCostUnit
+}
+
+// traceCallGasCost costs the gas limit of the call if it is set
+func traceCallGasCost(_ *Dispatcher, params []json.RawMessage) uint64 {
+	var arg txnArgs
+	if err := json.Unmarshal(params[0], &arg); err != nil || arg.Gas == nil {
+		return 0
+	}
+
+	return uint64(*arg.Gas) / traceGasPerCostUnit
+}
+
+// traceCallGasLimitCost costs the block gas limit if the gas limit of the call isn't set
+func traceCallGasLimitCost(d *Dispatcher, params []json.RawMessage) uint64 {
+	var arg txnArgs
+	if err := json.Unmarshal(params[0], &arg); err != nil || arg.Gas != nil {
+		return 0
+	}
+
+	header := d.store.Header()
+	if header == nil {
+		return 0
+	}
+
+	return header.GasLimit / traceGasPerCostUnit
+}
@@ -50,6 +50,9 @@ type debugBlockchainStore interface {
 
 	// TraceCall traces a single call at the point when the given header is mined
 	TraceCall(*types.Transaction, *types.Header, tracer.Tracer) (interface{}, error)
+
+	// SetHead rewinds the canonical chain to the block with the given number
+	SetHead(number uint64) error
 }
 
 type debugTxPoolStore interface {
@@ -191,7 +194,7 @@ func (d *Debug) TraceCall(
 				return nil, ErrHeaderNotFound
 			}
 
-			tx, err := DecodeTxn(arg, header.Number, d.store, true)
+			tx, err := DecodeTxn(arg, header, d.store, true)
 			if err != nil {
 				return nil, err
 			}
@@ -213,6 +216,16 @@ func (d *Debug) TraceCall(
 	)
 }
 
+// SetHead rewinds the canonical chain to the block with the given number.
+// Transactions of the removed blocks are put back into the transaction pool
+func (d *Debug) SetHead(number argUint64) (interface{}, error) {
+	if err := d.store.SetHead(uint64(number)); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
 func (d *Debug) traceBlock(
 	block *types.Block,
 	config *TraceConfig,
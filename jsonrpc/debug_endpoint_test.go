@@ -2,6 +2,7 @@ package jsonrpc
 
 import (
 	"encoding/json"
+	"errors"
 	"math/big"
 	"testing"
 	"time"
@@ -26,6 +27,7 @@ type debugEndpointMockStore struct {
 	traceCallFn         func(*types.Transaction, *types.Header, tracer.Tracer) (interface{}, error)
 	getNonceFn          func(types.Address) uint64
 	getAccountFn        func(types.Hash, types.Address) (*Account, error)
+	setHeadFn           func(uint64) error
 }
 
 func (s *debugEndpointMockStore) Header() *types.Header {
@@ -68,6 +70,10 @@ func (s *debugEndpointMockStore) GetAccount(root types.Hash, addr types.Address)
 	return s.getAccountFn(root, addr)
 }
 
+func (s *debugEndpointMockStore) SetHead(number uint64) error {
+	return s.setHeadFn(number)
+}
+
 func TestDebugTraceConfigDecode(t *testing.T) {
 	timeout15s := "15s"
 
@@ -726,6 +732,51 @@ func TestTraceCall(t *testing.T) {
 	}
 }
 
+func TestSetHead(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should rewind the head to the given block", func(t *testing.T) {
+		t.Parallel()
+
+		var rewoundTo uint64
+
+		endpoint := &Debug{
+			store: &debugEndpointMockStore{
+				setHeadFn: func(number uint64) error {
+					rewoundTo = number
+
+					return nil
+				},
+			},
+		}
+
+		res, err := endpoint.SetHead(argUint64(5))
+
+		require.NoError(t, err)
+		require.Nil(t, res)
+		require.Equal(t, uint64(5), rewoundTo)
+	})
+
+	t.Run("should return error if rewind fails", func(t *testing.T) {
+		t.Parallel()
+
+		rewindErr := errors.New("block 10 is above the current head 5")
+
+		endpoint := &Debug{
+			store: &debugEndpointMockStore{
+				setHeadFn: func(number uint64) error {
+					return rewindErr
+				},
+			},
+		}
+
+		res, err := endpoint.SetHead(argUint64(10))
+
+		require.ErrorIs(t, err, rewindErr)
+		require.Nil(t, res)
+	})
+}
+
 func Test_newTracer(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,151 @@
+package jsonrpc
+
+import (
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// DevStore controls the chain of the dev consensus.
+// The evm and hardhat endpoints are registered only when it is set
+type DevStore interface {
+	// Mine seals a new block, at the given timestamp if it isn't zero
+	Mine(timestamp uint64) error
+
+	// IncreaseTime moves the clock of the next blocks forward and returns the total offset in seconds
+	IncreaseTime(seconds uint64) int64
+
+	// SetNextBlockTimestamp sets the timestamp of the next block
+	SetNextBlockTimestamp(timestamp uint64) error
+
+	// Snapshot saves the head of the chain and returns the ID of the snapshot
+	Snapshot() uint64
+
+	// Revert rewinds the chain to the snapshot, it returns false if the snapshot doesn't exist
+	Revert(id uint64) (bool, error)
+
+	// SetBalance sets the balance of the account
+	SetBalance(addr types.Address, balance *big.Int) error
+
+	// SetCode sets the code of the account
+	SetCode(addr types.Address, code []byte) error
+
+	// SetStorageAt sets the value of the storage slot of the account
+	SetStorageAt(addr types.Address, slot, value types.Hash) error
+
+	// ImpersonateAccount allows sending transactions on behalf of the account without its key
+	ImpersonateAccount(addr types.Address)
+
+	// StopImpersonatingAccount stops the impersonation of the account
+	StopImpersonatingAccount(addr types.Address)
+
+	// IsImpersonated returns true if the account is impersonated
+	IsImpersonated(addr types.Address) bool
+
+	// SendImpersonatedTransaction seals the unsigned transaction of an impersonated account
+	SendImpersonatedTransaction(tx *types.Transaction) (types.Hash, error)
+}
+
+// Evm is the evm jsonrpc endpoint, which mines blocks, moves the time
+// and snapshots the chain of the dev consensus
+type Evm struct {
+	store DevStore
+}
+
+// Mine seals a new block, at the given timestamp if it is provided
+func (e *Evm) Mine(timestamp *argUint64) (interface{}, error) {
+	var blockTimestamp uint64
+	if timestamp != nil {
+		blockTimestamp = uint64(*timestamp)
+	}
+
+	if err := e.store.Mine(blockTimestamp); err != nil {
+		return nil, err
+	}
+
+	return "0x0", nil
+}
+
+// IncreaseTime moves the clock of the next blocks forward by the given number of seconds
+// and returns the total time offset
+func (e *Evm) IncreaseTime(seconds argUint64) (interface{}, error) {
+	offset := e.store.IncreaseTime(uint64(seconds))
+	if offset < 0 {
+		// the next block timestamp was set before the current time
+		offset = 0
+	}
+
+	return argUint64(offset), nil
+}
+
+// SetNextBlockTimestamp sets the timestamp of the next block, the following blocks continue from it
+func (e *Evm) SetNextBlockTimestamp(timestamp argUint64) (interface{}, error) {
+	if err := e.store.SetNextBlockTimestamp(uint64(timestamp)); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// Snapshot saves the state of the chain and returns the snapshot ID
+func (e *Evm) Snapshot() (interface{}, error) {
+	return argUint64(e.store.Snapshot()), nil
+}
+
+// Revert reverts the chain to the snapshot with the given ID.
+// The snapshot can be reverted to only once and the later snapshots are removed
+func (e *Evm) Revert(id argUint64) (interface{}, error) {
+	return e.store.Revert(uint64(id))
+}
+
+// Hardhat is the hardhat jsonrpc endpoint, which modifies the accounts
+// of the dev consensus chain and impersonates them
+type Hardhat struct {
+	store DevStore
+}
+
+// SetBalance sets the balance of the account
+func (h *Hardhat) SetBalance(address types.Address, balance argBig) (interface{}, error) {
+	if err := h.store.SetBalance(address, (*big.Int)(&balance)); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// SetCode sets the code of the account
+func (h *Hardhat) SetCode(address types.Address, code argBytes) (interface{}, error) {
+	if err := h.store.SetCode(address, code); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// SetStorageAt sets the 32 bytes value of the storage slot of the account
+func (h *Hardhat) SetStorageAt(address types.Address, slot argBig, value argBytes) (interface{}, error) {
+	if err := h.store.SetStorageAt(
+		address,
+		types.BytesToHash((*big.Int)(&slot).Bytes()),
+		types.BytesToHash(value),
+	); err != nil {
+		return nil, err
+	}
+
+	return true, nil
+}
+
+// ImpersonateAccount allows sending transactions on behalf of the account
+// with eth_sendTransaction, without its key
+func (h *Hardhat) ImpersonateAccount(address types.Address) (interface{}, error) {
+	h.store.ImpersonateAccount(address)
+
+	return true, nil
+}
+
+// StopImpersonatingAccount stops the impersonation of the account
+func (h *Hardhat) StopImpersonatingAccount(address types.Address) (interface{}, error) {
+	h.store.StopImpersonatingAccount(address)
+
+	return true, nil
+}
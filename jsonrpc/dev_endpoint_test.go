@@ -0,0 +1,169 @@
+package jsonrpc
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type mockDevStore struct {
+	timestamp    uint64
+	timeOffset   int64
+	snapshots    uint64
+	balances     map[types.Address]*big.Int
+	storage      map[types.Hash]types.Hash
+	impersonated map[types.Address]bool
+	sent         *types.Transaction
+}
+
+func newMockDevStore() *mockDevStore {
+	return &mockDevStore{
+		balances:     map[types.Address]*big.Int{},
+		storage:      map[types.Hash]types.Hash{},
+		impersonated: map[types.Address]bool{},
+	}
+}
+
+func (m *mockDevStore) Mine(timestamp uint64) error {
+	m.timestamp = timestamp
+
+	return nil
+}
+
+func (m *mockDevStore) IncreaseTime(seconds uint64) int64 {
+	m.timeOffset += int64(seconds)
+
+	return m.timeOffset
+}
+
+func (m *mockDevStore) SetNextBlockTimestamp(timestamp uint64) error {
+	m.timestamp = timestamp
+
+	return nil
+}
+
+func (m *mockDevStore) Snapshot() uint64 {
+	m.snapshots++
+
+	return m.snapshots
+}
+
+func (m *mockDevStore) Revert(id uint64) (bool, error) {
+	return id <= m.snapshots, nil
+}
+
+func (m *mockDevStore) SetBalance(addr types.Address, balance *big.Int) error {
+	m.balances[addr] = balance
+
+	return nil
+}
+
+func (m *mockDevStore) SetCode(types.Address, []byte) error {
+	return nil
+}
+
+func (m *mockDevStore) SetStorageAt(_ types.Address, slot, value types.Hash) error {
+	m.storage[slot] = value
+
+	return nil
+}
+
+func (m *mockDevStore) ImpersonateAccount(addr types.Address) {
+	m.impersonated[addr] = true
+}
+
+func (m *mockDevStore) StopImpersonatingAccount(addr types.Address) {
+	delete(m.impersonated, addr)
+}
+
+func (m *mockDevStore) IsImpersonated(addr types.Address) bool {
+	return m.impersonated[addr]
+}
+
+func (m *mockDevStore) SendImpersonatedTransaction(tx *types.Transaction) (types.Hash, error) {
+	m.sent = tx
+
+	return types.Hash{0x1}, nil
+}
+
+func TestDevEndpoints_NotRegisteredWithoutDevStore(t *testing.T) {
+	t.Parallel()
+
+	dispatcher := newTestDispatcher(t, hclog.NewNullLogger(), newMockStore(), &dispatcherParams{})
+
+	for _, method := range []string{"evm_mine", "hardhat_setBalance"} {
+		_, err := dispatcher.handleReq(context.Background(), Request{Method: method, Params: []byte("[]")})
+		require.ErrorContains(t, err, "does not exist/is not available")
+	}
+}
+
+func TestDevEndpoints(t *testing.T) {
+	t.Parallel()
+
+	devStore := newMockDevStore()
+	dispatcher := newTestDispatcher(t, hclog.NewNullLogger(), newMockStore(), &dispatcherParams{devStore: devStore})
+
+	handle := func(method, params string) []byte {
+		t.Helper()
+
+		res, err := dispatcher.handleReq(context.Background(), Request{Method: method, Params: []byte(params)})
+		require.NoError(t, err)
+
+		return res
+	}
+
+	require.Equal(t, `"0x0"`, string(handle("evm_mine", "[]")))
+	require.Equal(t, `"0x0"`, string(handle("evm_mine", `["0x64"]`)))
+	require.Equal(t, uint64(100), devStore.timestamp)
+
+	// the seconds are accepted both as numbers and quantities
+	require.Equal(t, `"0xe10"`, string(handle("evm_increaseTime", "[3600]")))
+	require.Equal(t, `"0x1c20"`, string(handle("evm_increaseTime", `["0xe10"]`)))
+
+	require.Empty(t, handle("evm_setNextBlockTimestamp", "[200]"))
+	require.Equal(t, uint64(200), devStore.timestamp)
+
+	require.Equal(t, `"0x1"`, string(handle("evm_snapshot", "[]")))
+	require.Equal(t, "true", string(handle("evm_revert", `["0x1"]`)))
+	require.Equal(t, "false", string(handle("evm_revert", `["0x2"]`)))
+
+	require.Equal(t, "true", string(handle("hardhat_setBalance", `["0x0000000000000000000000000000000000000001", "0x3e8"]`)))
+	require.Equal(t, big.NewInt(1000), devStore.balances[types.StringToAddress("0x1")])
+
+	require.Equal(t, "true", string(handle("hardhat_setStorageAt",
+		`["0x0000000000000000000000000000000000000001", "0x2", "0x0000000000000000000000000000000000000000000000000000000000000005"]`)))
+	require.Equal(t, types.BytesToHash([]byte{0x5}), devStore.storage[types.BytesToHash([]byte{0x2})])
+}
+
+func TestEth_SendTransaction_Impersonated(t *testing.T) {
+	t.Parallel()
+
+	devStore := newMockDevStore()
+
+	eth := newTestEthEndpointWithPriceLimit(getExampleStore(), 1)
+	eth.devStore = devStore
+
+	arg := &txnArgs{From: argAddrPtr(addr0), To: argAddrPtr(addr1)}
+
+	// the accounts which aren't impersonated can't send transactions
+	_, err := eth.SendTransaction(arg)
+	require.ErrorContains(t, err, "use eth_sendRawTransaction instead")
+
+	devStore.ImpersonateAccount(addr0)
+
+	estimatedGas, err := eth.EstimateGas(&txnArgs{From: argAddrPtr(addr0), To: argAddrPtr(addr1)}, nil)
+	require.NoError(t, err)
+
+	// the gas and the gas price are estimated
+	hash, err := eth.SendTransaction(arg)
+	require.NoError(t, err)
+	require.Equal(t, types.Hash{0x1}.String(), hash)
+	require.Equal(t, addr0, devStore.sent.From)
+	require.Equal(t, estimatedGas, argUint64(devStore.sent.Gas))
+	require.Equal(t, big.NewInt(1), devStore.sent.GasPrice)
+}
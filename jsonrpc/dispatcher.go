@@ -2,6 +2,7 @@ package jsonrpc
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,9 +14,16 @@ import (
 	"unicode"
 
 	"github.com/armon/go-metrics"
+	"github.com/graph-gophers/graphql-go"
 	"github.com/hashicorp/go-hclog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 )
 
+var rpcTracer = tracing.Tracer("jsonrpc")
+
 type serviceData struct {
 	sv      reflect.Value
 	funcMap map[string]*funcData
@@ -26,19 +34,33 @@ type funcData struct {
 	reqt  []reflect.Type
 	fv    reflect.Value
 	isDyn bool
+
+	// withCtx is true if the first argument of the function is the context of the request
+	withCtx bool
+}
+
+// firstParam returns the index of the first argument of the function which is a parameter of the request
+func (f *funcData) firstParam() int {
+	if f.withCtx {
+		return 2
+	}
+
+	return 1
 }
 
 func (f *funcData) numParams() int {
-	return f.inNum - 1
+	return f.inNum - f.firstParam()
 }
 
 type endpoints struct {
-	Eth    *Eth
-	Web3   *Web3
-	Net    *Net
-	TxPool *TxPool
-	Bridge *Bridge
-	Debug  *Debug
+	Eth     *Eth
+	Web3    *Web3
+	Net     *Net
+	TxPool  *TxPool
+	Bridge  *Bridge
+	Debug   *Debug
+	Evm     *Evm
+	Hardhat *Hardhat
 }
 
 // Dispatcher handles all json rpc requests by delegating
@@ -49,7 +71,16 @@ type Dispatcher struct {
 	filterManager *FilterManager
 	endpoints     endpoints
 
+	// store is used to estimate the cost of the requests
+	store JSONRPCStore
+
 	params *dispatcherParams
+
+	// policies restrict the methods served by the dispatcher, a method has to be allowed by all of them
+	policies []*AccessPolicy
+
+	// graphQL is the schema of the GraphQL endpoint, nil if it is disabled
+	graphQL *graphql.Schema
 }
 
 type dispatcherParams struct {
@@ -61,6 +92,15 @@ type dispatcherParams struct {
 	blockRangeLimit         uint64
 
 	concurrentRequestsDebug uint64
+
+	devStore DevStore
+
+	// methodCosts are the costs of the methods in rate limit units, by name or prefix ending with *
+	methodCosts map[string]uint64
+
+	// graphQL enables the GraphQL endpoint, whose queries cost at most graphQLMaxCost units (zero is unlimited)
+	graphQL        bool
+	graphQLMaxCost uint64
 }
 
 func (dp dispatcherParams) isExceedingBatchLengthLimit(value uint64) bool {
@@ -74,6 +114,7 @@ func newDispatcher(
 ) (*Dispatcher, error) {
 	d := &Dispatcher{
 		logger: logger.Named("dispatcher"),
+		store:  store,
 		params: params,
 	}
 
@@ -86,6 +127,15 @@ func newDispatcher(
 		return nil, err
 	}
 
+	if params.graphQL {
+		schema, err := newGraphQLSchema(d)
+		if err != nil {
+			return nil, fmt.Errorf("graphql schema: %w", err)
+		}
+
+		d.graphQL = schema
+	}
+
 	return d, nil
 }
 
@@ -96,6 +146,7 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) error {
 		d.params.chainID,
 		d.filterManager,
 		d.params.priceLimit,
+		d.params.devStore,
 	}
 	d.endpoints.Net = &Net{
 		store,
@@ -134,10 +185,55 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) error {
 		return err
 	}
 
-	return d.registerService("debug", d.endpoints.Debug)
+	if err = d.registerService("debug", d.endpoints.Debug); err != nil {
+		return err
+	}
+
+	// the chain can be modified only with the dev consensus
+	if d.params.devStore == nil {
+		return nil
+	}
+
+	d.endpoints.Evm = &Evm{d.params.devStore}
+	d.endpoints.Hardhat = &Hardhat{d.params.devStore}
+
+	if err = d.registerService("evm", d.endpoints.Evm); err != nil {
+		return err
+	}
+
+	return d.registerService("hardhat", d.endpoints.Hardhat)
+}
+
+// withPolicy returns a dispatcher which serves only the methods allowed by the policy,
+// in addition to the policies of the original dispatcher.
+// It shares the endpoints and the filters with the original dispatcher
+func (d *Dispatcher) withPolicy(policy AccessPolicy) (*Dispatcher, error) {
+	if err := policy.validate(d.serviceMap); err != nil {
+		return nil, err
+	}
+
+	restricted := *d
+	restricted.policies = append(append([]*AccessPolicy{}, d.policies...), &policy)
+
+	return &restricted, nil
+}
+
+// allows returns true if the method is allowed by all the policies of the dispatcher
+func (d *Dispatcher) allows(method string) bool {
+	for _, policy := range d.policies {
+		if !policy.allows(method) {
+			return false
+		}
+	}
+
+	return true
 }
 
 func (d *Dispatcher) getFnHandler(req Request) (*serviceData, *funcData, Error) {
+	if !d.allows(req.Method) {
+		return nil, nil, NewMethodNotFoundError(req.Method)
+	}
+
 	callName := strings.SplitN(req.Method, "_", 2)
 	if len(callName) != 2 {
 		return nil, nil, NewMethodNotFoundError(req.Method)
@@ -239,7 +335,7 @@ func (d *Dispatcher) RemoveFilterByWs(conn wsConn) {
 	d.filterManager.RemoveFilterByWs(conn)
 }
 
-func (d *Dispatcher) HandleWs(reqBody []byte, conn wsConn) ([]byte, error) {
+func (d *Dispatcher) HandleWs(ctx context.Context, reqBody []byte, conn wsConn) ([]byte, error) {
 	const (
 		openSquareBracket  byte = '['
 		closeSquareBracket byte = ']'
@@ -271,7 +367,7 @@ func (d *Dispatcher) HandleWs(reqBody []byte, conn wsConn) ([]byte, error) {
 		responses := make([][]byte, len(batchReq))
 
 		for i, req := range batchReq {
-			responses[i], err = d.handleSingleWs(req, conn).Bytes()
+			responses[i], err = d.handleSingleWs(ctx, req, conn).Bytes()
 			if err != nil {
 				return nil, err
 			}
@@ -293,10 +389,10 @@ func (d *Dispatcher) HandleWs(reqBody []byte, conn wsConn) ([]byte, error) {
 		return NewRPCResponse(req.ID, "2.0", nil, NewInvalidRequestError("Invalid json request")).Bytes()
 	}
 
-	return d.handleSingleWs(req, conn).Bytes()
+	return d.handleSingleWs(ctx, req, conn).Bytes()
 }
 
-func (d *Dispatcher) handleSingleWs(req Request, conn wsConn) Response {
+func (d *Dispatcher) handleSingleWs(ctx context.Context, req Request, conn wsConn) Response {
 	id, err := formatID(req.ID)
 	if err != nil {
 		return NewRPCResponse(nil, "2.0", nil, err)
@@ -304,6 +400,10 @@ func (d *Dispatcher) handleSingleWs(req Request, conn wsConn) Response {
 
 	var response []byte
 
+	if isSubscriptionMethod(req.Method) && !d.allows(req.Method) {
+		return NewRPCResponse(id, "2.0", nil, NewMethodNotFoundError(req.Method))
+	}
+
 	switch req.Method {
 	case "eth_subscribe":
 		var filterID string
@@ -320,13 +420,13 @@ func (d *Dispatcher) handleSingleWs(req Request, conn wsConn) Response {
 		}
 	default:
 		// its a normal query that we handle with the dispatcher
-		response, err = d.handleReq(req)
+		response, err = d.handleReq(ctx, req)
 	}
 
 	return NewRPCResponse(id, "2.0", response, err)
 }
 
-func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
+func (d *Dispatcher) Handle(ctx context.Context, reqBody []byte) ([]byte, error) {
 	x := bytes.TrimLeft(reqBody, " \t\r\n")
 	if len(x) == 0 {
 		return NewRPCResponse(nil, "2.0", nil, NewInvalidRequestError("Invalid json request")).Bytes()
@@ -342,7 +442,7 @@ func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
 			return NewRPCResponse(req.ID, "2.0", nil, NewInvalidRequestError("Invalid json request")).Bytes()
 		}
 
-		resp, err := d.handleReq(req)
+		resp, err := d.handleReq(ctx, req)
 
 		return NewRPCResponse(req.ID, "2.0", resp, err).Bytes()
 	}
@@ -371,7 +471,7 @@ func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
 	responses := make([]Response, 0)
 
 	for _, req := range requests {
-		var response, err = d.handleReq(req)
+		var response, err = d.handleReq(ctx, req)
 		if err != nil {
 			errorResponse := NewRPCResponse(req.ID, "2.0", response, err)
 			responses = append(responses, errorResponse)
@@ -391,7 +491,7 @@ func (d *Dispatcher) Handle(reqBody []byte) ([]byte, error) {
 	return respBytes, nil
 }
 
-func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
+func (d *Dispatcher) handleReq(ctx context.Context, req Request) (data []byte, rpcErr Error) {
 	d.logger.Debug("request", "method", req.Method, "id", req.ID)
 
 	service, fd, ferr := d.getFnHandler(req)
@@ -399,15 +499,34 @@ func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
 		return nil, ferr
 	}
 
+	ctx, span := rpcTracer.Start(ctx, req.Method,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "jsonrpc"),
+			attribute.String("rpc.method", req.Method),
+		))
+
+	defer func() {
+		if rpcErr != nil {
+			tracing.RecordError(span, rpcErr)
+		}
+
+		span.End()
+	}()
+
 	inArgs := make([]reflect.Value, fd.inNum)
 	inArgs[0] = service.sv
 
+	if fd.withCtx {
+		inArgs[1] = reflect.ValueOf(ctx)
+	}
+
 	inputs := make([]interface{}, fd.numParams())
 
-	for i := 0; i < fd.inNum-1; i++ {
-		val := reflect.New(fd.reqt[i+1])
+	for i := 0; i < fd.numParams(); i++ {
+		val := reflect.New(fd.reqt[i+fd.firstParam()])
 		inputs[i] = val.Interface()
-		inArgs[i+1] = val.Elem()
+		inArgs[i+fd.firstParam()] = val.Elem()
 	}
 
 	if fd.numParams() > 0 {
@@ -417,9 +536,8 @@ func (d *Dispatcher) handleReq(req Request) ([]byte, Error) {
 	}
 
 	var (
-		data []byte
-		err  error
-		ok   bool
+		err error
+		ok  bool
 	)
 
 	start := time.Now().UTC()
@@ -493,9 +611,11 @@ func (d *Dispatcher) registerService(serviceName string, service interface{}) er
 		if fd.inNum, fd.reqt, err = validateFunc(funcName, fd.fv, true); err != nil {
 			return fmt.Errorf("jsonrpc: %w", err)
 		}
+
+		fd.withCtx = fd.inNum > 1 && fd.reqt[1] == contextType
 		// check if last item is a pointer
 		if fd.numParams() != 0 {
-			last := fd.reqt[fd.numParams()]
+			last := fd.reqt[fd.inNum-1]
 			if last.Kind() == reflect.Ptr {
 				fd.isDyn = true
 			}
@@ -553,7 +673,10 @@ func validateFunc(funcName string, fv reflect.Value, _ bool) (inNum int, reqt []
 	return
 }
 
-var errt = reflect.TypeOf((*error)(nil)).Elem()
+var (
+	errt        = reflect.TypeOf((*error)(nil)).Elem()
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+)
 
 func isErrorType(t reflect.Type) bool {
 	return t.Implements(errt)
@@ -1,6 +1,7 @@
 package jsonrpc
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -27,7 +28,7 @@ func FuzzDispatcherFuncDecode(f *testing.F) {
 	require.NoError(f, dispatcher.registerService("mock", srv))
 
 	handleReq := func(typ string, msg string) interface{} {
-		_, err := dispatcher.handleReq(Request{
+		_, err := dispatcher.handleReq(context.Background(), Request{
 			Method: "mock_" + typ,
 			Params: []byte(msg),
 		})
@@ -152,9 +153,9 @@ func FuzzDispatcherBatchRequest(f *testing.F) {
 
 		body := fmt.Sprintf(`[{"id":1,"jsonrpc":"2.0","method":"eth_getBlockByNumber","params": %s}]`, params)
 
-		_, err := dispatcher.HandleWs([]byte(body), mock)
+		_, err := dispatcher.HandleWs(context.Background(), []byte(body), mock)
 		assert.NoError(t, err)
-		_, err = dispatcher.Handle([]byte(body))
+		_, err = dispatcher.Handle(context.Background(), []byte(body))
 		assert.NoError(t, err)
 	})
 }
@@ -192,7 +193,7 @@ func FuzzDispatcherWebsocketConnectionUnsubscribe(f *testing.F) {
 	}
 
 	f.Fuzz(func(t *testing.T, request string) {
-		_, err := dispatcher.HandleWs([]byte(request), mockConn)
+		_, err := dispatcher.HandleWs(context.Background(), []byte(request), mockConn)
 		assert.NoError(t, err)
 	})
 }
@@ -251,6 +252,6 @@ func FuzzDispatcherWebSocketConnectionRequestFormats(f *testing.F) {
 	}
 
 	f.Fuzz(func(t *testing.T, request string) {
-		_, _ = dispatcher.HandleWs([]byte(request), mockConnection)
+		_, _ = dispatcher.HandleWs(context.Background(), []byte(request), mockConnection)
 	})
 }
@@ -1,6 +1,7 @@
 package jsonrpc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -82,7 +83,7 @@ func TestDispatcher_HandleWebsocketConnection_EthSubscribe(t *testing.T) {
 		"method": "eth_subscribe",
 		"params": ["newHeads"]
 	}`)
-		_, err := dispatcher.HandleWs(req, mockConnection)
+		_, err := dispatcher.HandleWs(context.Background(), req, mockConnection)
 		require.NoError(t, err)
 
 		store.emitEvent(&mockEvent{
@@ -111,7 +112,7 @@ func TestDispatcher_HandleWebsocketConnection_EthSubscribe(t *testing.T) {
 		"method": "eth_subscribe",
 		"params": ["newPendingTransactions"]
 	}`)
-		_, err := dispatcher.HandleWs(req, mockConnection)
+		_, err := dispatcher.HandleWs(context.Background(), req, mockConnection)
 		require.NoError(t, err)
 
 		store.emitTxPoolEvent(proto.EventType_ADDED, "evt1")
@@ -186,7 +187,7 @@ func TestDispatcher_WebsocketConnection_RequestFormats(t *testing.T) {
 		},
 	}
 	for _, c := range cases {
-		data, err := dispatcher.HandleWs(c.msg, mockConnection)
+		data, err := dispatcher.HandleWs(context.Background(), c.msg, mockConnection)
 		resp := new(SuccessResponse)
 		merr := json.Unmarshal(data, resp)
 
@@ -236,6 +237,14 @@ func (m *mockService) Filter(f LogQuery) (interface{}, error) {
 	return nil, nil
 }
 
+type mockContextKey struct{}
+
+func (m *mockService) BlockWithContext(ctx context.Context, f BlockNumber) (interface{}, error) {
+	m.msgCh <- []interface{}{ctx.Value(mockContextKey{}), f}
+
+	return nil, nil
+}
+
 func TestDispatcherFuncDecode(t *testing.T) {
 	t.Parallel()
 
@@ -255,7 +264,7 @@ func TestDispatcherFuncDecode(t *testing.T) {
 	require.NoError(t, dispatcher.registerService("mock", srv))
 
 	handleReq := func(typ string, msg string) interface{} {
-		_, err := dispatcher.handleReq(Request{
+		_, err := dispatcher.handleReq(context.Background(), Request{
 			Method: "mock_" + typ,
 			Params: []byte(msg),
 		})
@@ -314,6 +323,13 @@ func TestDispatcherFuncDecode(t *testing.T) {
 			t.Fatal("no tx pool events received in the predefined time slot")
 		}
 	}
+
+	// the context of the request is passed to the functions which take it before the parameters
+	ctx := context.WithValue(context.Background(), mockContextKey{}, "value")
+
+	_, err := dispatcher.handleReq(ctx, Request{Method: "mock_blockWithContext", Params: []byte(`["0x1"]`)})
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{"value", BlockNumber(1)}, <-srv.msgCh)
 }
 
 func TestDispatcherBatchRequest(t *testing.T) {
@@ -496,11 +512,11 @@ func TestDispatcherBatchRequest(t *testing.T) {
 		t.Run(c.name, func(t *testing.T) {
 			t.Parallel()
 
-			res, _ := c.dispatcher.HandleWs(c.reqBody, mock)
+			res, _ := c.dispatcher.HandleWs(context.Background(), c.reqBody, mock)
 
 			check(c, res)
 
-			res, _ = c.dispatcher.Handle(c.reqBody)
+			res, _ = c.dispatcher.Handle(context.Background(), c.reqBody)
 
 			check(c, res)
 		})
@@ -538,19 +554,19 @@ func TestDispatcher_WebsocketConnection_Unsubscribe(t *testing.T) {
 	}
 
 	// non existing subscription
-	r, err := dispatcher.HandleWs(reqUnsub("\"787832\""), mockConn)
+	r, err := dispatcher.HandleWs(context.Background(), reqUnsub("\"787832\""), mockConn)
 	require.NoError(t, err)
 
 	require.NoError(t, json.Unmarshal(r, &resp))
 	assert.Equal(t, "false", string(resp.Result))
 
-	r, err = dispatcher.HandleWs([]byte(`{"method": "eth_subscribe", "params": ["newHeads"]}`), mockConn)
+	r, err = dispatcher.HandleWs(context.Background(), []byte(`{"method": "eth_subscribe", "params": ["newHeads"]}`), mockConn)
 	require.NoError(t, err)
 
 	require.NoError(t, json.Unmarshal(r, &resp))
 
 	// existing subscription
-	r, err = dispatcher.HandleWs(reqUnsub(string(resp.Result)), mockConn)
+	r, err = dispatcher.HandleWs(context.Background(), reqUnsub(string(resp.Result)), mockConn)
 	require.NoError(t, err)
 
 	require.NoError(t, json.Unmarshal(r, &resp))
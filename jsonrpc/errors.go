@@ -76,6 +76,18 @@ func (e *methodNotFoundError) ErrorCode() int {
 	return -32601
 }
 
+type limitExceededError struct {
+	err string
+}
+
+func (e *limitExceededError) Error() string {
+	return e.err
+}
+
+func (e *limitExceededError) ErrorCode() int {
+	return -32005
+}
+
 func NewMethodNotFoundError(method string) *methodNotFoundError {
 	return &methodNotFoundError{fmt.Sprintf("the method %s does not exist/is not available", method)}
 }
@@ -90,6 +102,10 @@ func NewInternalError(msg string) *internalError {
 	return &internalError{msg}
 }
 
+func NewLimitExceededError(msg string) *limitExceededError {
+	return &limitExceededError{msg}
+}
+
 func NewSubscriptionNotFoundError(method string) *subscriptionNotFoundError {
 	return &subscriptionNotFoundError{fmt.Sprintf("subscribe method %s not found", method)}
 }
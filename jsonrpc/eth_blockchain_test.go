@@ -13,6 +13,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/txpool/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEth_Block_GetBlockByNumber(t *testing.T) {
@@ -53,6 +54,34 @@ func TestEth_Block_GetBlockByNumber(t *testing.T) {
 	}
 }
 
+func TestEth_Block_GetBlockByNumber_Pending(t *testing.T) {
+	store := &mockBlockStore{}
+	for i := 0; i < 10; i++ {
+		store.add(newTestBlock(uint64(i), hash1))
+	}
+
+	eth := newTestEthEndpoint(store)
+
+	// no pending block, resolves to the latest block
+	res, err := eth.GetBlockByNumber(PendingBlockNumber, false)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, argUint64(9), res.(*block).Number) //nolint:forcetypeassert
+
+	store.pendingBlock = newTestBlock(10, hash2)
+	store.pendingBlock.Transactions = []*types.Transaction{{Nonce: 0}, {Nonce: 1}}
+
+	res, err = eth.GetBlockByNumber(PendingBlockNumber, false)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	assert.Equal(t, argUint64(10), res.(*block).Number) //nolint:forcetypeassert
+	assert.Equal(t, hash2, res.(*block).Hash)           //nolint:forcetypeassert
+
+	count, err := eth.GetBlockTransactionCountByNumber(PendingBlockNumber)
+	require.NoError(t, err)
+	assert.Equal(t, "0x2", count)
+}
+
 func TestEth_Block_GetBlockByHash(t *testing.T) {
 	store := &mockBlockStore{}
 	store.add(newTestBlock(1, hash1))
@@ -456,6 +485,7 @@ type mockBlockStore struct {
 	returnValue     []byte
 	forksInTime     chain.ForksInTime
 	baseFee         uint64
+	pendingBlock    *types.Block
 
 	maxPriorityFeePerGasFn func() (*big.Int, error)
 }
@@ -468,6 +498,10 @@ func newMockBlockStore() *mockBlockStore {
 	return store
 }
 
+func (m *mockBlockStore) PendingBlock() *types.Block {
+	return m.pendingBlock
+}
+
 func (m *mockBlockStore) add(blocks ...*types.Block) {
 	if m.blocks == nil {
 		m.blocks = []*types.Block{}
@@ -1,6 +1,7 @@
 package jsonrpc
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -19,7 +20,7 @@ import (
 
 type ethTxPoolStore interface {
 	// AddTx adds a new transaction to the tx pool
-	AddTx(tx *types.Transaction) error
+	AddTx(ctx context.Context, tx *types.Transaction) error
 
 	// GetPendingTx gets the pending transaction from the transaction pool, if it's present
 	GetPendingTx(txHash types.Hash) (*types.Transaction, bool)
@@ -75,6 +76,9 @@ type ethBlockchainStore interface {
 
 	// GetSyncProgression retrieves the current sync progression, if any
 	GetSyncProgression() *progress.Progression
+
+	// PendingBlock returns the speculative pending block, nil if it is not available
+	PendingBlock() *types.Block
 }
 
 type ethFilter interface {
@@ -98,6 +102,9 @@ type Eth struct {
 	chainID       uint64
 	filterManager *FilterManager
 	priceLimit    uint64
+
+	// devStore sends the transactions of the impersonated accounts, it is set only with the dev consensus
+	devStore DevStore
 }
 
 var (
@@ -128,6 +135,12 @@ func (e *Eth) Syncing() (interface{}, error) {
 
 // GetBlockByNumber returns information about a block by block number
 func (e *Eth) GetBlockByNumber(number BlockNumber, fullTx bool) (interface{}, error) {
+	if number == PendingBlockNumber {
+		if block := e.store.PendingBlock(); block != nil {
+			return toBlock(block, fullTx), nil
+		}
+	}
+
 	num, err := GetNumericBlockNumber(number, e.store)
 	if err != nil {
 		return nil, err
@@ -177,6 +190,12 @@ func (e *Eth) filterExtra(block *types.Block) error {
 }
 
 func (e *Eth) GetBlockTransactionCountByNumber(number BlockNumber) (interface{}, error) {
+	if number == PendingBlockNumber {
+		if block := e.store.PendingBlock(); block != nil {
+			return *common.EncodeUint64(uint64(len(block.Transactions))), nil
+		}
+	}
+
 	num, err := GetNumericBlockNumber(number, e.store)
 	if err != nil {
 		return nil, err
@@ -201,25 +220,54 @@ func (e *Eth) BlockNumber() (interface{}, error) {
 	return argUintPtr(h.Number), nil
 }
 
-// SendRawTransaction sends a raw transaction
-func (e *Eth) SendRawTransaction(buf argBytes) (interface{}, error) {
+// SendRawTransaction sends a raw transaction, the transaction is traced as a child of the request
+func (e *Eth) SendRawTransaction(ctx context.Context, buf argBytes) (interface{}, error) {
 	tx := &types.Transaction{}
 	if err := tx.UnmarshalRLP(buf); err != nil {
 		return nil, err
 	}
 
 	// tx hash will be calculated inside e.store.AddTx
-	if err := e.store.AddTx(tx); err != nil {
+	if err := e.store.AddTx(ctx, tx); err != nil {
 		return nil, err
 	}
 
 	return tx.Hash.String(), nil
 }
 
-// SendTransaction rejects eth_sendTransaction json-rpc call as we don't support wallet management
-func (e *Eth) SendTransaction(_ *txnArgs) (interface{}, error) {
-	return nil, fmt.Errorf("request calls to eth_sendTransaction method are not supported," +
-		" use eth_sendRawTransaction instead")
+// SendTransaction rejects eth_sendTransaction json-rpc call as we don't support wallet management.
+// The only exception are the accounts impersonated with the dev consensus, whose transactions are sealed unsigned
+func (e *Eth) SendTransaction(arg *txnArgs) (interface{}, error) {
+	if e.devStore == nil || arg == nil || arg.From == nil || !e.devStore.IsImpersonated(*arg.From) {
+		return nil, fmt.Errorf("request calls to eth_sendTransaction method are not supported," +
+			" use eth_sendRawTransaction instead")
+	}
+
+	if arg.Gas == nil {
+		gas, err := e.EstimateGas(arg, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		estimatedGas := gas.(argUint64) //nolint:forcetypeassert
+		arg.Gas = &estimatedGas
+	}
+
+	tx, err := DecodeTxn(arg, e.store.Header(), e.store, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := e.fillTransactionGasPrice(tx); err != nil {
+		return nil, err
+	}
+
+	hash, err := e.devStore.SendImpersonatedTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return hash.String(), nil
 }
 
 // GetTransactionByHash returns a transaction by its hash.
@@ -466,7 +514,7 @@ func (e *Eth) Call(arg *txnArgs, filter BlockNumberOrHash, apiOverride *stateOve
 		return nil, err
 	}
 
-	transaction, err := DecodeTxn(arg, header.Number, e.store, true)
+	transaction, err := DecodeTxn(arg, header, e.store, true)
 	if err != nil {
 		return nil, err
 	}
@@ -521,7 +569,7 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 	}
 
 	// testTransaction should execute tx with nonce always set to the current expected nonce for the account
-	transaction, err := DecodeTxn(arg, header.Number, e.store, true)
+	transaction, err := DecodeTxn(arg, header, e.store, true)
 	if err != nil {
 		return nil, err
 	}
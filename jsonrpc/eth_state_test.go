@@ -11,6 +11,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var (
@@ -151,6 +152,105 @@ func TestEth_State_GetBalance(t *testing.T) {
 	}
 }
 
+func TestEth_State_PendingBlock(t *testing.T) {
+	pendingRoot := types.StringToHash("0x1234")
+
+	store := &mockSpecialStore{
+		account: &mockAccount{
+			address: addr0,
+			account: &Account{Balance: big.NewInt(100), Nonce: 1},
+			storage: make(map[types.Hash][]byte),
+		},
+		block: &types.Block{
+			Header: &types.Header{
+				Hash:      types.ZeroHash,
+				Number:    0,
+				StateRoot: types.EmptyRootHash,
+			},
+		},
+	}
+
+	eth := newTestEthEndpoint(store)
+	pending := PendingBlockNumber
+	latest := LatestBlockNumber
+
+	getBalance := func(number *BlockNumber) *big.Int {
+		balance, err := eth.GetBalance(addr0, BlockNumberOrHash{BlockNumber: number})
+		require.NoError(t, err)
+
+		return (*big.Int)(balance.(*argBig)) //nolint:forcetypeassert
+	}
+
+	getTransactionCount := func(number *BlockNumber) uint64 {
+		nonce, err := eth.GetTransactionCount(addr0, BlockNumberOrHash{BlockNumber: number})
+		require.NoError(t, err)
+
+		return uint64(*nonce.(*argUint64)) //nolint:forcetypeassert
+	}
+
+	t.Run("no pending block falls back to the latest state and the txpool nonce", func(t *testing.T) {
+		assert.Equal(t, big.NewInt(100), getBalance(&pending))
+		assert.Equal(t, uint64(1), getTransactionCount(&pending)) // mock txpool nonce
+	})
+
+	store.pendingBlock = &types.Block{
+		Header: &types.Header{
+			Hash:       types.StringToHash("0x1"),
+			ParentHash: types.ZeroHash,
+			Number:     1,
+			StateRoot:  pendingRoot,
+		},
+	}
+	store.pendingAccount = &mockAccount{
+		address: addr0,
+		account: &Account{Balance: big.NewInt(70), Nonce: 3},
+		storage: make(map[types.Hash][]byte),
+	}
+
+	t.Run("pending block state is used for the pending tag", func(t *testing.T) {
+		assert.Equal(t, big.NewInt(70), getBalance(&pending))
+		assert.Equal(t, uint64(3), getTransactionCount(&pending))
+	})
+
+	t.Run("txpool nonce is used if it is ahead of the pending block", func(t *testing.T) {
+		store.poolNonce = 5
+		defer func() { store.poolNonce = 0 }()
+
+		assert.Equal(t, uint64(5), getTransactionCount(&pending))
+	})
+
+	t.Run("latest tag is not affected by the pending block", func(t *testing.T) {
+		assert.Equal(t, big.NewInt(100), getBalance(&latest))
+		assert.Equal(t, uint64(1), getTransactionCount(&latest))
+	})
+
+	t.Run("eth_call is executed on top of the pending block", func(t *testing.T) {
+		var calledHeader *types.Header
+
+		store.applyTxnHook = func(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error) {
+			calledHeader = header
+
+			// nonce defaults to the latest nonce of the sender, as for the other blocks
+			assert.Equal(t, uint64(1), txn.Nonce)
+
+			return &runtime.ExecutionResult{}, nil
+		}
+
+		to := types.StringToAddress("0xff")
+		args := &txnArgs{
+			From:     &addr0,
+			To:       &to,
+			Data:     &argBytes{0x1},
+			GasPrice: toArgBytesPtr(big.NewInt(1).Bytes()),
+		}
+
+		_, err := eth.Call(args, BlockNumberOrHash{BlockNumber: &pending}, nil)
+		require.NoError(t, err)
+		require.NotNil(t, calledHeader)
+		assert.Equal(t, pendingRoot, calledHeader.StateRoot)
+	})
+}
+
 func TestEth_State_GetTransactionCount(t *testing.T) {
 	store := &mockSpecialStore{
 		account: &mockAccount{
@@ -832,6 +932,13 @@ type mockSpecialStore struct {
 	account *mockAccount
 	block   *types.Block
 
+	// pendingAccount is the account state in the pending block (if any)
+	pendingAccount *mockAccount
+	pendingBlock   *types.Block
+
+	// poolNonce is the nonce of the account in the txpool, 1 if not set
+	poolNonce uint64
+
 	applyTxnHook func(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error)
 }
 
@@ -843,7 +950,23 @@ func (m *mockSpecialStore) GetBlockByHash(hash types.Hash, full bool) (*types.Bl
 	return m.block, true
 }
 
+func (m *mockSpecialStore) GetBaseFee() uint64 {
+	return 0
+}
+
+func (m *mockSpecialStore) PendingBlock() *types.Block {
+	return m.pendingBlock
+}
+
 func (m *mockSpecialStore) GetAccount(root types.Hash, addr types.Address) (*Account, error) {
+	if m.pendingBlock != nil && m.pendingAccount != nil && root == m.pendingBlock.Header.StateRoot {
+		if m.pendingAccount.address != addr {
+			return nil, ErrStateNotFound
+		}
+
+		return m.pendingAccount.account, nil
+	}
+
 	if m.account.address != addr {
 		return nil, ErrStateNotFound
 	}
@@ -872,6 +995,10 @@ func (m *mockSpecialStore) GetHeaderByNumber(num uint64) (*types.Header, bool) {
 }
 
 func (m *mockSpecialStore) GetNonce(addr types.Address) uint64 {
+	if m.poolNonce != 0 {
+		return m.poolNonce
+	}
+
 	return 1
 }
 
@@ -1,6 +1,7 @@
 package jsonrpc
 
 import (
+	"context"
 	"math/big"
 	"testing"
 
@@ -19,7 +20,7 @@ func TestEth_TxnPool_SendRawTransaction(t *testing.T) {
 	txn.ComputeHash(1)
 
 	data := txn.MarshalRLP()
-	_, err := eth.SendRawTransaction(data)
+	_, err := eth.SendRawTransaction(context.Background(), data)
 	assert.NoError(t, err)
 	assert.NotEqual(t, store.txn.Hash, types.ZeroHash)
 
@@ -41,7 +42,7 @@ func TestEth_TxnPool_SendTransaction(t *testing.T) {
 		GasPrice: big.NewInt(int64(1)),
 	}
 
-	_, err := eth.SendRawTransaction(txToSend.MarshalRLP())
+	_, err := eth.SendRawTransaction(context.Background(), txToSend.MarshalRLP())
 	assert.NoError(t, err)
 	assert.NotEqual(t, store.txn.Hash, types.ZeroHash)
 }
@@ -52,7 +53,7 @@ type mockStoreTxn struct {
 	txn      *types.Transaction
 }
 
-func (m *mockStoreTxn) AddTx(tx *types.Transaction) error {
+func (m *mockStoreTxn) AddTx(_ context.Context, tx *types.Transaction) error {
 	m.txn = tx
 
 	tx.ComputeHash(1)
@@ -60,6 +61,10 @@ func (m *mockStoreTxn) AddTx(tx *types.Transaction) error {
 	return nil
 }
 
+func (m *mockStoreTxn) PendingBlock() *types.Block {
+	return nil
+}
+
 func (m *mockStoreTxn) GetNonce(addr types.Address) uint64 {
 	return 1
 }
@@ -0,0 +1,195 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	graphqlotel "github.com/graph-gophers/graphql-go/trace/otel"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
+)
+
+const (
+	// graphQLMaxDepth is the maximum depth of the selections of a GraphQL query
+	graphQLMaxDepth = 16
+
+	// graphQLMaxBodySize is the maximum size of the body of a GraphQL request
+	graphQLMaxBodySize = 1 << 20
+)
+
+var errGraphQLDisabled = errors.New("graphql is disabled")
+
+// graphQLRequest is the body of a GraphQL request
+type graphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// graphQLCostKey is the key of the cost of the GraphQL request in the context of its resolvers
+type graphQLCostKey struct{}
+
+// graphQLCost accounts the cost of a GraphQL request. Every resolver which loads data takes the cost
+// of the equivalent JSON-RPC method, so the query is limited by the policies and the rate limit of the client
+type graphQLCost struct {
+	// d is the dispatcher of the client, whose policies allow the methods
+	d *Dispatcher
+
+	// take takes the cost from the bucket of the client, it returns an error if the request is limited
+	take func(cost int) error
+
+	lock  sync.Mutex
+	total uint64
+}
+
+// newGraphQLSchema parses the EIP-1767 schema, which is resolved by the endpoints of the dispatcher
+func newGraphQLSchema(d *Dispatcher) (*graphql.Schema, error) {
+	return graphql.ParseSchema(
+		graphQLSchema,
+		&gqlResolver{d: d},
+		graphql.MaxDepth(graphQLMaxDepth),
+		graphql.Tracer(&graphqlotel.Tracer{Tracer: rpcTracer}),
+	)
+}
+
+// HandleGraphQL executes the GraphQL request. The resolvers take their costs with the take function
+func (d *Dispatcher) HandleGraphQL(
+	ctx context.Context,
+	reqBody []byte,
+	take func(cost int) error,
+) (*graphql.Response, error) {
+	if d.graphQL == nil {
+		return nil, errGraphQLDisabled
+	}
+
+	var req graphQLRequest
+	if err := json.Unmarshal(reqBody, &req); err != nil {
+		return nil, fmt.Errorf("invalid graphql request: %w", err)
+	}
+
+	ctx = context.WithValue(ctx, graphQLCostKey{}, &graphQLCost{d: d, take: take})
+
+	return d.graphQL.Exec(ctx, req.Query, req.OperationName, req.Variables), nil
+}
+
+// chargeGraphQL takes the cost of the JSON-RPC method and the additional cost of the work requested
+// from the GraphQL request. It returns an error if the method isn't allowed,
+// the cost of the query exceeds the limit or the client is rate limited
+func chargeGraphQL(ctx context.Context, method string, extra uint64) error {
+	c, ok := ctx.Value(graphQLCostKey{}).(*graphQLCost)
+	if !ok {
+		return nil
+	}
+
+	if !c.d.allows(method) {
+		return fmt.Errorf("the method %s is not allowed", method)
+	}
+
+	cost := c.d.methodCost(method) + extra
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if limit := c.d.params.graphQLMaxCost; limit != 0 && c.total+cost > limit {
+		return fmt.Errorf("the query cost exceeds the limit of %d", limit)
+	}
+
+	if c.take != nil {
+		if err := c.take(int(cost)); err != nil {
+			return err
+		}
+	}
+
+	c.total += cost
+
+	return nil
+}
+
+// handleGraphQL serves the GraphQL requests. A request takes one unit from the bucket of its client up front,
+// its resolvers take the costs of the JSON-RPC methods as the query is executed
+func (j *JSONRPC) handleGraphQL(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", allowedHeaders)
+
+	switch req.Method {
+	case http.MethodPost:
+	case http.MethodOptions:
+		// nothing to return
+		return
+	default:
+		writeGraphQLError(w, http.StatusMethodNotAllowed, "method "+req.Method+" not allowed")
+
+		return
+	}
+
+	d, client, err := j.client(req)
+	if err != nil {
+		writeGraphQLError(w, http.StatusUnauthorized, "unauthorized: "+err.Error())
+
+		return
+	}
+
+	if limitErr := j.take(req, client, 1); limitErr != nil {
+		if limitErr.retryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(limitErr.retryAfterSeconds()))
+		}
+
+		writeGraphQLError(w, http.StatusTooManyRequests, limitErr.Error())
+
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, req.Body, graphQLMaxBodySize))
+	if err != nil {
+		writeGraphQLError(w, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	take := func(cost int) error {
+		if limitErr := j.take(req, client, cost); limitErr != nil {
+			return limitErr
+		}
+
+		return nil
+	}
+
+	resp, err := d.HandleGraphQL(tracing.Extract(req.Context(), propagation.HeaderCarrier(req.Header)), data, take)
+	if err != nil {
+		writeGraphQLError(w, http.StatusBadRequest, err.Error())
+
+		return
+	}
+
+	body, err := json.Marshal(resp)
+	if err != nil {
+		writeGraphQLError(w, http.StatusInternalServerError, err.Error())
+
+		return
+	}
+
+	// the queries which are rejected before their execution (e.g. invalid ones) have no data
+	if len(resp.Errors) > 0 && resp.Data == nil {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+
+	_, _ = w.Write(body)
+}
+
+func writeGraphQLError(w http.ResponseWriter, status int, message string) {
+	body, _ := json.Marshal(&graphql.Response{Errors: []*gqlerrors.QueryError{{Message: message}}})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,1040 @@
+package jsonrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// The resolvers of the EIP-1767 schema. Each field which loads data from the store
+// takes the cost of the equivalent JSON-RPC method with chargeGraphQL
+
+// gqlResolver resolves the queries and the mutations of the schema
+type gqlResolver struct {
+	d *Dispatcher
+}
+
+type gqlBlockArgs struct {
+	Number *gqlLong
+	Hash   *gqlBytes32
+}
+
+// Block returns the block by number or hash, the latest block if neither is set
+func (r *gqlResolver) Block(ctx context.Context, args gqlBlockArgs) (*gqlBlock, error) {
+	if args.Hash != nil {
+		if err := chargeGraphQL(ctx, "eth_getBlockByHash", 0); err != nil {
+			return nil, err
+		}
+
+		block, ok := r.d.store.GetBlockByHash(types.Hash(*args.Hash), true)
+
+		return newGQLBlock(r.d, block, ok)
+	}
+
+	if err := chargeGraphQL(ctx, "eth_getBlockByNumber", 0); err != nil {
+		return nil, err
+	}
+
+	number := r.d.store.Header().Number
+	if args.Number != nil {
+		number = uint64(*args.Number)
+	}
+
+	block, ok := r.d.store.GetBlockByNumber(number, true)
+
+	return newGQLBlock(r.d, block, ok)
+}
+
+type gqlBlocksArgs struct {
+	From gqlLong
+	To   *gqlLong
+}
+
+// Blocks returns the blocks of the range, it stops at the latest block
+func (r *gqlResolver) Blocks(ctx context.Context, args gqlBlocksArgs) ([]*gqlBlock, error) {
+	from, to := uint64(args.From), r.d.store.Header().Number
+
+	if args.To != nil {
+		if uint64(*args.To) < from {
+			return nil, ErrIncorrectBlockRange
+		}
+
+		// the range is limited by the blocks the node has, not by the requested one
+		if uint64(*args.To) < to {
+			to = uint64(*args.To)
+		}
+	}
+
+	if to < from {
+		return []*gqlBlock{}, nil
+	}
+
+	if limit := r.d.params.blockRangeLimit; limit != 0 && to-from > limit {
+		return nil, ErrBlockRangeTooHigh
+	}
+
+	blocks := []*gqlBlock{}
+
+	for number := from; number <= to; number++ {
+		if err := chargeGraphQL(ctx, "eth_getBlockByNumber", 0); err != nil {
+			return nil, err
+		}
+
+		block, ok := r.d.store.GetBlockByNumber(number, true)
+		if !ok {
+			break
+		}
+
+		resolver, err := newGQLBlock(r.d, block, ok)
+		if err != nil {
+			return nil, err
+		}
+
+		blocks = append(blocks, resolver)
+	}
+
+	return blocks, nil
+}
+
+// Pending returns the pending block, or an empty block on top of the latest one if the node doesn't build it
+func (r *gqlResolver) Pending(ctx context.Context) (*gqlPending, error) {
+	if err := chargeGraphQL(ctx, "eth_getBlockByNumber", 0); err != nil {
+		return nil, err
+	}
+
+	if block := r.d.store.PendingBlock(); block != nil {
+		return &gqlPending{d: r.d, header: block.Header, transactions: block.Transactions}, nil
+	}
+
+	return &gqlPending{d: r.d, header: r.d.store.Header()}, nil
+}
+
+type gqlHashArgs struct {
+	Hash gqlBytes32
+}
+
+// Transaction returns the transaction by hash, either included in a block or pending in the pool
+func (r *gqlResolver) Transaction(ctx context.Context, args gqlHashArgs) (*gqlTransaction, error) {
+	if err := chargeGraphQL(ctx, "eth_getTransactionByHash", 0); err != nil {
+		return nil, err
+	}
+
+	hash := types.Hash(args.Hash)
+
+	if tx, block := GetTxAndBlockByTxHash(hash, r.d.store); tx != nil {
+		resolver, err := newGQLBlock(r.d, block, true)
+		if err != nil {
+			return nil, err
+		}
+
+		_, index := types.FindTxByHash(block.Transactions, hash)
+
+		return &gqlTransaction{d: r.d, tx: tx, block: resolver, index: index}, nil
+	}
+
+	if tx, ok := r.d.store.GetPendingTx(hash); ok {
+		return &gqlTransaction{d: r.d, tx: tx}, nil
+	}
+
+	return nil, nil
+}
+
+type gqlFilterCriteria struct {
+	FromBlock *gqlLong
+	ToBlock   *gqlLong
+	Addresses *[]gqlAddress
+	Topics    *[][]gqlBytes32
+}
+
+type gqlLogsArgs struct {
+	Filter gqlFilterCriteria
+}
+
+// Logs returns the logs of the range of blocks matching the filter
+func (r *gqlResolver) Logs(ctx context.Context, args gqlLogsArgs) ([]*gqlLog, error) {
+	query := newGQLLogQuery(args.Filter.Addresses, args.Filter.Topics)
+	query.fromBlock, query.toBlock = LatestBlockNumber, LatestBlockNumber
+
+	if args.Filter.FromBlock != nil {
+		query.fromBlock = BlockNumber(*args.Filter.FromBlock)
+	}
+
+	if args.Filter.ToBlock != nil {
+		query.toBlock = BlockNumber(*args.Filter.ToBlock)
+	}
+
+	var extra uint64
+
+	from, fromErr := GetNumericBlockNumber(query.fromBlock, r.d.store)
+	to, toErr := GetNumericBlockNumber(query.toBlock, r.d.store)
+
+	if fromErr == nil && toErr == nil && to >= from {
+		extra = (to - from + 1) / logsBlocksPerCostUnit
+	}
+
+	if err := chargeGraphQL(ctx, "eth_getLogs", extra); err != nil {
+		return nil, err
+	}
+
+	logs, err := r.d.filterManager.GetLogsForQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	// the logs of a block share its resolver, so its receipts are loaded once
+	blocks := make(map[types.Hash]*gqlBlock)
+	resolvers := make([]*gqlLog, 0, len(logs))
+
+	for _, log := range logs {
+		block, ok := blocks[log.BlockHash]
+		if !ok {
+			raw, found := r.d.store.GetBlockByHash(log.BlockHash, true)
+			if !found {
+				return nil, ErrBlockNotFound
+			}
+
+			if block, err = newGQLBlock(r.d, raw, found); err != nil {
+				return nil, err
+			}
+
+			blocks[log.BlockHash] = block
+		}
+
+		resolvers = append(resolvers, &gqlLog{d: r.d, log: log, block: block})
+	}
+
+	return resolvers, nil
+}
+
+// GasPrice returns the gas price suggested by the node
+func (r *gqlResolver) GasPrice(ctx context.Context) (gqlBigInt, error) {
+	if err := chargeGraphQL(ctx, "eth_gasPrice", 0); err != nil {
+		return gqlBigInt{}, err
+	}
+
+	gasPrice, err := r.d.endpoints.Eth.getGasPrice()
+	if err != nil {
+		return gqlBigInt{}, err
+	}
+
+	return *newGQLBigInt(new(big.Int).SetUint64(gasPrice)), nil
+}
+
+// MaxPriorityFeePerGas returns the priority fee suggested by the node
+func (r *gqlResolver) MaxPriorityFeePerGas(ctx context.Context) (gqlBigInt, error) {
+	if err := chargeGraphQL(ctx, "eth_maxPriorityFeePerGas", 0); err != nil {
+		return gqlBigInt{}, err
+	}
+
+	priorityFee, err := r.d.store.MaxPriorityFeePerGas()
+	if err != nil {
+		return gqlBigInt{}, err
+	}
+
+	return *newGQLBigInt(priorityFee), nil
+}
+
+// Syncing returns the progress of the synchronization, nil if the node isn't syncing
+func (r *gqlResolver) Syncing(ctx context.Context) (*gqlSyncState, error) {
+	if err := chargeGraphQL(ctx, "eth_syncing", 0); err != nil {
+		return nil, err
+	}
+
+	progression := r.d.store.GetSyncProgression()
+	if progression == nil {
+		return nil, nil
+	}
+
+	return &gqlSyncState{progression: progression}, nil
+}
+
+// ChainID returns the chain id
+func (r *gqlResolver) ChainID(ctx context.Context) (gqlBigInt, error) {
+	if err := chargeGraphQL(ctx, "eth_chainId", 0); err != nil {
+		return gqlBigInt{}, err
+	}
+
+	return *newGQLBigInt(new(big.Int).SetUint64(r.d.params.chainID)), nil
+}
+
+type gqlSendRawTransactionArgs struct {
+	Data gqlBytes
+}
+
+// SendRawTransaction adds the signed transaction to the pool and returns its hash
+func (r *gqlResolver) SendRawTransaction(ctx context.Context, args gqlSendRawTransactionArgs) (gqlBytes32, error) {
+	if err := chargeGraphQL(ctx, "eth_sendRawTransaction", 0); err != nil {
+		return gqlBytes32{}, err
+	}
+
+	tx := &types.Transaction{}
+	if err := tx.UnmarshalRLP(args.Data); err != nil {
+		return gqlBytes32{}, err
+	}
+
+	// tx hash will be calculated inside AddTx
+	if err := r.d.store.AddTx(ctx, tx); err != nil {
+		return gqlBytes32{}, err
+	}
+
+	return gqlBytes32(tx.Hash), nil
+}
+
+// gqlBlock resolves a block of the chain
+type gqlBlock struct {
+	d     *Dispatcher
+	block *types.Block
+
+	// header is the header the block is encoded with, the one of the block has the filtered extra data
+	header *types.Header
+
+	// the receipts are loaded once, by the first field which needs them
+	receiptsOnce sync.Once
+	receipts     []*types.Receipt
+	receiptsErr  error
+}
+
+// newGQLBlock returns the resolver of the block, nil if the store doesn't have it
+func newGQLBlock(d *Dispatcher, block *types.Block, ok bool) (*gqlBlock, error) {
+	if !ok {
+		return nil, nil
+	}
+
+	header := block.Header
+
+	// filterExtra replaces the header of the block, so it is given a copy of the block of the store
+	filtered := &types.Block{Header: block.Header, Transactions: block.Transactions, Uncles: block.Uncles}
+	if err := d.endpoints.Eth.filterExtra(filtered); err != nil {
+		return nil, err
+	}
+
+	return &gqlBlock{d: d, block: filtered, header: header}, nil
+}
+
+// getReceipts returns the receipts of the block, they cost one eth_getTransactionReceipt
+func (b *gqlBlock) getReceipts(ctx context.Context) ([]*types.Receipt, error) {
+	b.receiptsOnce.Do(func() {
+		if b.receiptsErr = chargeGraphQL(ctx, "eth_getTransactionReceipt", 0); b.receiptsErr != nil {
+			return
+		}
+
+		b.receipts, b.receiptsErr = b.d.store.GetReceiptsByHash(b.block.Header.Hash)
+	})
+
+	return b.receipts, b.receiptsErr
+}
+
+func (b *gqlBlock) Number() gqlLong {
+	return gqlLong(b.block.Header.Number)
+}
+
+func (b *gqlBlock) Hash() gqlBytes32 {
+	return gqlBytes32(b.block.Header.Hash)
+}
+
+// Parent returns the parent block, nil for the genesis block
+func (b *gqlBlock) Parent(ctx context.Context) (*gqlBlock, error) {
+	if b.block.Header.Number == 0 {
+		return nil, nil
+	}
+
+	if err := chargeGraphQL(ctx, "eth_getBlockByHash", 0); err != nil {
+		return nil, err
+	}
+
+	block, ok := b.d.store.GetBlockByHash(b.block.Header.ParentHash, true)
+
+	return newGQLBlock(b.d, block, ok)
+}
+
+func (b *gqlBlock) Nonce() gqlBytes {
+	return b.block.Header.Nonce[:]
+}
+
+func (b *gqlBlock) TransactionsRoot() gqlBytes32 {
+	return gqlBytes32(b.block.Header.TxRoot)
+}
+
+func (b *gqlBlock) TransactionCount() *gqlLong {
+	return newGQLLong(uint64(len(b.block.Transactions)))
+}
+
+func (b *gqlBlock) StateRoot() gqlBytes32 {
+	return gqlBytes32(b.block.Header.StateRoot)
+}
+
+func (b *gqlBlock) ReceiptsRoot() gqlBytes32 {
+	return gqlBytes32(b.block.Header.ReceiptsRoot)
+}
+
+type gqlAccountBlockArgs struct {
+	Block *gqlLong
+}
+
+// Miner returns the account of the block proposer
+func (b *gqlBlock) Miner(args gqlAccountBlockArgs) (*gqlAccount, error) {
+	return newGQLAccount(b.d, types.BytesToAddress(b.block.Header.Miner), args.Block, b.block.Header)
+}
+
+func (b *gqlBlock) ExtraData() gqlBytes {
+	return b.block.Header.ExtraData
+}
+
+func (b *gqlBlock) GasLimit() gqlLong {
+	return gqlLong(b.block.Header.GasLimit)
+}
+
+func (b *gqlBlock) GasUsed() gqlLong {
+	return gqlLong(b.block.Header.GasUsed)
+}
+
+// BaseFeePerGas returns the base fee, nil if London isn't enabled at the block
+func (b *gqlBlock) BaseFeePerGas() *gqlBigInt {
+	if !b.d.store.GetForksInTime(b.block.Header.Number).London {
+		return nil
+	}
+
+	return newGQLBigInt(new(big.Int).SetUint64(b.block.Header.BaseFee))
+}
+
+func (b *gqlBlock) Timestamp() gqlLong {
+	return gqlLong(b.block.Header.Timestamp)
+}
+
+func (b *gqlBlock) LogsBloom() gqlBytes {
+	return b.block.Header.LogsBloom[:]
+}
+
+func (b *gqlBlock) MixHash() gqlBytes32 {
+	return gqlBytes32(b.block.Header.MixHash)
+}
+
+func (b *gqlBlock) Difficulty() gqlBigInt {
+	return *newGQLBigInt(new(big.Int).SetUint64(b.block.Header.Difficulty))
+}
+
+func (b *gqlBlock) OmmerHash() gqlBytes32 {
+	return gqlBytes32(b.block.Header.Sha3Uncles)
+}
+
+func (b *gqlBlock) Transactions() *[]*gqlTransaction {
+	txs := make([]*gqlTransaction, len(b.block.Transactions))
+	for i, tx := range b.block.Transactions {
+		txs[i] = &gqlTransaction{d: b.d, tx: tx, block: b, index: i}
+	}
+
+	return &txs
+}
+
+type gqlIndexArgs struct {
+	Index gqlLong
+}
+
+// TransactionAt returns the transaction at the index, nil if the block has fewer transactions
+func (b *gqlBlock) TransactionAt(args gqlIndexArgs) *gqlTransaction {
+	return b.transactionAt(uint64(args.Index))
+}
+
+func (b *gqlBlock) transactionAt(index uint64) *gqlTransaction {
+	if index >= uint64(len(b.block.Transactions)) {
+		return nil
+	}
+
+	return &gqlTransaction{d: b.d, tx: b.block.Transactions[index], block: b, index: int(index)}
+}
+
+type gqlBlockFilterCriteria struct {
+	Addresses *[]gqlAddress
+	Topics    *[][]gqlBytes32
+}
+
+type gqlBlockLogsArgs struct {
+	Filter gqlBlockFilterCriteria
+}
+
+// Logs returns the logs of the block matching the filter
+func (b *gqlBlock) Logs(ctx context.Context, args gqlBlockLogsArgs) ([]*gqlLog, error) {
+	if err := chargeGraphQL(ctx, "eth_getLogs", 0); err != nil {
+		return nil, err
+	}
+
+	query := newGQLLogQuery(args.Filter.Addresses, args.Filter.Topics)
+
+	receipts, err := b.getReceipts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]*gqlLog, 0)
+	logIdx := uint64(0)
+
+	for idx, receipt := range receipts {
+		for _, log := range receipt.Logs {
+			if query.Match(log) {
+				logs = append(logs, &gqlLog{
+					d:     b.d,
+					log:   toLog(log, logIdx, uint64(idx), b.block.Header, b.block.Transactions[idx].Hash),
+					block: b,
+				})
+			}
+
+			logIdx++
+		}
+	}
+
+	return logs, nil
+}
+
+type gqlAddressArgs struct {
+	Address gqlAddress
+}
+
+// Account returns the account at the state of the block
+func (b *gqlBlock) Account(args gqlAddressArgs) *gqlAccount {
+	return &gqlAccount{d: b.d, address: types.Address(args.Address), header: b.block.Header}
+}
+
+type gqlCallArgs struct {
+	Data gqlCallData
+}
+
+// Call executes the message at the state of the block
+func (b *gqlBlock) Call(ctx context.Context, args gqlCallArgs) (*gqlCallResult, error) {
+	return gqlCall(ctx, b.d, b.block.Header, &args.Data)
+}
+
+// EstimateGas estimates the gas of the message at the state of the block
+func (b *gqlBlock) EstimateGas(ctx context.Context, args gqlCallArgs) (gqlLong, error) {
+	return gqlEstimateGas(ctx, b.d, BlockNumber(b.block.Header.Number), &args.Data)
+}
+
+// Raw returns the RLP encoding of the block
+func (b *gqlBlock) Raw() gqlBytes {
+	block := &types.Block{Header: b.header, Transactions: b.block.Transactions, Uncles: b.block.Uncles}
+
+	return block.MarshalRLP()
+}
+
+// gqlPending resolves the pending block
+type gqlPending struct {
+	d            *Dispatcher
+	header       *types.Header
+	transactions []*types.Transaction
+}
+
+func (p *gqlPending) TransactionCount() gqlLong {
+	return gqlLong(len(p.transactions))
+}
+
+func (p *gqlPending) Transactions() *[]*gqlTransaction {
+	txs := make([]*gqlTransaction, len(p.transactions))
+	for i, tx := range p.transactions {
+		txs[i] = &gqlTransaction{d: p.d, tx: tx}
+	}
+
+	return &txs
+}
+
+// Account returns the account at the pending state
+func (p *gqlPending) Account(args gqlAddressArgs) *gqlAccount {
+	return &gqlAccount{d: p.d, address: types.Address(args.Address), header: p.header}
+}
+
+// Call executes the message at the pending state
+func (p *gqlPending) Call(ctx context.Context, args gqlCallArgs) (*gqlCallResult, error) {
+	return gqlCall(ctx, p.d, p.header, &args.Data)
+}
+
+// EstimateGas estimates the gas of the message at the pending state
+func (p *gqlPending) EstimateGas(ctx context.Context, args gqlCallArgs) (gqlLong, error) {
+	return gqlEstimateGas(ctx, p.d, PendingBlockNumber, &args.Data)
+}
+
+// gqlTransaction resolves a transaction, the block is nil if the transaction is pending
+type gqlTransaction struct {
+	d     *Dispatcher
+	tx    *types.Transaction
+	block *gqlBlock
+	index int
+}
+
+// header returns the header of the state of the transaction accounts, the latest one if the transaction is pending
+func (t *gqlTransaction) header() *types.Header {
+	if t.block == nil {
+		return t.d.store.Header()
+	}
+
+	return t.block.block.Header
+}
+
+// getReceipt returns the receipt of the transaction, nil if the transaction is pending
+func (t *gqlTransaction) getReceipt(ctx context.Context) (*types.Receipt, error) {
+	if t.block == nil {
+		return nil, nil
+	}
+
+	receipts, err := t.block.getReceipts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.index >= len(receipts) {
+		return nil, nil
+	}
+
+	return receipts[t.index], nil
+}
+
+func (t *gqlTransaction) Hash() gqlBytes32 {
+	return gqlBytes32(t.tx.Hash)
+}
+
+func (t *gqlTransaction) Nonce() gqlLong {
+	return gqlLong(t.tx.Nonce)
+}
+
+func (t *gqlTransaction) Index() *gqlLong {
+	if t.block == nil {
+		return nil
+	}
+
+	return newGQLLong(uint64(t.index))
+}
+
+func (t *gqlTransaction) From(args gqlAccountBlockArgs) (*gqlAccount, error) {
+	return newGQLAccount(t.d, t.tx.From, args.Block, t.header())
+}
+
+// To returns the recipient, nil for the contract creations
+func (t *gqlTransaction) To(args gqlAccountBlockArgs) (*gqlAccount, error) {
+	if t.tx.To == nil {
+		return nil, nil
+	}
+
+	return newGQLAccount(t.d, *t.tx.To, args.Block, t.header())
+}
+
+func (t *gqlTransaction) Value() gqlBigInt {
+	return *newGQLBigInt(t.tx.Value)
+}
+
+// GasPrice returns the price paid by the included transaction, or the maximum price of the pending one
+func (t *gqlTransaction) GasPrice() gqlBigInt {
+	if t.block != nil {
+		return *newGQLBigInt(t.tx.GetGasPrice(t.block.block.Header.BaseFee))
+	}
+
+	if t.tx.GasPrice != nil && t.tx.GasPrice.BitLen() > 0 {
+		return *newGQLBigInt(t.tx.GasPrice)
+	}
+
+	return *newGQLBigInt(t.tx.GasFeeCap)
+}
+
+func (t *gqlTransaction) MaxFeePerGas() *gqlBigInt {
+	if t.tx.Type != types.DynamicFeeTx {
+		return nil
+	}
+
+	return newGQLBigInt(t.tx.GasFeeCap)
+}
+
+func (t *gqlTransaction) MaxPriorityFeePerGas() *gqlBigInt {
+	if t.tx.Type != types.DynamicFeeTx {
+		return nil
+	}
+
+	return newGQLBigInt(t.tx.GasTipCap)
+}
+
+// EffectiveGasPrice returns the price paid by the transaction, nil if it is pending
+func (t *gqlTransaction) EffectiveGasPrice() *gqlBigInt {
+	if t.block == nil {
+		return nil
+	}
+
+	return newGQLBigInt(t.tx.GetGasPrice(t.block.block.Header.BaseFee))
+}
+
+func (t *gqlTransaction) Gas() gqlLong {
+	return gqlLong(t.tx.Gas)
+}
+
+func (t *gqlTransaction) InputData() gqlBytes {
+	return t.tx.Input
+}
+
+func (t *gqlTransaction) Block() *gqlBlock {
+	return t.block
+}
+
+func (t *gqlTransaction) Status(ctx context.Context) (*gqlLong, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil || receipt.Status == nil {
+		return nil, err
+	}
+
+	return newGQLLong(uint64(*receipt.Status)), nil
+}
+
+func (t *gqlTransaction) GasUsed(ctx context.Context) (*gqlLong, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+
+	return newGQLLong(receipt.GasUsed), nil
+}
+
+func (t *gqlTransaction) CumulativeGasUsed(ctx context.Context) (*gqlLong, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+
+	return newGQLLong(receipt.CumulativeGasUsed), nil
+}
+
+// CreatedContract returns the account created by the transaction, nil if it isn't a contract creation
+func (t *gqlTransaction) CreatedContract(ctx context.Context, args gqlAccountBlockArgs) (*gqlAccount, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil || receipt.ContractAddress == nil {
+		return nil, err
+	}
+
+	return newGQLAccount(t.d, *receipt.ContractAddress, args.Block, t.header())
+}
+
+func (t *gqlTransaction) Logs(ctx context.Context) (*[]*gqlLog, error) {
+	if t.block == nil {
+		return nil, nil
+	}
+
+	receipts, err := t.block.getReceipts(ctx)
+	if err != nil || t.index >= len(receipts) {
+		return nil, err
+	}
+
+	// the index of the first log of the transaction in the block
+	logIdx := uint64(0)
+	for _, receipt := range receipts[:t.index] {
+		logIdx += uint64(len(receipt.Logs))
+	}
+
+	logs := toLogs(receipts[t.index].Logs, logIdx, uint64(t.index), t.block.block.Header, t.tx.Hash)
+
+	resolvers := make([]*gqlLog, len(logs))
+	for i, log := range logs {
+		resolvers[i] = &gqlLog{d: t.d, log: log, block: t.block}
+	}
+
+	return &resolvers, nil
+}
+
+func (t *gqlTransaction) R() gqlBigInt {
+	return *newGQLBigInt(t.tx.R)
+}
+
+func (t *gqlTransaction) S() gqlBigInt {
+	return *newGQLBigInt(t.tx.S)
+}
+
+func (t *gqlTransaction) V() gqlBigInt {
+	return *newGQLBigInt(t.tx.V)
+}
+
+func (t *gqlTransaction) Type() *gqlLong {
+	return newGQLLong(uint64(t.tx.Type))
+}
+
+// Raw returns the RLP encoding of the transaction
+func (t *gqlTransaction) Raw() gqlBytes {
+	return t.tx.MarshalRLP()
+}
+
+// gqlLog resolves a log of a transaction of the block
+type gqlLog struct {
+	d     *Dispatcher
+	log   *Log
+	block *gqlBlock
+}
+
+func (l *gqlLog) Index() gqlLong {
+	return gqlLong(l.log.LogIndex)
+}
+
+// Account returns the account which emitted the log
+func (l *gqlLog) Account(args gqlAccountBlockArgs) (*gqlAccount, error) {
+	return newGQLAccount(l.d, l.log.Address, args.Block, l.block.block.Header)
+}
+
+func (l *gqlLog) Topics() []gqlBytes32 {
+	topics := make([]gqlBytes32, len(l.log.Topics))
+	for i, topic := range l.log.Topics {
+		topics[i] = gqlBytes32(topic)
+	}
+
+	return topics
+}
+
+func (l *gqlLog) Data() gqlBytes {
+	return gqlBytes(l.log.Data)
+}
+
+func (l *gqlLog) Transaction() (*gqlTransaction, error) {
+	tx := l.block.transactionAt(uint64(l.log.TxIndex))
+	if tx == nil {
+		return nil, fmt.Errorf("transaction %d of block %s not found", l.log.TxIndex, l.block.block.Header.Hash)
+	}
+
+	return tx, nil
+}
+
+// gqlAccount resolves an account at the state of the header
+type gqlAccount struct {
+	d       *Dispatcher
+	address types.Address
+	header  *types.Header
+}
+
+// newGQLAccount returns the resolver of the account at the state of the block number,
+// or of the header if the number isn't set
+func newGQLAccount(d *Dispatcher, address types.Address, number *gqlLong, header *types.Header) (*gqlAccount, error) {
+	if number != nil {
+		var ok bool
+		if header, ok = d.store.GetHeaderByNumber(uint64(*number)); !ok {
+			return nil, fmt.Errorf("block %d not found", uint64(*number))
+		}
+	}
+
+	return &gqlAccount{d: d, address: address, header: header}, nil
+}
+
+// getAccount returns the account, an empty one if it isn't in the state
+func (a *gqlAccount) getAccount() (*Account, error) {
+	acc, err := a.d.store.GetAccount(a.header.StateRoot, a.address)
+	if errors.Is(err, ErrStateNotFound) {
+		return &Account{Balance: new(big.Int)}, nil
+	}
+
+	return acc, err
+}
+
+func (a *gqlAccount) Address() gqlAddress {
+	return gqlAddress(a.address)
+}
+
+func (a *gqlAccount) Balance(ctx context.Context) (gqlBigInt, error) {
+	if err := chargeGraphQL(ctx, "eth_getBalance", 0); err != nil {
+		return gqlBigInt{}, err
+	}
+
+	acc, err := a.getAccount()
+	if err != nil {
+		return gqlBigInt{}, err
+	}
+
+	return *newGQLBigInt(acc.Balance), nil
+}
+
+func (a *gqlAccount) TransactionCount(ctx context.Context) (gqlLong, error) {
+	if err := chargeGraphQL(ctx, "eth_getTransactionCount", 0); err != nil {
+		return 0, err
+	}
+
+	acc, err := a.getAccount()
+	if err != nil {
+		return 0, err
+	}
+
+	return gqlLong(acc.Nonce), nil
+}
+
+func (a *gqlAccount) Code(ctx context.Context) (gqlBytes, error) {
+	if err := chargeGraphQL(ctx, "eth_getCode", 0); err != nil {
+		return nil, err
+	}
+
+	code, err := a.d.store.GetCode(a.header.StateRoot, a.address)
+	if errors.Is(err, ErrStateNotFound) {
+		return gqlBytes{}, nil
+	}
+
+	return code, err
+}
+
+type gqlSlotArgs struct {
+	Slot gqlBytes32
+}
+
+func (a *gqlAccount) Storage(ctx context.Context, args gqlSlotArgs) (gqlBytes32, error) {
+	if err := chargeGraphQL(ctx, "eth_getStorageAt", 0); err != nil {
+		return gqlBytes32{}, err
+	}
+
+	value, err := a.d.store.GetStorage(a.header.StateRoot, a.address, types.Hash(args.Slot))
+	if errors.Is(err, ErrStateNotFound) {
+		return gqlBytes32{}, nil
+	} else if err != nil {
+		return gqlBytes32{}, err
+	}
+
+	return gqlBytes32(types.BytesToHash(value)), nil
+}
+
+// gqlCallData is the message of a call or a gas estimation
+type gqlCallData struct {
+	From                 *gqlAddress
+	To                   *gqlAddress
+	Gas                  *gqlLong
+	GasPrice             *gqlBigInt
+	MaxFeePerGas         *gqlBigInt
+	MaxPriorityFeePerGas *gqlBigInt
+	Value                *gqlBigInt
+	Data                 *gqlBytes
+}
+
+// toTxnArgs converts the message to the arguments of the JSON-RPC methods
+func (c *gqlCallData) toTxnArgs() *txnArgs {
+	bigArg := func(b *gqlBigInt) *argBytes {
+		if b == nil {
+			return nil
+		}
+
+		return argBytesPtr((*big.Int)(b).Bytes())
+	}
+
+	args := &txnArgs{
+		From:      (*types.Address)(c.From),
+		To:        (*types.Address)(c.To),
+		Gas:       (*argUint64)(c.Gas),
+		GasPrice:  bigArg(c.GasPrice),
+		GasTipCap: bigArg(c.MaxPriorityFeePerGas),
+		GasFeeCap: bigArg(c.MaxFeePerGas),
+		Value:     bigArg(c.Value),
+		Data:      (*argBytes)(c.Data),
+	}
+
+	if c.MaxFeePerGas != nil || c.MaxPriorityFeePerGas != nil {
+		args.Type = argUintPtr(uint64(types.DynamicFeeTx))
+	}
+
+	return args
+}
+
+// gqlCall executes the message at the state of the header, the failed executions have the status 0
+func gqlCall(ctx context.Context, d *Dispatcher, header *types.Header, data *gqlCallData) (*gqlCallResult, error) {
+	if err := chargeGraphQL(ctx, "eth_call", 0); err != nil {
+		return nil, err
+	}
+
+	transaction, err := DecodeTxn(data.toTxnArgs(), header, d.store, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the caller didn't supply the gas limit in the message, then we set it to maximum possible => block gas limit
+	if transaction.Gas == 0 {
+		transaction.Gas = header.GasLimit
+	}
+
+	if err := d.endpoints.Eth.fillTransactionGasPrice(transaction); err != nil {
+		return nil, err
+	}
+
+	result, err := d.store.ApplyTxn(header, transaction, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	status := uint64(types.ReceiptSuccess)
+	if result.Failed() {
+		status = uint64(types.ReceiptFailed)
+	}
+
+	return &gqlCallResult{data: result.ReturnValue, gasUsed: result.GasUsed, status: status}, nil
+}
+
+// gqlEstimateGas estimates the gas of the message at the state of the block number
+func gqlEstimateGas(ctx context.Context, d *Dispatcher, number BlockNumber, data *gqlCallData) (gqlLong, error) {
+	if err := chargeGraphQL(ctx, "eth_estimateGas", 0); err != nil {
+		return 0, err
+	}
+
+	gas, err := d.endpoints.Eth.EstimateGas(data.toTxnArgs(), &number)
+	if err != nil {
+		return 0, err
+	}
+
+	estimated, ok := gas.(argUint64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected gas estimation %v", gas)
+	}
+
+	return gqlLong(estimated), nil
+}
+
+// gqlCallResult resolves the result of a call
+type gqlCallResult struct {
+	data    []byte
+	gasUsed uint64
+	status  uint64
+}
+
+func (r *gqlCallResult) Data() gqlBytes {
+	return r.data
+}
+
+func (r *gqlCallResult) GasUsed() gqlLong {
+	return gqlLong(r.gasUsed)
+}
+
+func (r *gqlCallResult) Status() gqlLong {
+	return gqlLong(r.status)
+}
+
+// gqlSyncState resolves the progress of the synchronization
+type gqlSyncState struct {
+	progression *progress.Progression
+}
+
+func (s *gqlSyncState) StartingBlock() gqlLong {
+	return gqlLong(s.progression.StartingBlock)
+}
+
+func (s *gqlSyncState) CurrentBlock() gqlLong {
+	return gqlLong(s.progression.CurrentBlock)
+}
+
+func (s *gqlSyncState) HighestBlock() gqlLong {
+	return gqlLong(s.progression.HighestBlock)
+}
+
+// newGQLLogQuery returns the log query of the addresses and the topics of a filter
+func newGQLLogQuery(addresses *[]gqlAddress, topics *[][]gqlBytes32) *LogQuery {
+	query := &LogQuery{}
+
+	if addresses != nil {
+		for _, address := range *addresses {
+			query.Addresses = append(query.Addresses, types.Address(address))
+		}
+	}
+
+	if topics != nil {
+		for _, set := range *topics {
+			hashes := make([]types.Hash, len(set))
+			for i, topic := range set {
+				hashes[i] = types.Hash(topic)
+			}
+
+			query.Topics = append(query.Topics, hashes)
+		}
+	}
+
+	return query
+}
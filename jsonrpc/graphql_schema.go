@@ -0,0 +1,173 @@
+package jsonrpc
+
+// graphQLSchema is the EIP-1767 schema of the chain data. The fields a chain without proof of work
+// doesn't have (e.g. the ommers and the total difficulty) are left out
+const graphQLSchema = `
+# Bytes32 is a 32 byte binary string, represented as 0x-prefixed hexadecimal.
+scalar Bytes32
+# Address is a 20 byte Ethereum address, represented as 0x-prefixed hexadecimal.
+scalar Address
+# Bytes is an arbitrary length binary string, represented as 0x-prefixed hexadecimal.
+scalar Bytes
+# BigInt is a large integer. Input is accepted as either a JSON number or as a string.
+# Strings may be either decimal or 0x-prefixed hexadecimal. Output values are all
+# 0x-prefixed hexadecimal.
+scalar BigInt
+# Long is a 64 bit unsigned integer. Input is accepted as either a JSON number or as a string.
+# Strings may be either decimal or 0x-prefixed hexadecimal. Output values are JSON numbers.
+scalar Long
+
+schema {
+    query: Query
+    mutation: Mutation
+}
+
+# Account is an Ethereum account at a particular block.
+type Account {
+    address: Address!
+    balance: BigInt!
+    transactionCount: Long!
+    code: Bytes!
+    storage(slot: Bytes32!): Bytes32!
+}
+
+# Log is an Ethereum event log.
+type Log {
+    index: Long!
+    account(block: Long): Account!
+    topics: [Bytes32!]!
+    data: Bytes!
+    transaction: Transaction!
+}
+
+# Transaction is an Ethereum transaction.
+type Transaction {
+    hash: Bytes32!
+    nonce: Long!
+    # index is null if the transaction is pending.
+    index: Long
+    from(block: Long): Account!
+    # to is null for the contract creations.
+    to(block: Long): Account
+    value: BigInt!
+    gasPrice: BigInt!
+    maxFeePerGas: BigInt
+    maxPriorityFeePerGas: BigInt
+    effectiveGasPrice: BigInt
+    gas: Long!
+    inputData: Bytes!
+    # block is null if the transaction is pending.
+    block: Block
+    # The receipt fields are null if the transaction is pending.
+    status: Long
+    gasUsed: Long
+    cumulativeGasUsed: Long
+    createdContract(block: Long): Account
+    logs: [Log!]
+    r: BigInt!
+    s: BigInt!
+    v: BigInt!
+    type: Long
+    raw: Bytes!
+}
+
+# BlockFilterCriteria filters the logs of a block.
+input BlockFilterCriteria {
+    addresses: [Address!]
+    topics: [[Bytes32!]!]
+}
+
+# Block is an Ethereum block.
+type Block {
+    number: Long!
+    hash: Bytes32!
+    parent: Block
+    nonce: Bytes!
+    transactionsRoot: Bytes32!
+    transactionCount: Long
+    stateRoot: Bytes32!
+    receiptsRoot: Bytes32!
+    miner(block: Long): Account!
+    extraData: Bytes!
+    gasLimit: Long!
+    gasUsed: Long!
+    baseFeePerGas: BigInt
+    timestamp: Long!
+    logsBloom: Bytes!
+    mixHash: Bytes32!
+    difficulty: BigInt!
+    ommerHash: Bytes32!
+    transactions: [Transaction!]
+    transactionAt(index: Long!): Transaction
+    logs(filter: BlockFilterCriteria!): [Log!]!
+    account(address: Address!): Account!
+    call(data: CallData!): CallResult
+    estimateGas(data: CallData!): Long!
+    raw: Bytes!
+}
+
+# CallData is the message of a call.
+input CallData {
+    from: Address
+    to: Address
+    gas: Long
+    gasPrice: BigInt
+    maxFeePerGas: BigInt
+    maxPriorityFeePerGas: BigInt
+    value: BigInt
+    data: Bytes
+}
+
+# CallResult is the result of a call.
+type CallResult {
+    data: Bytes!
+    gasUsed: Long!
+    # status is 1 if the call succeeded, 0 if it failed.
+    status: Long!
+}
+
+# FilterCriteria filters the logs of a range of blocks.
+input FilterCriteria {
+    # fromBlock is the latest block if it's null.
+    fromBlock: Long
+    # toBlock is the latest block if it's null.
+    toBlock: Long
+    addresses: [Address!]
+    topics: [[Bytes32!]!]
+}
+
+# SyncState is the progress of the synchronization.
+type SyncState {
+    startingBlock: Long!
+    currentBlock: Long!
+    highestBlock: Long!
+}
+
+# Pending is the pending block built by the node.
+type Pending {
+    transactionCount: Long!
+    transactions: [Transaction!]
+    account(address: Address!): Account!
+    call(data: CallData!): CallResult
+    estimateGas(data: CallData!): Long!
+}
+
+type Query {
+    # block is the latest block if both number and hash are null.
+    block(number: Long, hash: Bytes32): Block
+    # blocks are the blocks from and to, inclusive, to is the latest block if it's null.
+    blocks(from: Long!, to: Long): [Block!]!
+    pending: Pending!
+    transaction(hash: Bytes32!): Transaction
+    logs(filter: FilterCriteria!): [Log!]!
+    gasPrice: BigInt!
+    maxPriorityFeePerGas: BigInt!
+    # syncing is null if the node isn't syncing.
+    syncing: SyncState
+    chainID: BigInt!
+}
+
+type Mutation {
+    sendRawTransaction(data: Bytes!): Bytes32!
+}
+`
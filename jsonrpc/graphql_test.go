@@ -0,0 +1,540 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// graphQLTestStore is a chain of three blocks, the block 1 includes a transfer to addr1 which emitted a log
+type graphQLTestStore struct {
+	*mockStore
+
+	blocks     []*types.Block
+	receipts   map[types.Hash][]*types.Receipt
+	pendingTxs []*types.Transaction
+	code       map[types.Address][]byte
+	storage    map[types.Hash][]byte
+}
+
+func newGraphQLTestStore() *graphQLTestStore {
+	tx := newTestTransaction(1, addr0)
+
+	store := &graphQLTestStore{
+		mockStore: newMockStore(),
+		blocks: []*types.Block{
+			{Header: &types.Header{Number: 0, Hash: hash1}},
+			{
+				Header:       &types.Header{Number: 1, Hash: hash2, ParentHash: hash1, GasLimit: 30_000_000},
+				Transactions: []*types.Transaction{tx},
+			},
+			{Header: &types.Header{Number: 2, Hash: hash3, ParentHash: hash2, GasLimit: 30_000_000}},
+		},
+		receipts: map[types.Hash][]*types.Receipt{
+			hash2: {
+				{
+					Status:            receiptStatusPtr(types.ReceiptSuccess),
+					GasUsed:           21000,
+					CumulativeGasUsed: 21000,
+					Logs:              []*types.Log{{Address: addr1, Topics: []types.Hash{hash4}, Data: []byte{0x1}}},
+				},
+			},
+		},
+		pendingTxs: []*types.Transaction{newTestTransaction(2, addr0)},
+		code:       map[types.Address][]byte{addr1: {0x60, 0x00}},
+		storage:    map[types.Hash][]byte{hash1: {0x5}},
+	}
+
+	store.SetAccount(addr1, &Account{Balance: big.NewInt(10), Nonce: 2})
+
+	return store
+}
+
+func receiptStatusPtr(status types.ReceiptStatus) *types.ReceiptStatus {
+	return &status
+}
+
+func (s *graphQLTestStore) Header() *types.Header {
+	return s.blocks[len(s.blocks)-1].Header
+}
+
+func (s *graphQLTestStore) GetHeaderByNumber(num uint64) (*types.Header, bool) {
+	if num >= uint64(len(s.blocks)) {
+		return nil, false
+	}
+
+	return s.blocks[num].Header, true
+}
+
+func (s *graphQLTestStore) GetBlockByNumber(num uint64, full bool) (*types.Block, bool) {
+	if num >= uint64(len(s.blocks)) {
+		return nil, false
+	}
+
+	return s.blocks[num], true
+}
+
+func (s *graphQLTestStore) GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool) {
+	for _, block := range s.blocks {
+		if block.Header.Hash == hash {
+			return block, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *graphQLTestStore) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error) {
+	return s.receipts[hash], nil
+}
+
+func (s *graphQLTestStore) ReadTxLookup(txHash types.Hash) (types.Hash, bool) {
+	for _, block := range s.blocks {
+		if txn, _ := types.FindTxByHash(block.Transactions, txHash); txn != nil {
+			return block.Header.Hash, true
+		}
+	}
+
+	return types.ZeroHash, false
+}
+
+func (s *graphQLTestStore) GetPendingTx(txHash types.Hash) (*types.Transaction, bool) {
+	txn, _ := types.FindTxByHash(s.pendingTxs, txHash)
+
+	return txn, txn != nil
+}
+
+func (s *graphQLTestStore) AddTx(ctx context.Context, tx *types.Transaction) error {
+	tx.ComputeHash(1)
+	s.pendingTxs = append(s.pendingTxs, tx)
+
+	return nil
+}
+
+func (s *graphQLTestStore) GetNonce(addr types.Address) uint64 {
+	return 0
+}
+
+func (s *graphQLTestStore) GetCode(root types.Hash, addr types.Address) ([]byte, error) {
+	code, ok := s.code[addr]
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+
+	return code, nil
+}
+
+func (s *graphQLTestStore) GetStorage(root types.Hash, addr types.Address, slot types.Hash) ([]byte, error) {
+	value, ok := s.storage[slot]
+	if !ok {
+		return nil, ErrStateNotFound
+	}
+
+	return value, nil
+}
+
+func (s *graphQLTestStore) GetSyncProgression() *progress.Progression {
+	return nil
+}
+
+func (s *graphQLTestStore) GetForksInTime(blockNumber uint64) chain.ForksInTime {
+	return chain.AllForksEnabled.At(blockNumber)
+}
+
+func (s *graphQLTestStore) GetBaseFee() uint64 {
+	return 0
+}
+
+func (s *graphQLTestStore) GetAvgGasPrice() *big.Int {
+	return big.NewInt(1)
+}
+
+func (s *graphQLTestStore) MaxPriorityFeePerGas() (*big.Int, error) {
+	return big.NewInt(2), nil
+}
+
+func (s *graphQLTestStore) ApplyTxn(
+	_ *types.Header,
+	txn *types.Transaction,
+	_ types.StateOverride,
+	_ bool,
+) (*runtime.ExecutionResult, error) {
+	// the calls of addr2 revert
+	if txn.To != nil && *txn.To == addr2 {
+		return &runtime.ExecutionResult{ReturnValue: []byte{0xde, 0xad}, GasUsed: 50, Err: runtime.ErrExecutionReverted}, nil
+	}
+
+	return &runtime.ExecutionResult{ReturnValue: txn.Input, GasUsed: 100}, nil
+}
+
+// execGraphQL executes the query and returns the JSON encoding of the response
+func execGraphQL(t *testing.T, d *Dispatcher, query string, take func(cost int) error) string {
+	t.Helper()
+
+	reqBody, err := json.Marshal(&graphQLRequest{Query: query})
+	require.NoError(t, err)
+
+	resp, err := d.HandleGraphQL(context.Background(), reqBody, take)
+	require.NoError(t, err)
+
+	body, err := json.Marshal(resp)
+	require.NoError(t, err)
+
+	return string(body)
+}
+
+func newGraphQLTestDispatcher(t *testing.T, params *dispatcherParams) *Dispatcher {
+	t.Helper()
+
+	params.chainID = 100
+	params.graphQL = true
+
+	return newTestDispatcher(t, hclog.NewNullLogger(), newGraphQLTestStore(), params)
+}
+
+func TestGraphQL_Block(t *testing.T) {
+	t.Parallel()
+
+	d := newGraphQLTestDispatcher(t, &dispatcherParams{})
+
+	resp := execGraphQL(t, d, `{
+		block(number: 1) {
+			number
+			hash
+			parent { number }
+			transactionCount
+			baseFeePerGas
+			transactions {
+				index
+				nonce
+				from { address }
+				to { address balance transactionCount }
+				value
+				status
+				gasUsed
+				cumulativeGasUsed
+				logs { index account { address } topics data transaction { index } }
+			}
+		}
+	}`, nil)
+
+	require.JSONEq(t, `{"data":{"block":{
+		"number": 1,
+		"hash": "`+hash2.String()+`",
+		"parent": {"number": 0},
+		"transactionCount": 1,
+		"baseFeePerGas": "0x0",
+		"transactions": [{
+			"index": 0,
+			"nonce": 1,
+			"from": {"address": "`+addr0.String()+`"},
+			"to": {"address": "`+addr1.String()+`", "balance": "0xa", "transactionCount": 2},
+			"value": "0xc8",
+			"status": 1,
+			"gasUsed": 21000,
+			"cumulativeGasUsed": 21000,
+			"logs": [{
+				"index": 0,
+				"account": {"address": "`+addr1.String()+`"},
+				"topics": ["`+hash4.String()+`"],
+				"data": "0x01",
+				"transaction": {"index": 0}
+			}]
+		}]
+	}}}`, resp)
+
+	// the latest block, the genesis block has no parent and a missing block is null
+	resp = execGraphQL(t, d, `{
+		latest: block { number }
+		genesis: block(hash: "`+hash1.String()+`") { number parent { number } }
+		missing: block(number: 10) { number }
+	}`, nil)
+
+	require.JSONEq(t, `{"data":{
+		"latest": {"number": 2},
+		"genesis": {"number": 0, "parent": null},
+		"missing": null
+	}}`, resp)
+}
+
+func TestGraphQL_Blocks(t *testing.T) {
+	t.Parallel()
+
+	d := newGraphQLTestDispatcher(t, &dispatcherParams{blockRangeLimit: 1})
+
+	resp := execGraphQL(t, d, `{ blocks(from: 1) { number } }`, nil)
+	require.JSONEq(t, `{"data":{"blocks":[{"number":1},{"number":2}]}}`, resp)
+
+	resp = execGraphQL(t, d, `{ blocks(from: 0, to: 2) { number } }`, nil)
+	require.Contains(t, resp, ErrBlockRangeTooHigh.Error())
+
+	// the range is clamped to the latest block before it is checked
+	resp = execGraphQL(t, d, `{ blocks(from: 2, to: "0xffffffffffffffff") { number } }`, nil)
+	require.JSONEq(t, `{"data":{"blocks":[{"number":2}]}}`, resp)
+
+	resp = execGraphQL(t, d, `{ blocks(from: 5) { number } }`, nil)
+	require.JSONEq(t, `{"data":{"blocks":[]}}`, resp)
+
+	resp = execGraphQL(t, d, `{ blocks(from: 2, to: 1) { number } }`, nil)
+	require.Contains(t, resp, ErrIncorrectBlockRange.Error())
+}
+
+func TestGraphQL_Transaction(t *testing.T) {
+	t.Parallel()
+
+	store := newGraphQLTestStore()
+	d := newTestDispatcher(t, hclog.NewNullLogger(), store, &dispatcherParams{graphQL: true})
+
+	included := store.blocks[1].Transactions[0]
+	pending := store.pendingTxs[0]
+
+	resp := execGraphQL(t, d, `{
+		included: transaction(hash: "`+included.Hash.String()+`") {
+			hash index block { number } status gasPrice effectiveGasPrice
+		}
+		pending: transaction(hash: "`+pending.Hash.String()+`") {
+			nonce index block { number } status gasPrice effectiveGasPrice logs { index }
+		}
+		missing: transaction(hash: "`+hash4.String()+`") { hash }
+	}`, nil)
+
+	require.JSONEq(t, `{"data":{
+		"included": {
+			"hash": "`+included.Hash.String()+`",
+			"index": 0,
+			"block": {"number": 1},
+			"status": 1,
+			"gasPrice": "0x1",
+			"effectiveGasPrice": "0x1"
+		},
+		"pending": {
+			"nonce": 2,
+			"index": null,
+			"block": null,
+			"status": null,
+			"gasPrice": "0x1",
+			"effectiveGasPrice": null,
+			"logs": null
+		},
+		"missing": null
+	}}`, resp)
+}
+
+func TestGraphQL_Account(t *testing.T) {
+	t.Parallel()
+
+	d := newGraphQLTestDispatcher(t, &dispatcherParams{})
+
+	resp := execGraphQL(t, d, `{
+		block {
+			contract: account(address: "`+addr1.String()+`") {
+				balance transactionCount code storage(slot: "`+hash1.String()+`")
+			}
+			empty: account(address: "`+addr2.String()+`") {
+				balance transactionCount code storage(slot: "`+hash2.String()+`")
+			}
+		}
+	}`, nil)
+
+	require.JSONEq(t, `{"data":{"block":{
+		"contract": {
+			"balance": "0xa",
+			"transactionCount": 2,
+			"code": "0x6000",
+			"storage": "`+types.BytesToHash([]byte{0x5}).String()+`"
+		},
+		"empty": {
+			"balance": "0x0",
+			"transactionCount": 0,
+			"code": "0x",
+			"storage": "`+types.ZeroHash.String()+`"
+		}
+	}}}`, resp)
+}
+
+func TestGraphQL_Logs(t *testing.T) {
+	t.Parallel()
+
+	d := newGraphQLTestDispatcher(t, &dispatcherParams{})
+
+	resp := execGraphQL(t, d, `{
+		matching: logs(filter: {fromBlock: 0, topics: [["`+hash4.String()+`"]]}) {
+			index data transaction { nonce block { number } }
+		}
+		other: logs(filter: {fromBlock: 0, addresses: ["`+addr2.String()+`"]}) { index }
+		block(number: 1) {
+			logs(filter: {addresses: ["`+addr1.String()+`"]}) { index }
+		}
+	}`, nil)
+
+	require.JSONEq(t, `{"data":{
+		"matching": [{"index": 0, "data": "0x01", "transaction": {"nonce": 1, "block": {"number": 1}}}],
+		"other": [],
+		"block": {"logs": [{"index": 0}]}
+	}}`, resp)
+}
+
+func TestGraphQL_CallAndEstimateGas(t *testing.T) {
+	t.Parallel()
+
+	d := newGraphQLTestDispatcher(t, &dispatcherParams{})
+
+	resp := execGraphQL(t, d, `{
+		block {
+			success: call(data: {to: "`+addr1.String()+`", data: "0x1234"}) { data gasUsed status }
+			reverted: call(data: {to: "`+addr2.String()+`", data: "0x1234"}) { data gasUsed status }
+			estimateGas(data: {from: "`+addr1.String()+`", to: "`+addr2.String()+`", value: "0x1"})
+		}
+		pending {
+			transactionCount
+			call(data: {to: "`+addr1.String()+`"}) { status }
+		}
+	}`, nil)
+
+	require.JSONEq(t, `{"data":{
+		"block": {
+			"success": {"data": "0x1234", "gasUsed": 100, "status": 1},
+			"reverted": {"data": "0xdead", "gasUsed": 50, "status": 0},
+			"estimateGas": 21000
+		},
+		"pending": {"transactionCount": 0, "call": {"status": 1}}
+	}}`, resp)
+}
+
+func TestGraphQL_Misc(t *testing.T) {
+	t.Parallel()
+
+	d := newGraphQLTestDispatcher(t, &dispatcherParams{})
+
+	resp := execGraphQL(t, d, `{ chainID gasPrice maxPriorityFeePerGas syncing { currentBlock } }`, nil)
+	require.JSONEq(t, `{"data":{
+		"chainID": "0x64",
+		"gasPrice": "0x2",
+		"maxPriorityFeePerGas": "0x2",
+		"syncing": null
+	}}`, resp)
+
+	tx := newTestTransaction(5, addr0)
+
+	resp = execGraphQL(t, d, `mutation { sendRawTransaction(data: "`+hex.EncodeToHex(tx.MarshalRLP())+`") }`, nil)
+	require.JSONEq(t, `{"data":{"sendRawTransaction":"`+tx.Hash.String()+`"}}`, resp)
+}
+
+func TestGraphQL_Cost(t *testing.T) {
+	t.Parallel()
+
+	t.Run("method costs", func(t *testing.T) {
+		t.Parallel()
+
+		d := newGraphQLTestDispatcher(t, &dispatcherParams{methodCosts: map[string]uint64{"eth_getBalance": 5}})
+
+		total := 0
+		take := func(cost int) error {
+			total += cost
+
+			return nil
+		}
+
+		// a block, the receipts of the block once and the balance
+		_ = execGraphQL(t, d, `{ block(number: 1) { transactions { status gasUsed to { balance } } } }`, take)
+		require.Equal(t, 7, total)
+	})
+
+	t.Run("max cost", func(t *testing.T) {
+		t.Parallel()
+
+		d := newGraphQLTestDispatcher(t, &dispatcherParams{graphQLMaxCost: 3})
+
+		resp := execGraphQL(t, d, `{ a: block { number } b: block { number } c: block { number } }`, nil)
+		require.NotContains(t, resp, "errors")
+
+		resp = execGraphQL(t, d, `{ a: block { number } b: block { number } c: block { number } d: block { number } }`, nil)
+		require.Contains(t, resp, "the query cost exceeds the limit of 3")
+	})
+
+	t.Run("rate limited", func(t *testing.T) {
+		t.Parallel()
+
+		d := newGraphQLTestDispatcher(t, &dispatcherParams{})
+
+		take := func(cost int) error {
+			return errors.New("rate limit exceeded")
+		}
+
+		resp := execGraphQL(t, d, `{ chainID }`, take)
+		require.Contains(t, resp, "rate limit exceeded")
+	})
+
+	t.Run("denied method", func(t *testing.T) {
+		t.Parallel()
+
+		d := newGraphQLTestDispatcher(t, &dispatcherParams{})
+
+		restricted, err := d.withPolicy(AccessPolicy{DenyMethods: []string{"eth_getBalance"}})
+		require.NoError(t, err)
+
+		resp := execGraphQL(t, restricted, `{ block { number account(address: "`+addr1.String()+`") { balance } } }`, nil)
+		require.Contains(t, resp, "the method eth_getBalance is not allowed")
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		t.Parallel()
+
+		d := newGraphQLTestDispatcher(t, &dispatcherParams{})
+
+		query := "{ block " + strings.Repeat("{ parent ", graphQLMaxDepth) +
+			"{ number }" + strings.Repeat(" }", graphQLMaxDepth+1)
+
+		resp := execGraphQL(t, d, query, nil)
+		require.Contains(t, resp, "exceeds max depth")
+	})
+}
+
+func TestJSONRPC_GraphQL(t *testing.T) {
+	t.Parallel()
+
+	port, err := tests.GetFreePort()
+	require.NoError(t, err)
+
+	addr := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: port}
+
+	_, err = NewJSONRPC(hclog.NewNullLogger(), &Config{
+		Store:     newGraphQLTestStore(),
+		Addr:      addr,
+		ChainID:   100,
+		RateLimit: RateLimit{Rate: 0.01, Burst: 3},
+		GraphQL:   true,
+	})
+	require.NoError(t, err)
+
+	url := "http://" + addr.String() + "/graphql"
+
+	resp, body := postBody(t, url, `{"query": "{ block { number } }"}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.JSONEq(t, `{"data":{"block":{"number":2}}}`, body)
+
+	resp, body = postBody(t, url, `{"query": "{ block { unknown } }"}`)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	require.Contains(t, body, `Cannot query field \"unknown\"`)
+
+	// the bucket is empty after the base costs of the requests and the cost of the block
+	resp, body = postBody(t, url, `{"query": "{ chainID }"}`)
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.Equal(t, "100", resp.Header.Get("Retry-After"))
+	require.Contains(t, body, "rate limit exceeded, retry after 100s")
+}
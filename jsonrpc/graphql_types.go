@@ -0,0 +1,168 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// The scalars of the EIP-1767 schema. The inputs are decoded by UnmarshalGraphQL
+// and the outputs are encoded by MarshalJSON
+
+// gqlBytes32 is a 32 bytes hash, encoded as a hex string
+type gqlBytes32 types.Hash
+
+func (gqlBytes32) ImplementsGraphQLType(name string) bool { return name == "Bytes32" }
+
+func (b *gqlBytes32) UnmarshalGraphQL(input interface{}) error {
+	s, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for Bytes32", input)
+	}
+
+	return (*types.Hash)(b).UnmarshalText([]byte(s))
+}
+
+func (b gqlBytes32) MarshalJSON() ([]byte, error) {
+	return json.Marshal(types.Hash(b).String())
+}
+
+// gqlAddress is a 20 bytes address, encoded as a hex string
+type gqlAddress types.Address
+
+func (gqlAddress) ImplementsGraphQLType(name string) bool { return name == "Address" }
+
+func (a *gqlAddress) UnmarshalGraphQL(input interface{}) error {
+	s, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for Address", input)
+	}
+
+	return (*types.Address)(a).UnmarshalText([]byte(s))
+}
+
+func (a gqlAddress) MarshalJSON() ([]byte, error) {
+	return json.Marshal(types.Address(a).String())
+}
+
+// gqlBytes is an arbitrary length byte array, encoded as a hex string
+type gqlBytes []byte
+
+func (gqlBytes) ImplementsGraphQLType(name string) bool { return name == "Bytes" }
+
+func (b *gqlBytes) UnmarshalGraphQL(input interface{}) error {
+	s, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for Bytes", input)
+	}
+
+	decoded, err := hex.DecodeHex(s)
+	if err != nil {
+		return fmt.Errorf("invalid Bytes %q: %w", s, err)
+	}
+
+	*b = decoded
+
+	return nil
+}
+
+func (b gqlBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeToHex(b))
+}
+
+// gqlBigInt is a big integer, encoded as a hex string. The inputs are either hex or decimal strings
+type gqlBigInt big.Int
+
+func (gqlBigInt) ImplementsGraphQLType(name string) bool { return name == "BigInt" }
+
+func (b *gqlBigInt) UnmarshalGraphQL(input interface{}) error {
+	var value *big.Int
+
+	switch input := input.(type) {
+	case string:
+		parsed, ok := new(big.Int).SetString(input, 0)
+		if !ok {
+			return fmt.Errorf("invalid BigInt %q", input)
+		}
+
+		value = parsed
+	case int32:
+		value = big.NewInt(int64(input))
+	case float64:
+		if input != math.Trunc(input) {
+			return fmt.Errorf("invalid BigInt %v", input)
+		}
+
+		value, _ = big.NewFloat(input).Int(nil)
+	default:
+		return fmt.Errorf("unexpected type %T for BigInt", input)
+	}
+
+	if value.Sign() < 0 {
+		return fmt.Errorf("negative BigInt %s", value)
+	}
+
+	*b = gqlBigInt(*value)
+
+	return nil
+}
+
+func (b gqlBigInt) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeBig((*big.Int)(&b)))
+}
+
+func newGQLBigInt(b *big.Int) *gqlBigInt {
+	if b == nil {
+		return (*gqlBigInt)(new(big.Int))
+	}
+
+	return (*gqlBigInt)(new(big.Int).Set(b))
+}
+
+// gqlLong is a 64 bit unsigned integer, encoded as a number. The inputs are either numbers or hex strings
+type gqlLong uint64
+
+func (gqlLong) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+func (l *gqlLong) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case string:
+		value, err := strconv.ParseUint(input, 0, 64)
+		if err != nil {
+			return fmt.Errorf("invalid Long %q: %w", input, err)
+		}
+
+		*l = gqlLong(value)
+	case int32:
+		if input < 0 {
+			return fmt.Errorf("negative Long %d", input)
+		}
+
+		*l = gqlLong(input)
+	case float64:
+		if input < 0 || input != math.Trunc(input) || input > math.MaxUint64 {
+			return fmt.Errorf("invalid Long %v", input)
+		}
+
+		*l = gqlLong(input)
+	default:
+		return fmt.Errorf("unexpected type %T for Long", input)
+	}
+
+	return nil
+}
+
+func (l gqlLong) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatUint(uint64(l), 10)), nil
+}
+
+func newGQLLong(n uint64) *gqlLong {
+	l := gqlLong(n)
+
+	return &l
+}
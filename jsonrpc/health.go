@@ -0,0 +1,196 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	healthStatusOK     = "ok"
+	healthStatusFailed = "failed"
+
+	// validatorSigningBlocks is the number of the last blocks a validator has to sign one of to be signing
+	validatorSigningBlocks = 10
+)
+
+// ValidatorStore reports the status of the validator of the node
+type ValidatorStore interface {
+	// ValidatorStatus returns the address of the validator, whether it is in the validator set of the next block
+	// and the number of the latest of the last blocks it signed, zero if it signed none of them
+	ValidatorStatus(blocks uint64) (types.Address, bool, uint64, error)
+}
+
+// HealthConfig defines the thresholds of the /health and /ready endpoints
+type HealthConfig struct {
+	// MaxHeadAge is the maximum age of the head block, unlimited if it is zero
+	MaxHeadAge time.Duration
+
+	// MinPeers is the minimum number of connected peers of a ready node
+	MinPeers uint64
+
+	// MaxSyncLag is the maximum number of blocks a ready node is behind the highest known block while syncing
+	MaxSyncLag uint64
+
+	// ValidatorStore reports the validator status, it is set only with the consensus which has validators
+	ValidatorStore ValidatorStore
+}
+
+// healthStatus is the response of the /health and /ready endpoints
+type healthStatus struct {
+	Status string `json:"status"`
+
+	// Reasons are the reasons the checks failed
+	Reasons []string `json:"reasons,omitempty"`
+
+	Head      healthHead       `json:"head"`
+	Peers     int              `json:"peers"`
+	Syncing   bool             `json:"syncing"`
+	SyncLag   uint64           `json:"syncLag"`
+	Validator *healthValidator `json:"validator,omitempty"`
+}
+
+type healthHead struct {
+	Number uint64     `json:"number"`
+	Hash   types.Hash `json:"hash"`
+
+	// Age is the age of the head block in seconds
+	Age uint64 `json:"age"`
+}
+
+// healthValidator is the status of the validator of the node. Its address isn't reported,
+// since the endpoints don't authenticate the clients
+type healthValidator struct {
+	// Active is true if the validator is in the validator set of the next block
+	Active bool `json:"active"`
+
+	// Signing is true if the validator signed one of the last blocks
+	Signing         bool   `json:"signing"`
+	LastSignedBlock uint64 `json:"lastSignedBlock,omitempty"`
+	Error           string `json:"error,omitempty"`
+}
+
+// validatorHealthCache keeps the validator status of the head block, which changes only with a new block,
+// so the probes don't look up the validator set and the last blocks every time
+type validatorHealthCache struct {
+	lock   sync.Mutex
+	head   types.Hash
+	status *healthValidator
+}
+
+// get returns the validator status of the given head block
+func (c *validatorHealthCache) get(store ValidatorStore, head types.Hash) *healthValidator {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.status == nil || c.head != head {
+		c.head, c.status = head, validatorHealth(store)
+	}
+
+	return c.status
+}
+
+// healthCheck returns the status of the node. The liveness check fails if the head is older than MaxHeadAge
+// and the node isn't syncing, the readiness check fails also if the node isn't in sync or connected to enough peers
+func healthCheck(
+	store JSONRPCStore,
+	config HealthConfig,
+	validators *validatorHealthCache,
+	readiness bool,
+	now time.Time,
+) *healthStatus {
+	status := &healthStatus{
+		Status: healthStatusOK,
+		Peers:  store.GetPeers(),
+	}
+
+	failed := func(format string, args ...interface{}) {
+		status.Status = healthStatusFailed
+		status.Reasons = append(status.Reasons, fmt.Sprintf(format, args...))
+	}
+
+	if head := store.Header(); head != nil {
+		status.Head = healthHead{Number: head.Number, Hash: head.Hash}
+
+		if timestamp := time.Unix(int64(head.Timestamp), 0); now.After(timestamp) {
+			status.Head.Age = uint64(now.Sub(timestamp).Seconds())
+		}
+	}
+
+	if progression := store.GetSyncProgression(); progression != nil {
+		status.Syncing = true
+
+		if progression.HighestBlock > status.Head.Number {
+			status.SyncLag = progression.HighestBlock - status.Head.Number
+		}
+	}
+
+	headAge := time.Duration(status.Head.Age) * time.Second
+	if config.MaxHeadAge != 0 && headAge > config.MaxHeadAge && (readiness || !status.Syncing) {
+		failed("head block %d is %s old, the maximum is %s", status.Head.Number, headAge, config.MaxHeadAge)
+	}
+
+	if readiness {
+		if status.SyncLag > config.MaxSyncLag {
+			failed("syncing %d blocks behind, the maximum is %d", status.SyncLag, config.MaxSyncLag)
+		}
+
+		if uint64(status.Peers) < config.MinPeers {
+			failed("connected to %d peers, the minimum is %d", status.Peers, config.MinPeers)
+		}
+	}
+
+	if config.ValidatorStore != nil {
+		status.Validator = validators.get(config.ValidatorStore, status.Head.Hash)
+	}
+
+	return status
+}
+
+// validatorHealth returns the status of the validator, the validators which aren't active aren't expected to sign
+func validatorHealth(store ValidatorStore) *healthValidator {
+	_, active, lastSigned, err := store.ValidatorStatus(validatorSigningBlocks)
+	if err != nil {
+		return &healthValidator{Error: err.Error()}
+	}
+
+	return &healthValidator{
+		Active:          active,
+		Signing:         lastSigned != 0,
+		LastSignedBlock: lastSigned,
+	}
+}
+
+// handleHealth serves the liveness check
+func (j *JSONRPC) handleHealth(w http.ResponseWriter, req *http.Request) {
+	j.writeHealth(w, req, false)
+}
+
+// handleReady serves the readiness check
+func (j *JSONRPC) handleReady(w http.ResponseWriter, req *http.Request) {
+	j.writeHealth(w, req, true)
+}
+
+func (j *JSONRPC) writeHealth(w http.ResponseWriter, req *http.Request, readiness bool) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	status := healthCheck(j.config.Store, j.config.Health, &j.validatorHealth, readiness, time.Now())
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if status.Status != healthStatusOK {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		j.logger.Debug("failed to write the health status", "err", err)
+	}
+}
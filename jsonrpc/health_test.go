@@ -0,0 +1,224 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/helper/progress"
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type mockHealthStore struct {
+	*mockStore
+
+	header      *types.Header
+	peers       int
+	progression *progress.Progression
+}
+
+func (m *mockHealthStore) Header() *types.Header {
+	return m.header
+}
+
+func (m *mockHealthStore) GetPeers() int {
+	return m.peers
+}
+
+func (m *mockHealthStore) GetSyncProgression() *progress.Progression {
+	return m.progression
+}
+
+type mockValidatorStore struct {
+	active     bool
+	lastSigned uint64
+	err        error
+}
+
+func (m *mockValidatorStore) ValidatorStatus(uint64) (types.Address, bool, uint64, error) {
+	return types.StringToAddress("1"), m.active, m.lastSigned, m.err
+}
+
+func TestHealthCheck(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_000_000, 0)
+	config := HealthConfig{MaxHeadAge: time.Minute, MinPeers: 2, MaxSyncLag: 10}
+
+	cases := []struct {
+		name      string
+		store     *mockHealthStore
+		readiness bool
+		reasons   []string
+	}{
+		{
+			name:      "ready",
+			store:     &mockHealthStore{header: &types.Header{Number: 100, Timestamp: 999_990}, peers: 2},
+			readiness: true,
+		},
+		{
+			name:      "stale head",
+			store:     &mockHealthStore{header: &types.Header{Number: 100, Timestamp: 999_000}, peers: 2},
+			readiness: true,
+			reasons:   []string{"head block 100 is 16m40s old, the maximum is 1m0s"},
+		},
+		{
+			name: "stale head while syncing is alive",
+			store: &mockHealthStore{
+				header:      &types.Header{Number: 100},
+				progression: &progress.Progression{HighestBlock: 500},
+			},
+		},
+		{
+			name:    "stale head without syncing isn't alive",
+			store:   &mockHealthStore{header: &types.Header{Number: 100}},
+			reasons: []string{"head block 100 is 277h46m40s old, the maximum is 1m0s"},
+		},
+		{
+			name: "syncing",
+			store: &mockHealthStore{
+				header:      &types.Header{Number: 100, Timestamp: 999_990},
+				peers:       5,
+				progression: &progress.Progression{HighestBlock: 111},
+			},
+			readiness: true,
+			reasons:   []string{"syncing 11 blocks behind, the maximum is 10"},
+		},
+		{
+			name: "almost synced",
+			store: &mockHealthStore{
+				header:      &types.Header{Number: 100, Timestamp: 999_990},
+				peers:       5,
+				progression: &progress.Progression{HighestBlock: 110},
+			},
+			readiness: true,
+		},
+		{
+			name:      "not enough peers",
+			store:     &mockHealthStore{header: &types.Header{Number: 100, Timestamp: 999_990}, peers: 1},
+			readiness: true,
+			reasons:   []string{"connected to 1 peers, the minimum is 2"},
+		},
+		{
+			name:  "peers aren't checked by liveness",
+			store: &mockHealthStore{header: &types.Header{Number: 100, Timestamp: 999_990}},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			status := healthCheck(c.store, config, &validatorHealthCache{}, c.readiness, now)
+			require.Equal(t, c.reasons, status.Reasons)
+
+			if len(c.reasons) == 0 {
+				require.Equal(t, healthStatusOK, status.Status)
+			} else {
+				require.Equal(t, healthStatusFailed, status.Status)
+			}
+		})
+	}
+}
+
+func TestHealthCheck_Validator(t *testing.T) {
+	t.Parallel()
+
+	store := &mockHealthStore{header: &types.Header{Number: 100}}
+
+	status := healthCheck(store, HealthConfig{
+		ValidatorStore: &mockValidatorStore{active: true, lastSigned: 99},
+	}, &validatorHealthCache{}, true, time.Now())
+	require.Equal(t, &healthValidator{
+		Active:          true,
+		Signing:         true,
+		LastSignedBlock: 99,
+	}, status.Validator)
+
+	status = healthCheck(store, HealthConfig{
+		ValidatorStore: &mockValidatorStore{active: true},
+	}, &validatorHealthCache{}, true, time.Now())
+	require.True(t, status.Validator.Active)
+	require.False(t, status.Validator.Signing)
+
+	status = healthCheck(store, HealthConfig{
+		ValidatorStore: &mockValidatorStore{err: errors.New("validators not found")},
+	}, &validatorHealthCache{}, true, time.Now())
+	require.Equal(t, "validators not found", status.Validator.Error)
+
+	// the validator status is reported, it doesn't fail the checks
+	require.Equal(t, healthStatusOK, status.Status)
+}
+
+func TestHealthCheck_ValidatorCache(t *testing.T) {
+	t.Parallel()
+
+	var (
+		store      = &mockHealthStore{header: &types.Header{Number: 100, Hash: types.StringToHash("1")}}
+		validators = &mockValidatorStore{active: true, lastSigned: 99}
+		config     = HealthConfig{ValidatorStore: validators}
+		cache      = &validatorHealthCache{}
+	)
+
+	require.Equal(t, uint64(99), healthCheck(store, config, cache, true, time.Now()).Validator.LastSignedBlock)
+
+	// the status is looked up again only with a new head block
+	validators.lastSigned = 100
+	require.Equal(t, uint64(99), healthCheck(store, config, cache, true, time.Now()).Validator.LastSignedBlock)
+
+	store.header = &types.Header{Number: 101, Hash: types.StringToHash("2")}
+	require.Equal(t, uint64(100), healthCheck(store, config, cache, true, time.Now()).Validator.LastSignedBlock)
+}
+
+func TestJSONRPC_Health(t *testing.T) {
+	t.Parallel()
+
+	port, err := tests.GetFreePort()
+	require.NoError(t, err)
+
+	addr := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: port}
+
+	_, err = NewJSONRPC(hclog.NewNullLogger(), &Config{
+		Store: &mockHealthStore{
+			mockStore: newMockStore(),
+			header:    &types.Header{Number: 7, Timestamp: uint64(time.Now().Unix())},
+			peers:     1,
+		},
+		Addr:   addr,
+		Health: HealthConfig{MaxHeadAge: time.Minute, MinPeers: 2},
+		// the health endpoints don't require credentials
+		Auth: &AuthConfig{Tiers: map[string]Tier{"admin": {}}},
+	})
+	require.NoError(t, err)
+
+	get := func(path string) (int, *healthStatus) {
+		resp, err := http.Get("http://" + addr.String() + path) //nolint:noctx
+		require.NoError(t, err)
+
+		defer resp.Body.Close()
+
+		status := &healthStatus{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(status))
+
+		return resp.StatusCode, status
+	}
+
+	code, status := get("/health")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, healthStatusOK, status.Status)
+	require.Equal(t, uint64(7), status.Head.Number)
+
+	code, status = get("/ready")
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, healthStatusFailed, status.Status)
+	require.Equal(t, []string{"connected to 1 peers, the minimum is 2"}, status.Reasons)
+}
@@ -48,10 +48,40 @@ type headerGetter interface {
 	GetHeaderByNumber(uint64) (*types.Header, bool)
 }
 
-// GetBlockHeader returns a header using the provided number
+type pendingBlockGetter interface {
+	// PendingBlock returns the speculative pending block, nil if it is not available
+	PendingBlock() *types.Block
+}
+
+// getPendingHeader returns the header of the pending block,
+// if the store keeps one on top of the current head
+func getPendingHeader(store interface{}) (*types.Header, bool) {
+	pendingStore, ok := store.(pendingBlockGetter)
+	if !ok {
+		return nil, false
+	}
+
+	block := pendingStore.PendingBlock()
+	if block == nil {
+		return nil, false
+	}
+
+	return block.Header, true
+}
+
+// GetBlockHeader returns a header using the provided number.
+// The pending block number resolves to the pending block header if available,
+// and to the latest header otherwise
 func GetBlockHeader(number BlockNumber, store headerGetter) (*types.Header, error) {
 	switch number {
-	case PendingBlockNumber, LatestBlockNumber:
+	case PendingBlockNumber:
+		if header, ok := getPendingHeader(store); ok {
+			return header, nil
+		}
+
+		return store.Header(), nil
+
+	case LatestBlockNumber:
 		return store.Header(), nil
 
 	case EarliestBlockNumber:
@@ -140,7 +170,22 @@ func GetNextNonce(address types.Address, number BlockNumber, store nonceGetter)
 		// Grab the latest pending nonce from the TxPool
 		// If the account is not initialized in the local TxPool,
 		// return the latest nonce from the world state
-		return store.GetNonce(address), nil
+		nonce := store.GetNonce(address)
+
+		// The pending block may not include all the pool transactions of the account,
+		// so its nonce is used only if it is ahead of the pool
+		if header, ok := getPendingHeader(store); ok {
+			pendingNonce, err := getAccountNonce(address, header.StateRoot, store)
+			if err != nil {
+				return 0, err
+			}
+
+			if pendingNonce > nonce {
+				nonce = pendingNonce
+			}
+		}
+
+		return nonce, nil
 	}
 
 	header, err := GetBlockHeader(number, store)
@@ -148,7 +193,12 @@ func GetNextNonce(address types.Address, number BlockNumber, store nonceGetter)
 		return 0, err
 	}
 
-	acc, err := store.GetAccount(header.StateRoot, address)
+	return getAccountNonce(address, header.StateRoot, store)
+}
+
+// getAccountNonce returns the account nonce in the given state
+func getAccountNonce(address types.Address, stateRoot types.Hash, store nonceGetter) (uint64, error) {
+	acc, err := store.GetAccount(stateRoot, address)
 
 	//nolint:govet
 	if errors.Is(err, ErrStateNotFound) {
@@ -162,7 +212,9 @@ func GetNextNonce(address types.Address, number BlockNumber, store nonceGetter)
 	return acc.Nonce, nil
 }
 
-func DecodeTxn(arg *txnArgs, blockNumber uint64, store nonceGetter, forceSetNonce bool) (*types.Transaction, error) {
+// DecodeTxn creates the transaction from the given arguments.
+// Missing nonce is set to the next nonce of the sender in the latest state
+func DecodeTxn(arg *txnArgs, header *types.Header, store nonceGetter, forceSetNonce bool) (*types.Transaction, error) {
 	if arg == nil {
 		return nil, errors.New("missing value for required argument 0")
 	}
@@ -235,7 +287,7 @@ func DecodeTxn(arg *txnArgs, blockNumber uint64, store nonceGetter, forceSetNonc
 		txn.To = arg.To
 	}
 
-	txn.ComputeHash(blockNumber)
+	txn.ComputeHash(header.Number)
 
 	return txn, nil
 }
@@ -870,7 +870,7 @@ func TestDecodeTxn(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			tx, err := DecodeTxn(test.arg, 1, test.store, false)
+			tx, err := DecodeTxn(test.arg, &types.Header{Number: 1}, test.store, false)
 
 			// DecodeTxn computes hash of tx
 			if !test.err {
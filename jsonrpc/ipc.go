@@ -0,0 +1,93 @@
+package jsonrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// ipcConn is the connection of a local client, which streams the JSON-RPC requests and responses.
+// It is served like a WS connection, so the subscriptions are pushed to it
+type ipcConn struct {
+	lock     sync.Mutex
+	conn     net.Conn
+	filterID string
+}
+
+func (c *ipcConn) SetFilterID(filterID string) {
+	c.filterID = filterID
+}
+
+func (c *ipcConn) GetFilterID() string {
+	return c.filterID
+}
+
+// WriteMessage writes out the message followed by a new line, the message type is ignored
+func (c *ipcConn) WriteMessage(_ int, data []byte) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_, err := c.conn.Write(append(data, '\n'))
+
+	return err
+}
+
+// serveIPC serves the connections accepted by the listener until it is closed.
+// The local clients are trusted, they are served by the unrestricted dispatcher without rate limits
+func serveIPC(logger hclog.Logger, d dispatcher, lis net.Listener) {
+	logger.Info("ipc server started", "addr", lis.Addr().String())
+
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				logger.Error("closed ipc listener", "err", err)
+			}
+
+			return
+		}
+
+		go handleIPCConn(logger, d, conn)
+	}
+}
+
+// handleIPCConn reads the stream of the requests (objects or batches) until the client closes the connection
+func handleIPCConn(logger hclog.Logger, d dispatcher, conn net.Conn) {
+	defer conn.Close()
+
+	ipc := &ipcConn{conn: conn}
+	decoder := json.NewDecoder(conn)
+
+	for {
+		var message json.RawMessage
+		if err := decoder.Decode(&message); err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				// the rest of the stream can't be decoded
+				logger.Debug("invalid ipc message", "err", err)
+
+				resp, _ := NewRPCResponse(nil, "2.0", nil, NewInvalidRequestError("Invalid json request")).Bytes()
+				_ = ipc.WriteMessage(0, resp)
+			}
+
+			d.RemoveFilterByWs(ipc)
+
+			return
+		}
+
+		go func() {
+			resp, err := d.HandleWs(context.Background(), message, ipc)
+			if err != nil {
+				logger.Error("unable to handle ipc request", "err", err)
+
+				resp, _ = NewRPCResponse(nil, "2.0", nil, NewInternalError(err.Error())).Bytes()
+			}
+
+			_ = ipc.WriteMessage(0, resp)
+		}()
+	}
+}
@@ -0,0 +1,71 @@
+package jsonrpc
+
+import (
+	"bufio"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/helper/ipc"
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+)
+
+func TestJSONRPC_IPC(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "hydra.ipc")
+
+	lis, err := ipc.Listen(path)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = lis.Close()
+	})
+
+	port, err := tests.GetFreePort()
+	require.NoError(t, err)
+
+	_, err = NewJSONRPC(hclog.NewNullLogger(), &Config{
+		Store:   newMockStore(),
+		Addr:    &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: port},
+		ChainID: 100,
+		// the local clients aren't restricted by the policy of the listener
+		AccessPolicy: AccessPolicy{Namespaces: []string{"web3"}},
+		IPCListener:  lis,
+	})
+	require.NoError(t, err)
+
+	conn, err := ipc.DialTimeout(path, time.Second)
+	require.NoError(t, err)
+
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+
+	request := func(body string) string {
+		_, err := conn.Write([]byte(body))
+		require.NoError(t, err)
+
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+
+		return line
+	}
+
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"0x64"}`+"\n",
+		request(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`))
+
+	// the requests are delimited by the JSON values, not the new lines
+	require.Equal(t, `[{"jsonrpc":"2.0","id":2,"result":"0x64"}]`+"\n",
+		request(`[{"jsonrpc":"2.0","id":2,`+"\n"+`"method":"eth_chainId","params":[]}]`))
+
+	require.Contains(t, request(`{"jsonrpc":`+"}"), "Invalid json request")
+
+	// the connection is closed after an invalid message
+	_, err = reader.ReadString('\n')
+	require.Error(t, err)
+}
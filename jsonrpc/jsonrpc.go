@@ -1,19 +1,28 @@
 package jsonrpc
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/versioning"
 	"github.com/gorilla/websocket"
+	"github.com/graph-gophers/graphql-go"
 	"github.com/hashicorp/go-hclog"
+	"go.opentelemetry.io/otel/propagation"
 )
 
+// allowedHeaders are the headers the browser clients are allowed to send
+const allowedHeaders = "Accept, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, " +
+	"Traceparent, Tracestate"
+
 type serverType int
 
 const (
@@ -39,13 +48,28 @@ func (s serverType) String() string {
 type JSONRPC struct {
 	logger     hclog.Logger
 	config     *Config
+	addr       *net.TCPAddr
 	dispatcher dispatcher
+
+	// auth authenticates the clients, all of them are served by the dispatcher if it is nil
+	auth *authenticator
+
+	// limiter limits the request cost of the clients,
+	// the ones which aren't authenticated are limited by rateLimit
+	limiter   *rateLimiter
+	rateLimit RateLimit
+
+	// validatorHealth caches the validator status reported by the health endpoints
+	validatorHealth validatorHealthCache
 }
 
 type dispatcher interface {
 	RemoveFilterByWs(conn wsConn)
-	HandleWs(reqBody []byte, conn wsConn) ([]byte, error)
-	Handle(reqBody []byte) ([]byte, error)
+	HandleWs(ctx context.Context, reqBody []byte, conn wsConn) ([]byte, error)
+	Handle(ctx context.Context, reqBody []byte) ([]byte, error)
+	Cost(reqBody []byte) int
+	DataCost(reqBody []byte) int
+	HandleGraphQL(ctx context.Context, reqBody []byte, take func(cost int) error) (*graphql.Response, error)
 }
 
 // JSONRPCStore defines all the methods required
@@ -71,6 +95,46 @@ type Config struct {
 
 	ConcurrentRequestsDebug uint64
 	WebSocketReadLimit      uint64
+
+	// DevStore enables the evm and hardhat endpoints of the dev consensus
+	DevStore DevStore
+
+	// AccessPolicy restricts the methods served on Addr
+	AccessPolicy AccessPolicy
+
+	// PrivateAddr is the address of an optional second listener (e.g. on localhost),
+	// which serves the methods allowed by PrivateAccessPolicy
+	PrivateAddr         *net.TCPAddr
+	PrivateAccessPolicy AccessPolicy
+
+	// Auth enables the authentication of the clients of both listeners
+	Auth *AuthConfig
+
+	// RateLimit limits the request cost of the clients of Addr by their IP address,
+	// if they aren't authenticated (the authenticated clients are limited by their tiers)
+	RateLimit RateLimit
+
+	// MethodCosts overrides the costs of the methods, by name or prefix ending with *
+	MethodCosts map[string]uint64
+
+	// Health defines the thresholds of the /health and /ready endpoints of both listeners
+	Health HealthConfig
+
+	// GraphQL enables the EIP-1767 GraphQL endpoint on /graphql of both listeners,
+	// a query costs at most GraphQLMaxCost units (zero is unlimited)
+	GraphQL        bool
+	GraphQLMaxCost uint64
+
+	// IPCListener serves all the methods to the local clients (e.g. on the unix socket in the data dir),
+	// without authentication and rate limits
+	IPCListener net.Listener
+}
+
+// listenerConfig is the configuration of one of the listeners
+type listenerConfig struct {
+	addr      *net.TCPAddr
+	policy    AccessPolicy
+	rateLimit RateLimit
 }
 
 // NewJSONRPC returns the JSONRPC http server
@@ -85,6 +149,10 @@ func NewJSONRPC(logger hclog.Logger, config *Config) (*JSONRPC, error) {
 			jsonRPCBatchLengthLimit: config.BatchLengthLimit,
 			blockRangeLimit:         config.BlockRangeLimit,
 			concurrentRequestsDebug: config.ConcurrentRequestsDebug,
+			devStore:                config.DevStore,
+			methodCosts:             config.MethodCosts,
+			graphQL:                 config.GraphQL,
+			graphQLMaxCost:          config.GraphQLMaxCost,
 		},
 	)
 
@@ -92,10 +160,67 @@ func NewJSONRPC(logger hclog.Logger, config *Config) (*JSONRPC, error) {
 		return nil, err
 	}
 
+	if err := config.RateLimit.validate(); err != nil {
+		return nil, err
+	}
+
+	logger = logger.Named("jsonrpc")
+
+	// the listeners share the buckets of the clients
+	limiter := newRateLimiter()
+
+	srv, err := newListener(logger, config, d, limiter, listenerConfig{
+		addr:      config.Addr,
+		policy:    config.AccessPolicy.withUnsafeMethodsDenied(),
+		rateLimit: config.RateLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if config.PrivateAddr != nil {
+		if _, err := newListener(logger.Named("private"), config, d, limiter, listenerConfig{
+			addr:   config.PrivateAddr,
+			policy: config.PrivateAccessPolicy,
+		}); err != nil {
+			return nil, fmt.Errorf("private listener: %w", err)
+		}
+	}
+
+	if config.IPCListener != nil {
+		go serveIPC(logger.Named("ipc"), d, config.IPCListener)
+	}
+
+	return srv, nil
+}
+
+// newListener starts the http server on the address of the listener,
+// which serves the methods allowed by its policy
+func newListener(
+	logger hclog.Logger,
+	config *Config,
+	d *Dispatcher,
+	limiter *rateLimiter,
+	listener listenerConfig,
+) (*JSONRPC, error) {
+	restricted, err := d.withPolicy(listener.policy)
+	if err != nil {
+		return nil, err
+	}
+
 	srv := &JSONRPC{
-		logger:     logger.Named("jsonrpc"),
+		logger:     logger,
 		config:     config,
-		dispatcher: d,
+		addr:       listener.addr,
+		dispatcher: restricted,
+		limiter:    limiter,
+		rateLimit:  listener.rateLimit,
+	}
+
+	if config.Auth != nil {
+		if srv.auth, err = newAuthenticator(config.Auth, restricted); err != nil {
+			return nil, err
+		}
 	}
 
 	// start http server
@@ -106,14 +231,22 @@ func NewJSONRPC(logger hclog.Logger, config *Config) (*JSONRPC, error) {
 	return srv, nil
 }
 
+// Addr returns the address the http server listens on
+func (j *JSONRPC) Addr() net.Addr {
+	return j.addr
+}
+
 func (j *JSONRPC) setupHTTP() error {
-	j.logger.Info("http server started", "addr", j.config.Addr.String())
+	j.logger.Info("http server started", "addr", j.addr.String())
 
-	lis, err := net.Listen("tcp", j.config.Addr.String())
+	lis, err := net.Listen("tcp", j.addr.String())
 	if err != nil {
 		return err
 	}
 
+	// the bound port replaces the port 0 of the configuration
+	j.addr = lis.Addr().(*net.TCPAddr) //nolint:forcetypeassert
+
 	// NewServeMux must be used, as it disables all debug features.
 	// For some strange reason, with DefaultServeMux debug/vars is always enabled (but not debug/pprof).
 	// If pprof need to be enabled, this should be DefaultServeMux
@@ -125,6 +258,14 @@ func (j *JSONRPC) setupHTTP() error {
 
 	mux.HandleFunc("/ws", j.handleWs)
 
+	if j.config.GraphQL {
+		mux.Handle("/graphql", middlewareFactory(j.config)(http.HandlerFunc(j.handleGraphQL)))
+	}
+
+	// the load balancers probe the node without credentials
+	mux.HandleFunc("/health", j.handleHealth)
+	mux.HandleFunc("/ready", j.handleReady)
+
 	srv := http.Server{
 		Handler:           mux,
 		ReadHeaderTimeout: 60 * time.Second,
@@ -211,7 +352,100 @@ func isSupportedWSType(messageType int) bool {
 		messageType == websocket.BinaryMessage
 }
 
+// authenticate returns the dispatcher of the client of the request. If the client isn't authenticated,
+// it writes the error response and returns false
+func (j *JSONRPC) authenticate(w http.ResponseWriter, req *http.Request) (dispatcher, *authClient, bool) {
+	d, client, err := j.client(req)
+	if err != nil {
+		writeErrorResponse(w, http.StatusUnauthorized, NewInvalidRequestError("unauthorized: "+err.Error()))
+
+		return nil, nil, false
+	}
+
+	return d, client, true
+}
+
+// client returns the dispatcher and the authenticated client of the request,
+// the client is nil if the authentication is disabled
+func (j *JSONRPC) client(req *http.Request) (dispatcher, *authClient, error) {
+	if j.auth == nil {
+		return j.dispatcher, nil, nil
+	}
+
+	client, err := j.auth.authenticate(req)
+	if err != nil {
+		j.logger.Debug("unauthorized request", "remote", req.RemoteAddr, "err", err)
+
+		return nil, nil, err
+	}
+
+	return j.auth.dispatchers[client.tier], client, nil
+}
+
+// bucket returns the tier, the id and the rate limit of the bucket of the client of the request,
+// the authenticated client or the IP address of the request
+func (j *JSONRPC) bucket(req *http.Request, client *authClient) (string, string, RateLimit) {
+	if client != nil {
+		return client.tier, client.id, j.auth.rateLimit(client)
+	}
+
+	return defaultTier, "ip:" + clientIP(req), j.rateLimit
+}
+
+// take takes the cost of the request from the bucket of its client. It returns an error if the request is limited
+func (j *JSONRPC) take(req *http.Request, client *authClient, cost int) *rateLimitError {
+	tier, id, limit := j.bucket(req, client)
+
+	return j.limiter.take(tier, id, limit, cost)
+}
+
+// admit takes the price of the request body from the bucket of its client. Once the request is admitted,
+// the returned function takes the cost of the data it refers to, which refunds the price if it is limited.
+// The request isn't costed at all if its client isn't rate limited
+func (j *JSONRPC) admit(
+	req *http.Request,
+	client *authClient,
+	d dispatcher,
+	reqBody []byte,
+) (func() *rateLimitError, *rateLimitError) {
+	tier, id, limit := j.bucket(req, client)
+	if limit.Rate == 0 {
+		return func() *rateLimitError { return nil }, nil
+	}
+
+	price := d.Cost(reqBody)
+	if limitErr := j.limiter.take(tier, id, limit, price); limitErr != nil {
+		return nil, limitErr
+	}
+
+	takeData := func() *rateLimitError {
+		if limitErr := j.limiter.take(tier, id, limit, d.DataCost(reqBody)); limitErr != nil {
+			j.limiter.refund(tier, id, limit, price)
+
+			return limitErr
+		}
+
+		return nil
+	}
+
+	return takeData, nil
+}
+
+func writeErrorResponse(w http.ResponseWriter, status int, err Error) {
+	resp, _ := NewRPCResponse(nil, "2.0", nil, err).Bytes()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(resp)
+}
+
 func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
+	// the upgrade request is authenticated, the connection is served with the dispatcher of the client
+	d, client, ok := j.authenticate(w, req)
+	if !ok {
+		return
+	}
+
 	// CORS rule - Allow requests from anywhere
 	wsUpgrader.CheckOrigin = func(r *http.Request) bool { return true }
 
@@ -240,6 +474,9 @@ func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
 
 	wrapConn := &wsWrapper{ws: ws, logger: j.logger}
 
+	// the requests of the connection are traced as children of the trace context of the upgrade request
+	ctx := tracing.Extract(context.Background(), propagation.HeaderCarrier(req.Header))
+
 	j.logger.Info("Websocket connection established")
 	// Run the listen loop
 	for {
@@ -258,14 +495,27 @@ func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
 				j.logger.Info("Closing WS connection with error")
 			}
 
-			j.dispatcher.RemoveFilterByWs(wrapConn)
+			d.RemoveFilterByWs(wrapConn)
 
 			break
 		}
 
 		if isSupportedWSType(msgType) {
+			takeData, limitErr := j.admit(req, client, d, message)
+			if limitErr != nil {
+				_ = wrapConn.WriteMessage(msgType, limitErr.response(message))
+
+				continue
+			}
+
 			go func() {
-				resp, handleErr := j.dispatcher.HandleWs(message, wrapConn)
+				if limitErr := takeData(); limitErr != nil {
+					_ = wrapConn.WriteMessage(msgType, limitErr.response(message))
+
+					return
+				}
+
+				resp, handleErr := d.HandleWs(ctx, message, wrapConn)
 				if handleErr != nil {
 					j.logger.Error(fmt.Sprintf("Unable to handle WS request, %s", handleErr.Error()))
 
@@ -284,10 +534,7 @@ func (j *JSONRPC) handleWs(w http.ResponseWriter, req *http.Request) {
 func (j *JSONRPC) handle(w http.ResponseWriter, req *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
-	w.Header().Set(
-		"Access-Control-Allow-Headers",
-		"Accept, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization",
-	)
+	w.Header().Set("Access-Control-Allow-Headers", allowedHeaders)
 
 	switch req.Method {
 	case "POST":
@@ -302,6 +549,11 @@ func (j *JSONRPC) handle(w http.ResponseWriter, req *http.Request) {
 }
 
 func (j *JSONRPC) handleJSONRPCRequest(w http.ResponseWriter, req *http.Request) {
+	d, client, ok := j.authenticate(w, req)
+	if !ok {
+		return
+	}
+
 	data, err := io.ReadAll(req.Body)
 	if err != nil {
 		_, _ = w.Write([]byte(err.Error()))
@@ -309,10 +561,27 @@ func (j *JSONRPC) handleJSONRPCRequest(w http.ResponseWriter, req *http.Request)
 		return
 	}
 
+	// the request is admitted by its price before its data is looked up to charge the rest of its cost
+	takeData, limitErr := j.admit(req, client, d, data)
+	if limitErr == nil {
+		limitErr = takeData()
+	}
+
+	if limitErr != nil {
+		if limitErr.retryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(limitErr.retryAfterSeconds()))
+		}
+
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = w.Write(limitErr.response(data))
+
+		return
+	}
+
 	// log request
 	j.logger.Debug("handle", "request", string(data))
 
-	resp, err := j.dispatcher.Handle(data)
+	resp, err := d.Handle(tracing.Extract(req.Context(), propagation.HeaderCarrier(req.Header)), data)
 	if err != nil {
 		_, _ = w.Write([]byte(err.Error()))
 	} else {
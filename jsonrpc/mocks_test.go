@@ -132,6 +132,10 @@ func (m *mockStore) SetAccount(addr types.Address, account *Account) {
 	m.accounts[addr] = account
 }
 
+func (m *mockStore) PendingBlock() *types.Block {
+	return nil
+}
+
 func (m *mockStore) Header() *types.Header {
 	return m.header
 }
@@ -181,6 +185,10 @@ func (m *mockStore) GetBlockByNumber(num uint64, full bool) (*types.Block, bool)
 	return &types.Block{Header: header}, header != nil
 }
 
+func (m *mockStore) SetHead(number uint64) error {
+	return nil
+}
+
 func (m *mockStore) GetTxs(inclQueued bool) (
 	map[types.Address][]*types.Transaction,
 	map[types.Address][]*types.Transaction,
@@ -188,6 +196,13 @@ func (m *mockStore) GetTxs(inclQueued bool) (
 	return nil, nil
 }
 
+func (m *mockStore) GetAccountTxs(addr types.Address) (
+	[]*types.Transaction,
+	[]*types.Transaction,
+) {
+	return nil, nil
+}
+
 func (m *mockStore) GetCapacity() (uint64, uint64) {
 	return 0, 0
 }
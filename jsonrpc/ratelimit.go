@@ -0,0 +1,190 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// limitersPruneInterval is the interval at which the buckets of the idle clients are removed
+	limitersPruneInterval = time.Minute
+
+	// defaultTier is the tier label of the clients which aren't authenticated
+	defaultTier = "default"
+
+	errRateLimitExceeded = "rate limit exceeded"
+)
+
+// RateLimit is a token bucket of request cost units. Most of the requests cost one unit,
+// the expensive ones cost more (see Dispatcher.Cost and Dispatcher.DataCost)
+type RateLimit struct {
+	// Rate is the number of cost units per second of a client, unlimited if it is zero
+	Rate float64
+
+	// Burst is the number of cost units a client can spend at once, the rate rounded up if it is zero
+	Burst int
+}
+
+// burst returns the size of the bucket
+func (l RateLimit) burst() int {
+	if l.Burst == 0 {
+		return int(math.Ceil(l.Rate))
+	}
+
+	return l.Burst
+}
+
+// validate returns an error if the rate or the burst is negative
+func (l RateLimit) validate() error {
+	if l.Rate < 0 || l.Burst < 0 {
+		return fmt.Errorf("negative rate limit %v/%d", l.Rate, l.Burst)
+	}
+
+	return nil
+}
+
+// rateLimitError is the error of a limited request
+type rateLimitError struct {
+	err string
+
+	// retryAfter is the time after which the request would be allowed, zero if it never is
+	retryAfter time.Duration
+}
+
+func (e *rateLimitError) Error() string {
+	return e.err
+}
+
+func (e *rateLimitError) ErrorCode() int {
+	return NewLimitExceededError("").ErrorCode()
+}
+
+// response returns the JSON-RPC error response of the limited request body,
+// with the id of the request or one error response for every request of a batch
+func (e *rateLimitError) response(reqBody []byte) []byte {
+	reqBody = bytes.TrimLeft(reqBody, " \t\r\n")
+
+	if len(reqBody) > 0 && reqBody[0] == '[' {
+		var batchReq BatchRequest
+		if err := json.Unmarshal(reqBody, &batchReq); err == nil && len(batchReq) > 0 {
+			responses := make([]Response, len(batchReq))
+			for i, req := range batchReq {
+				responses[i] = NewRPCResponse(req.ID, "2.0", nil, e)
+			}
+
+			resp, _ := json.Marshal(responses)
+
+			return resp
+		}
+	}
+
+	var req Request
+	_ = json.Unmarshal(reqBody, &req)
+
+	resp, _ := NewRPCResponse(req.ID, "2.0", nil, e).Bytes()
+
+	return resp
+}
+
+// retryAfterSeconds returns the value of the Retry-After header, rounded up to whole seconds
+func (e *rateLimitError) retryAfterSeconds() int {
+	return int(math.Ceil(e.retryAfter.Seconds()))
+}
+
+// rateLimiter holds the token buckets of the clients, shared by the listeners
+type rateLimiter struct {
+	lock      sync.Mutex
+	limiters  map[string]*rate.Limiter
+	lastPrune time.Time
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		limiters:  map[string]*rate.Limiter{},
+		lastPrune: time.Now(),
+	}
+}
+
+// take takes the cost of the request from the bucket of the client, identified by its tier and id.
+// If the bucket doesn't hold enough units, nothing is taken and a rateLimitError is returned
+func (r *rateLimiter) take(tier, id string, limit RateLimit, cost int) *rateLimitError {
+	metrics.IncrCounterWithLabels(
+		[]string{jsonRPCMetric, "request_cost"}, float32(cost), []metrics.Label{{Name: "tier", Value: tier}})
+
+	if limit.Rate == 0 {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := time.Now()
+
+	if now.Sub(r.lastPrune) > limitersPruneInterval {
+		// the buckets which are full again behave the same as the new ones
+		for key, limiter := range r.limiters {
+			if limiter.TokensAt(now) >= float64(limiter.Burst()) {
+				delete(r.limiters, key)
+			}
+		}
+
+		r.lastPrune = now
+
+		metrics.SetGauge([]string{jsonRPCMetric, "rate_limiter_clients"}, float32(len(r.limiters)))
+	}
+
+	key := tier + "/" + id
+
+	limiter, ok := r.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(limit.Rate), limit.burst())
+		r.limiters[key] = limiter
+	}
+
+	reservation := limiter.ReserveN(now, cost)
+	if !reservation.OK() {
+		metrics.IncrCounterWithLabels(
+			[]string{jsonRPCMetric, "rate_limited"}, 1, []metrics.Label{{Name: "tier", Value: tier}})
+
+		return &rateLimitError{
+			err: fmt.Sprintf("request cost %d exceeds the rate limit burst %d", cost, limiter.Burst()),
+		}
+	}
+
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+
+		metrics.IncrCounterWithLabels(
+			[]string{jsonRPCMetric, "rate_limited"}, 1, []metrics.Label{{Name: "tier", Value: tier}})
+
+		limitErr := &rateLimitError{retryAfter: delay}
+		limitErr.err = fmt.Sprintf("%s, retry after %ds", errRateLimitExceeded, limitErr.retryAfterSeconds())
+
+		return limitErr
+	}
+
+	return nil
+}
+
+// refund returns the cost of a request, which was taken but not served, to the bucket of the client
+func (r *rateLimiter) refund(tier, id string, limit RateLimit, cost int) {
+	if limit.Rate == 0 || cost <= 0 {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// a pruned bucket is full again
+	if limiter, ok := r.limiters[tier+"/"+id]; ok {
+		// a reservation of negative units adds them to the bucket, which never holds more than its burst
+		limiter.ReserveN(time.Now(), -cost)
+	}
+}
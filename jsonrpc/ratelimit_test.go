@@ -0,0 +1,253 @@
+package jsonrpc
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// newCostTestStore returns a store with the head at block 1000 and the block 5 which used 12M gas
+func newCostTestStore() *mockStore {
+	store := newMockStore()
+	store.header = &types.Header{Number: 1000, GasLimit: 30_000_000}
+	store.addHeader(store.header)
+	store.addHeader(&types.Header{Number: 5, GasUsed: 12_000_000})
+
+	return store
+}
+
+func TestDispatcher_Cost(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name        string
+		methodCosts map[string]uint64
+		request     string
+		cost        int
+		dataCost    int
+	}{
+		{
+			name:    "default cost",
+			request: `{"method": "eth_chainId"}`,
+			cost:    1,
+		},
+		{
+			name:    "invalid request",
+			request: `{"method": `,
+			cost:    1,
+		},
+		{
+			name:    "batch",
+			request: `[{"method": "eth_chainId"}, {"method": "eth_blockNumber"}]`,
+			cost:    2,
+		},
+		{
+			name:     "logs by block span",
+			request:  `{"method": "eth_getLogs", "params": [{"fromBlock": "0x0", "toBlock": "latest"}]}`,
+			cost:     1,
+			dataCost: 10,
+		},
+		{
+			name:    "logs by block numbers",
+			request: `{"method": "eth_getLogs", "params": [{"fromBlock": "0x0", "toBlock": "0x3e7"}]}`,
+			cost:    11,
+		},
+		{
+			name:    "logs by block hash",
+			request: `{"method": "eth_getLogs", "params": [{"blockHash": "` + types.ZeroHash.String() + `"}]}`,
+			cost:    1,
+		},
+		{
+			name:     "trace block by gas used",
+			request:  `{"method": "debug_traceBlockByNumber", "params": ["0x5"]}`,
+			cost:     1,
+			dataCost: 12,
+		},
+		{
+			name:    "trace call by gas",
+			request: `{"method": "debug_traceCall", "params": [{"gas": "0x2dc6c0"}, "latest"]}`,
+			cost:    4,
+		},
+		{
+			name:     "trace call by block gas limit",
+			request:  `{"method": "debug_traceCall", "params": [{}, "latest"]}`,
+			cost:     1,
+			dataCost: 30,
+		},
+		{
+			name:        "configured method cost",
+			methodCosts: map[string]uint64{"debug_*": 5, "debug_traceCall": 2},
+			request:     `{"method": "debug_traceCall", "params": [{"gas": "0x2dc6c0"}, "latest"]}`,
+			cost:        5,
+		},
+		{
+			name:        "configured prefix cost",
+			methodCosts: map[string]uint64{"debug_*": 5, "debug_traceCall": 2},
+			request:     `{"method": "debug_traceBlockByNumber", "params": ["0x5"]}`,
+			cost:        5,
+			dataCost:    12,
+		},
+		{
+			name:        "longest prefix",
+			methodCosts: map[string]uint64{"eth_*": 2, "eth_get*": 3},
+			request:     `{"method": "eth_getBalance"}`,
+			cost:        3,
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			dispatcher := newTestDispatcher(t, hclog.NewNullLogger(), newCostTestStore(), &dispatcherParams{
+				methodCosts: c.methodCosts,
+			})
+
+			require.Equal(t, c.cost, dispatcher.Cost([]byte(c.request)))
+			require.Equal(t, c.dataCost, dispatcher.DataCost([]byte(c.request)))
+		})
+	}
+}
+
+// newTestRateLimitJSONRPC starts a JSON-RPC server limited by the IP address of the clients
+// and returns its URL
+func newTestRateLimitJSONRPC(t *testing.T, limit RateLimit) string {
+	t.Helper()
+
+	port, err := tests.GetFreePort()
+	require.NoError(t, err)
+
+	addr := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: port}
+
+	_, err = NewJSONRPC(hclog.NewNullLogger(), &Config{
+		Store:     newCostTestStore(),
+		Addr:      addr,
+		ChainID:   100,
+		RateLimit: limit,
+	})
+	require.NoError(t, err)
+
+	return "http://" + addr.String()
+}
+
+func postBody(t *testing.T, url, body string) (*http.Response, string) {
+	t.Helper()
+
+	resp, err := http.Post(url, "application/json", strings.NewReader(body)) //nolint:noctx
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return resp, string(data)
+}
+
+func TestJSONRPC_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	chainIDRequest := `{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`
+
+	t.Run("retry after", func(t *testing.T) {
+		t.Parallel()
+
+		url := newTestRateLimitJSONRPC(t, RateLimit{Rate: 0.01, Burst: 3})
+
+		resp, _ := postBody(t, url, `[`+chainIDRequest+`,`+chainIDRequest+`]`)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, _ = postBody(t, url, chainIDRequest)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		resp, body := postBody(t, url, chainIDRequest)
+		require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		require.Equal(t, "100", resp.Header.Get("Retry-After"))
+		require.Contains(t, body, `"code":-32005`)
+		require.Contains(t, body, `"id":1`)
+		require.Contains(t, body, "rate limit exceeded, retry after 100s")
+
+		resp, body = postBody(t, url, `[`+chainIDRequest+`,`+strings.Replace(chainIDRequest, `"id":1`, `"id":2`, 1)+`]`)
+		require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		require.Contains(t, body, `"id":1`)
+		require.Contains(t, body, `"id":2`)
+	})
+
+	t.Run("cost exceeds burst", func(t *testing.T) {
+		t.Parallel()
+
+		url := newTestRateLimitJSONRPC(t, RateLimit{Rate: 1, Burst: 5})
+
+		resp, body := postBody(t, url,
+			`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"fromBlock":"0x0","toBlock":"0x3e7"}]}`)
+		require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		require.Empty(t, resp.Header.Get("Retry-After"))
+		require.Contains(t, body, "request cost 11 exceeds the rate limit burst 5")
+
+		// the bucket is still full
+		resp, _ = postBody(t, url, chainIDRequest)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+
+	t.Run("data cost exceeds burst", func(t *testing.T) {
+		t.Parallel()
+
+		url := newTestRateLimitJSONRPC(t, RateLimit{Rate: 0.01, Burst: 5})
+
+		// the request is admitted for one unit, the span up to the latest block costs 10 more
+		resp, body := postBody(t, url,
+			`{"jsonrpc":"2.0","id":1,"method":"eth_getLogs","params":[{"fromBlock":"0x0","toBlock":"latest"}]}`)
+		require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		require.Empty(t, resp.Header.Get("Retry-After"))
+		require.Contains(t, body, "request cost 10 exceeds the rate limit burst 5")
+
+		// the admission unit is refunded
+		for i := 0; i < 5; i++ {
+			resp, _ = postBody(t, url, chainIDRequest)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+		}
+
+		resp, _ = postBody(t, url, chainIDRequest)
+		require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	})
+}
+
+// costlessDispatcher fails the test if a request is costed
+type costlessDispatcher struct {
+	dispatcher
+
+	t *testing.T
+}
+
+func (d *costlessDispatcher) Cost([]byte) int {
+	d.t.Error("the request is costed")
+
+	return 1
+}
+
+func (d *costlessDispatcher) DataCost([]byte) int {
+	d.t.Error("the data of the request is costed")
+
+	return 0
+}
+
+func TestJSONRPC_AdmitUnlimited(t *testing.T) {
+	t.Parallel()
+
+	j := &JSONRPC{limiter: newRateLimiter()}
+	req := &http.Request{RemoteAddr: "127.0.0.1:1234"}
+
+	takeData, limitErr := j.admit(req, nil, &costlessDispatcher{t: t}, []byte(`{"method": "debug_traceTransaction"}`))
+	require.Nil(t, limitErr)
+	require.Nil(t, takeData())
+}
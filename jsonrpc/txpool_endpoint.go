@@ -12,6 +12,10 @@ type txPoolStore interface {
 	// GetTxs gets tx pool transactions currently pending for inclusion and currently queued for validation
 	GetTxs(inclQueued bool) (map[types.Address][]*types.Transaction, map[types.Address][]*types.Transaction)
 
+	// GetAccountTxs gets tx pool transactions of the account currently pending for inclusion
+	// and currently queued for validation
+	GetAccountTxs(addr types.Address) ([]*types.Transaction, []*types.Transaction)
+
 	// GetCapacity returns the current and max capacity of the pool in slots
 	GetCapacity() (uint64, uint64)
 
@@ -29,6 +33,11 @@ type ContentResponse struct {
 	Queued  map[types.Address]map[uint64]*transaction `json:"queued"`
 }
 
+type ContentFromResponse struct {
+	Pending map[uint64]*transaction `json:"pending"`
+	Queued  map[uint64]*transaction `json:"queued"`
+}
+
 type InspectResponse struct {
 	Pending         map[string]map[string]string `json:"pending"`
 	Queued          map[string]map[string]string `json:"queued"`
@@ -67,6 +76,28 @@ func (t *TxPool) Content() (interface{}, error) {
 	return resp, nil
 }
 
+// Create response for txpool_contentFrom request.
+// See https://geth.ethereum.org/docs/interacting-with-geth/rpc/ns-txpool#txpool-contentfrom.
+func (t *TxPool) ContentFrom(addr types.Address) (interface{}, error) {
+	convertTxs := func(txs []*types.Transaction) map[uint64]*transaction {
+		result := make(map[uint64]*transaction, len(txs))
+
+		for _, tx := range txs {
+			result[tx.Nonce] = toTransaction(tx, nil, &types.ZeroHash, nil)
+		}
+
+		return result
+	}
+
+	pendingTxs, queuedTxs := t.store.GetAccountTxs(addr)
+	resp := ContentFromResponse{
+		Pending: convertTxs(pendingTxs),
+		Queued:  convertTxs(queuedTxs),
+	}
+
+	return resp, nil
+}
+
 // Create response for txpool_inspect request.
 // See https://geth.ethereum.org/docs/rpc/ns-txpool#txpool_inspect.
 func (t *TxPool) Inspect() (interface{}, error) {
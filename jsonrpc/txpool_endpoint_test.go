@@ -264,6 +264,50 @@ func TestStatusEndpoint(t *testing.T) {
 	})
 }
 
+func TestContentFromEndpoint(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns empty ContentFromResponse if account has no transactions", func(t *testing.T) {
+		t.Parallel()
+
+		mockStore := newMockTxPoolStore()
+		mockStore.pending[types.Address{0x2}] = []*types.Transaction{newTestTransaction(1, types.Address{0x2})}
+		txPoolEndpoint := &TxPool{mockStore}
+
+		result, _ := txPoolEndpoint.ContentFrom(types.Address{0x1})
+		//nolint:forcetypeassert
+		response := result.(ContentFromResponse)
+
+		assert.Equal(t, 0, len(response.Pending))
+		assert.Equal(t, 0, len(response.Queued))
+	})
+
+	t.Run("returns only the transactions of the requested account", func(t *testing.T) {
+		t.Parallel()
+
+		mockStore := newMockTxPoolStore()
+		address1 := types.Address{0x1}
+		address2 := types.Address{0x2}
+		testTx1 := newTestTransaction(2, address1)
+		testTx2 := newTestDynamicFeeTransaction(5, address1)
+		testTx3 := newTestTransaction(2, address2)
+		mockStore.pending[address1] = []*types.Transaction{testTx1}
+		mockStore.queued[address1] = []*types.Transaction{testTx2}
+		mockStore.pending[address2] = []*types.Transaction{testTx3}
+		txPoolEndpoint := &TxPool{mockStore}
+
+		result, _ := txPoolEndpoint.ContentFrom(address1)
+		//nolint:forcetypeassert
+		response := result.(ContentFromResponse)
+
+		assert.Equal(t, 1, len(response.Pending))
+		assert.Equal(t, 1, len(response.Queued))
+		assert.Equal(t, testTx1.Hash, response.Pending[testTx1.Nonce].Hash)
+		assert.Equal(t, testTx2.Hash, response.Queued[testTx2.Nonce].Hash)
+		assert.Equal(t, address1, response.Queued[testTx2.Nonce].From)
+	})
+}
+
 type mockTxPoolStore struct {
 	pending       map[types.Address][]*types.Transaction
 	queued        map[types.Address][]*types.Transaction
@@ -286,6 +330,10 @@ func (s *mockTxPoolStore) GetTxs(inclQueued bool) (map[types.Address][]*types.Tr
 	return s.pending, s.queued
 }
 
+func (s *mockTxPoolStore) GetAccountTxs(addr types.Address) ([]*types.Transaction, []*types.Transaction) {
+	return s.pending[addr], s.queued[addr]
+}
+
 func (s *mockTxPoolStore) GetCapacity() (uint64, uint64) {
 	return s.capacity, s.maxSlots
 }
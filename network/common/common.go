@@ -13,6 +13,7 @@ import (
 type DialPriority uint64
 
 const (
+	PriorityStaticDial    DialPriority = 0
 	PriorityRequestedDial DialPriority = 1
 	PriorityRandomDial    DialPriority = 10
 )
@@ -20,6 +20,9 @@ type Config struct {
 	MaxOutboundPeers int64                  // the maximum number of outbound peer connections
 	Chain            *chain.Chain           // the reference to the chain configuration
 	SecretsManager   secrets.SecretsManager // the secrets manager used for key storage
+	StaticPeers      []string               // the libp2p addresses of peers the node always keeps connected
+	TrustedPeers     []string               // the libp2p addresses of peers that bypass the connection slot limits
+	PeerAllowlist    bool                   // flag indicating if inbound connections are limited to static and trusted peers
 }
 
 func DefaultConfig() *Config {
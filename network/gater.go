@@ -0,0 +1,58 @@
+package network
+
+import (
+	"github.com/libp2p/go-libp2p/core/control"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// connectionGater is the libp2p connection gater used by the networking server.
+// When the peer allowlist is enabled, inbound connections from peers
+// that are neither static nor trusted are rejected once the remote peer ID is known
+type connectionGater struct {
+	allowlistEnabled bool                // flag indicating if the peer allowlist is enforced
+	staticPeers      *staticPeersWrapper // reference to the static and trusted peer sets
+}
+
+// newConnectionGater returns a new instance of the connection gater
+func newConnectionGater(allowlistEnabled bool, staticPeers *staticPeersWrapper) *connectionGater {
+	return &connectionGater{
+		allowlistEnabled: allowlistEnabled,
+		staticPeers:      staticPeers,
+	}
+}
+
+// InterceptPeerDial tests whether we're permitted to Dial the specified peer
+func (g *connectionGater) InterceptPeerDial(_ peer.ID) bool {
+	return true
+}
+
+// InterceptAddrDial tests whether we're permitted to dial the specified multiaddr for the given peer
+func (g *connectionGater) InterceptAddrDial(_ peer.ID, _ multiaddr.Multiaddr) bool {
+	return true
+}
+
+// InterceptAccept tests whether an incipient inbound connection is allowed.
+// The remote peer ID is not known at this point, so the check is deferred to InterceptSecured
+func (g *connectionGater) InterceptAccept(_ network.ConnMultiaddrs) bool {
+	return true
+}
+
+// InterceptSecured tests whether a given connection, now authenticated, is allowed
+func (g *connectionGater) InterceptSecured(
+	direction network.Direction,
+	peerID peer.ID,
+	_ network.ConnMultiaddrs,
+) bool {
+	if !g.allowlistEnabled || direction != network.DirInbound {
+		return true
+	}
+
+	return g.staticPeers.isKnownPeer(peerID)
+}
+
+// InterceptUpgraded tests whether a fully capable connection is allowed
+func (g *connectionGater) InterceptUpgraded(_ network.Conn) (bool, control.DisconnectReason) {
+	return true, 0
+}
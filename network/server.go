@@ -56,6 +56,8 @@ const (
 var (
 	ErrNoBootnodes  = errors.New("no bootnodes specified")
 	ErrMinBootnodes = errors.New("minimum 1 bootnode is required")
+
+	ErrStaticPeerSelf = errors.New("static peer can not have the same ID as the host")
 )
 
 type Server struct {
@@ -88,6 +90,8 @@ type Server struct {
 	temporaryDials sync.Map // map of temporary connections; peerID -> bool
 
 	bootnodes *bootnodesWrapper // reference of all bootnodes for the node
+
+	staticPeers *staticPeersWrapper // reference of all static and trusted peers for the node
 }
 
 // NewServer returns a new instance of the networking server
@@ -118,12 +122,18 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 		return addrs
 	}
 
+	staticPeers, err := newStaticPeersWrapper(config.StaticPeers, config.TrustedPeers)
+	if err != nil {
+		return nil, err
+	}
+
 	host, err := libp2p.New(
 		// Use noise as the encryption protocol
 		libp2p.Security(noise.ID, noise.New),
 		libp2p.ListenAddrs(listenAddr),
 		libp2p.AddrsFactory(addrsFactory),
 		libp2p.Identity(key),
+		libp2p.ConnectionGater(newConnectionGater(config.PeerAllowlist, staticPeers)),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create libp2p stack: %w", err)
@@ -154,6 +164,7 @@ func NewServer(logger hclog.Logger, config *Config) (*Server, error) {
 			config.MaxInboundPeers,
 			config.MaxOutboundPeers,
 		),
+		staticPeers: staticPeers,
 	}
 
 	// start gossip protocol
@@ -176,6 +187,16 @@ func (s *Server) HasFreeConnectionSlot(direction network.Direction) bool {
 	return s.connectionCounts.HasFreeConnectionSlot(direction)
 }
 
+// IsTrustedPeer checks if the peer is a trusted peer, which bypasses the connection slot limits [Thread safe]
+func (s *Server) IsTrustedPeer(peerID peer.ID) bool {
+	return s.staticPeers.isTrustedPeer(peerID)
+}
+
+// IsStaticPeer checks if the peer is a static peer, which is always redialed on disconnect [Thread safe]
+func (s *Server) IsStaticPeer(peerID peer.ID) bool {
+	return s.staticPeers.isStaticPeer(peerID)
+}
+
 // PeerConnInfo holds the connection information about the peer
 type PeerConnInfo struct {
 	Info peer.AddrInfo
@@ -269,6 +290,14 @@ func (s *Server) Start() error {
 	go s.runDial()
 	go s.keepAliveMinimumPeerConnections()
 
+	// Dial the static and trusted peers right away,
+	// instead of waiting for the first keep alive tick
+	s.dialStaticPeers()
+
+	for _, trustedPeer := range s.staticPeers.getTrustedPeers() {
+		s.addToDialQueue(trustedPeer, common.PriorityStaticDial)
+	}
+
 	// watch for disconnected peers
 	s.host.Network().Notify(&network.NotifyBundle{
 		DisconnectedF: func(net network.Network, conn network.Conn) {
@@ -323,8 +352,21 @@ func (s *Server) setupBootnodes() error {
 	return nil
 }
 
+// dialStaticPeers adds all static peers that are not currently connected
+// to the dial queue, with a priority higher than any other dial
+func (s *Server) dialStaticPeers() {
+	for _, staticPeer := range s.staticPeers.getStaticPeers() {
+		if staticPeer.ID == s.host.ID() || s.hasPeer(staticPeer.ID) {
+			continue
+		}
+
+		s.addToDialQueue(staticPeer, common.PriorityStaticDial)
+	}
+}
+
 // keepAliveMinimumPeerConnections will attempt to make new connections
 // if the active peer count is lesser than the specified limit.
+// Static peers are always redialed first, regardless of the active peer count
 func (s *Server) keepAliveMinimumPeerConnections() {
 	for {
 		select {
@@ -333,6 +375,8 @@ func (s *Server) keepAliveMinimumPeerConnections() {
 			return
 		}
 
+		s.dialStaticPeers()
+
 		if s.numPeers() < MinimumPeerConnections {
 			if s.config.NoDiscover || !s.bootnodes.hasBootnodes() {
 				// dial unconnected peer
@@ -360,6 +404,12 @@ func (s *Server) runDial() {
 	defer cancel()
 
 	if err := s.Subscribe(ctx, func(event *peerEvent.PeerEvent) {
+		// Trusted peers are dialed without taking a slot,
+		// so there is nothing to give back for them
+		if s.IsTrustedPeer(event.PeerID) {
+			return
+		}
+
 		// Return back slot on PeerFailedToConnect or PeerDisconnected
 		switch event.Type {
 		case
@@ -398,10 +448,13 @@ func (s *Server) runDial() {
 				continue
 			}
 
-			s.logger.Debug("Waiting for a dialing slot", "addr", peerInfo, "local", s.host.ID())
+			// Trusted peers bypass the outbound slot limits
+			if !s.IsTrustedPeer(peerInfo.ID) {
+				s.logger.Debug("Waiting for a dialing slot", "addr", peerInfo, "local", s.host.ID())
 
-			if closed := slots.Take(ctx); closed {
-				return
+				if closed := slots.Take(ctx); closed {
+					return
+				}
 			}
 
 			// the connection process is async because it involves connection (here) +
@@ -568,6 +621,29 @@ func (s *Server) JoinPeer(rawPeerMultiaddr string) error {
 	return nil
 }
 
+// AddStaticPeer adds a new static peer to the networking server.
+// Static peers are dialed with the highest priority and redialed whenever they disconnect
+func (s *Server) AddStaticPeer(rawPeerMultiaddr string) error {
+	peerInfo, err := common.StringToAddrInfo(rawPeerMultiaddr)
+	if err != nil {
+		return err
+	}
+
+	if peerInfo.ID == s.host.ID() {
+		return ErrStaticPeerSelf
+	}
+
+	s.logger.Info("Static peer added", "addr", peerInfo)
+
+	s.staticPeers.addStaticPeer(peerInfo)
+
+	if !s.hasPeer(peerInfo.ID) {
+		s.addToDialQueue(peerInfo, common.PriorityStaticDial)
+	}
+
+	return nil
+}
+
 // joinPeer creates a new dial task for the peer (for async joining)
 func (s *Server) joinPeer(peerInfo *peer.AddrInfo) {
 	s.logger.Info("Join request", "addr", peerInfo)
@@ -18,6 +18,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConnLimit_Inbound(t *testing.T) {
@@ -1158,3 +1159,114 @@ func generateRandomPeers(t *testing.T, count int) ([]*randomPeer, error) {
 
 	return randomPeers, nil
 }
+
+func TestPeerAllowlist_RejectsUnknownPeers(t *testing.T) {
+	servers, createErr := createServers(2, nil)
+	if createErr != nil {
+		t.Fatalf("Unable to create servers, %v", createErr)
+	}
+
+	staticPeerAddr, err := common.AddrInfoToString(servers[1].AddrInfo())
+	require.NoError(t, err)
+
+	allowlistServer, createErr := CreateServer(&CreateServerParams{
+		ConfigCallback: func(c *Config) {
+			c.NoDiscover = true
+			c.PeerAllowlist = true
+			c.StaticPeers = []string{staticPeerAddr}
+		},
+	})
+	if createErr != nil {
+		t.Fatalf("Unable to create server, %v", createErr)
+	}
+
+	servers = append(servers, allowlistServer)
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	// Server 0 is neither static nor trusted, so the allowlist server rejects it
+	smallTimeout := time.Second * 5
+	if joinErr := JoinAndWait(servers[0], allowlistServer, smallTimeout, smallTimeout); joinErr == nil {
+		t.Fatal("Peer join should've failed", joinErr)
+	}
+
+	// Server 1 is a static peer of the allowlist server, so the connection is accepted
+	if joinErr := JoinAndWait(servers[1], allowlistServer, DefaultBufferTimeout, DefaultJoinTimeout); joinErr != nil {
+		t.Fatalf("Unable to join servers, %v", joinErr)
+	}
+
+	assert.True(t, allowlistServer.IsStaticPeer(servers[1].AddrInfo().ID))
+	assert.False(t, allowlistServer.IsStaticPeer(servers[0].AddrInfo().ID))
+}
+
+func TestTrustedPeer_BypassesSlotLimits(t *testing.T) {
+	servers, createErr := createServers(2, nil)
+	if createErr != nil {
+		t.Fatalf("Unable to create servers, %v", createErr)
+	}
+
+	trustedPeerAddr, err := common.AddrInfoToString(servers[1].AddrInfo())
+	require.NoError(t, err)
+
+	limitedServer, createErr := CreateServer(&CreateServerParams{
+		ConfigCallback: func(c *Config) {
+			c.NoDiscover = true
+			c.MaxInboundPeers = 1
+			c.MaxOutboundPeers = 1
+			c.TrustedPeers = []string{trustedPeerAddr}
+		},
+	})
+	if createErr != nil {
+		t.Fatalf("Unable to create server, %v", createErr)
+	}
+
+	servers = append(servers, limitedServer)
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	// Server 0 takes the only inbound slot
+	if joinErr := JoinAndWait(servers[0], limitedServer, DefaultBufferTimeout, DefaultJoinTimeout); joinErr != nil {
+		t.Fatalf("Unable to join servers, %v", joinErr)
+	}
+
+	// Server 1 is trusted, so it is accepted even though there are no free slots
+	if joinErr := JoinAndWait(servers[1], limitedServer, DefaultBufferTimeout, DefaultJoinTimeout); joinErr != nil {
+		t.Fatalf("Unable to join servers, %v", joinErr)
+	}
+
+	assert.Equal(t, int64(2), limitedServer.numPeers())
+}
+
+func TestAddStaticPeer(t *testing.T) {
+	servers, createErr := createServers(2, map[int]*CreateServerParams{
+		0: {ConfigCallback: func(c *Config) { c.NoDiscover = true }},
+		1: {ConfigCallback: func(c *Config) { c.NoDiscover = true }},
+	})
+	if createErr != nil {
+		t.Fatalf("Unable to create servers, %v", createErr)
+	}
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	selfAddr, err := common.AddrInfoToString(servers[0].AddrInfo())
+	require.NoError(t, err)
+	assert.ErrorIs(t, servers[0].AddStaticPeer(selfAddr), ErrStaticPeerSelf)
+
+	staticPeerAddr, err := common.AddrInfoToString(servers[1].AddrInfo())
+	require.NoError(t, err)
+
+	connectCtx, cancelFn := context.WithTimeout(context.Background(), DefaultJoinTimeout)
+	defer cancelFn()
+
+	require.NoError(t, servers[0].AddStaticPeer(staticPeerAddr))
+
+	_, connectErr := WaitUntilPeerConnectsTo(connectCtx, servers[0], servers[1].AddrInfo().ID)
+	require.NoError(t, connectErr)
+	assert.True(t, servers[0].IsStaticPeer(servers[1].AddrInfo().ID))
+}
@@ -0,0 +1,115 @@
+package network
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/network/common"
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// staticPeersWrapper keeps track of the peers the node always keeps
+// a connection to (static) and the peers that bypass the connection limits (trusted)
+type staticPeersWrapper struct {
+	sync.RWMutex
+
+	// staticPeers is a map of the static peers that get redialed on disconnect
+	staticPeers map[peer.ID]*peer.AddrInfo
+
+	// trustedPeers is a map of the trusted peers that are not subject to slot limits
+	trustedPeers map[peer.ID]*peer.AddrInfo
+}
+
+// newStaticPeersWrapper parses the raw static and trusted peer addresses
+// and returns a new staticPeersWrapper instance
+func newStaticPeersWrapper(rawStaticPeers, rawTrustedPeers []string) (*staticPeersWrapper, error) {
+	spw := &staticPeersWrapper{
+		staticPeers:  make(map[peer.ID]*peer.AddrInfo),
+		trustedPeers: make(map[peer.ID]*peer.AddrInfo),
+	}
+
+	for _, rawAddr := range rawStaticPeers {
+		staticPeer, err := common.StringToAddrInfo(rawAddr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse static peer %s: %w", rawAddr, err)
+		}
+
+		spw.staticPeers[staticPeer.ID] = staticPeer
+	}
+
+	for _, rawAddr := range rawTrustedPeers {
+		trustedPeer, err := common.StringToAddrInfo(rawAddr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse trusted peer %s: %w", rawAddr, err)
+		}
+
+		spw.trustedPeers[trustedPeer.ID] = trustedPeer
+	}
+
+	return spw, nil
+}
+
+// addStaticPeer adds the peer to the static peer set [Thread safe]
+func (spw *staticPeersWrapper) addStaticPeer(peerInfo *peer.AddrInfo) {
+	spw.Lock()
+	defer spw.Unlock()
+
+	spw.staticPeers[peerInfo.ID] = peerInfo
+}
+
+// isStaticPeer checks if the peer ID belongs to a static peer [Thread safe]
+func (spw *staticPeersWrapper) isStaticPeer(peerID peer.ID) bool {
+	spw.RLock()
+	defer spw.RUnlock()
+
+	_, ok := spw.staticPeers[peerID]
+
+	return ok
+}
+
+// isTrustedPeer checks if the peer ID belongs to a trusted peer [Thread safe]
+func (spw *staticPeersWrapper) isTrustedPeer(peerID peer.ID) bool {
+	spw.RLock()
+	defer spw.RUnlock()
+
+	_, ok := spw.trustedPeers[peerID]
+
+	return ok
+}
+
+// isKnownPeer checks if the peer ID belongs to either a static or a trusted peer [Thread safe]
+func (spw *staticPeersWrapper) isKnownPeer(peerID peer.ID) bool {
+	spw.RLock()
+	defer spw.RUnlock()
+
+	_, isStatic := spw.staticPeers[peerID]
+	_, isTrusted := spw.trustedPeers[peerID]
+
+	return isStatic || isTrusted
+}
+
+// getStaticPeers returns a copy of the static peer set [Thread safe]
+func (spw *staticPeersWrapper) getStaticPeers() []*peer.AddrInfo {
+	spw.RLock()
+	defer spw.RUnlock()
+
+	staticPeers := make([]*peer.AddrInfo, 0, len(spw.staticPeers))
+	for _, staticPeer := range spw.staticPeers {
+		staticPeers = append(staticPeers, staticPeer)
+	}
+
+	return staticPeers
+}
+
+// getTrustedPeers returns a copy of the trusted peer set [Thread safe]
+func (spw *staticPeersWrapper) getTrustedPeers() []*peer.AddrInfo {
+	spw.RLock()
+	defer spw.RUnlock()
+
+	trustedPeers := make([]*peer.AddrInfo, 0, len(spw.trustedPeers))
+	for _, trustedPeer := range spw.trustedPeers {
+		trustedPeers = append(trustedPeers, trustedPeer)
+	}
+
+	return trustedPeers
+}
@@ -30,6 +30,7 @@ type MockNetworkingServer struct {
 	emitEventFn              emitEventDelegate
 	isTemporaryDialFn        isTemporaryDialDelegate
 	hasFreeConnectionSlotFn  hasFreeConnectionSlotDelegate
+	isTrustedPeerFn          isTrustedPeerDelegate
 
 	// Discovery Hooks
 	newDiscoveryClientFn       newDiscoveryClientDelegate
@@ -74,6 +75,7 @@ type updatePendingConnCountDelegate func(int64, network.Direction)
 type emitEventDelegate func(*event.PeerEvent)
 type isTemporaryDialDelegate func(peer.ID) bool
 type hasFreeConnectionSlotDelegate func(network.Direction) bool
+type isTrustedPeerDelegate func(peer.ID) bool
 
 // Required for Discovery
 type getRandomBootnodeDelegate func() *peer.AddrInfo
@@ -174,6 +176,18 @@ func (m *MockNetworkingServer) HookHasFreeConnectionSlot(fn hasFreeConnectionSlo
 	m.hasFreeConnectionSlotFn = fn
 }
 
+func (m *MockNetworkingServer) IsTrustedPeer(peerID peer.ID) bool {
+	if m.isTrustedPeerFn != nil {
+		return m.isTrustedPeerFn(peerID)
+	}
+
+	return false
+}
+
+func (m *MockNetworkingServer) HookIsTrustedPeer(fn isTrustedPeerDelegate) {
+	m.isTrustedPeerFn = fn
+}
+
 func (m *MockNetworkingServer) GetRandomBootnode() *peer.AddrInfo {
 	if m.getRandomBootnodeFn != nil {
 		return m.getRandomBootnodeFn()
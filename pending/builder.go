@@ -0,0 +1,221 @@
+package pending
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/consensus"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/txpool"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// DefaultRebuildInterval is the default interval in which the pending block is reused,
+// it is rebuilt on the next request afterwards in order to pick up newly promoted transactions
+const DefaultRebuildInterval = time.Second
+
+// maxRetainedStates is the number of the recently built pending block states which are kept,
+// so the requests which resolved a pending block can read its state after it is rebuilt
+const maxRetainedStates = 4
+
+// Blockchain is the interface representing blockchain
+type Blockchain interface {
+	Header() *types.Header
+	CalculateGasLimit(number uint64) (uint64, error)
+	CalculateBaseFee(parent *types.Header) uint64
+}
+
+// TxPool is the interface representing the transaction pool
+type TxPool interface {
+	Pending() *txpool.PendingTxs
+}
+
+// Builder keeps a speculative pending block, built lazily from the txpool transactions
+// on top of the head state, so the nodes which are never asked for it never build it
+type Builder struct {
+	logger     hclog.Logger
+	blockchain Blockchain
+	txPool     TxPool
+	executor   *state.Executor
+
+	// stateStorage is the storage of the head state, the pending state
+	// is kept in memory on top of it and never written to it
+	stateStorage itrie.Storage
+
+	// interval in which the pending block is reused before it is rebuilt
+	interval time.Duration
+
+	// block is the latest built pending block and builtAt is the time it was built at
+	block   *types.Block
+	builtAt time.Time
+
+	// states are the states of the recently built pending blocks by their state roots,
+	// stateRoots keeps the roots in the order the blocks were built in
+	states     map[types.Hash]state.State
+	stateRoots []types.Hash
+
+	lock sync.Mutex
+}
+
+// NewBuilder creates a new pending block builder
+func NewBuilder(
+	logger hclog.Logger,
+	blockchain Blockchain,
+	txPool TxPool,
+	executor *state.Executor,
+	stateStorage itrie.Storage,
+	interval time.Duration,
+) *Builder {
+	if interval == 0 {
+		interval = DefaultRebuildInterval
+	}
+
+	return &Builder{
+		logger:       logger.Named("pending"),
+		blockchain:   blockchain,
+		txPool:       txPool,
+		executor:     executor,
+		stateStorage: stateStorage,
+		interval:     interval,
+		states:       make(map[types.Hash]state.State, maxRetainedStates),
+	}
+}
+
+// Block returns the pending block built on top of the current head. The block is rebuilt
+// if the head has moved or the rebuild interval has elapsed since it was built,
+// nil is returned if it can't be built [Thread safe]
+func (b *Builder) Block() *types.Block {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	head := b.blockchain.Header()
+	if head == nil {
+		return nil
+	}
+
+	if b.block != nil && b.block.ParentHash() == head.Hash && time.Since(b.builtAt) < b.interval {
+		return b.block
+	}
+
+	block, pendingState, err := b.Build()
+	if err != nil {
+		b.logger.Debug("failed to build pending block", "err", err)
+
+		return nil
+	}
+
+	b.block = block
+	b.builtAt = time.Now()
+	b.retainState(block.Header.StateRoot, pendingState)
+
+	return block
+}
+
+// StateAt returns the state of a recently built pending block
+// with the given state root, if it is still kept [Thread safe]
+func (b *Builder) StateAt(root types.Hash) (state.State, bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	pendingState, ok := b.states[root]
+
+	return pendingState, ok
+}
+
+// retainState keeps the state of the built pending block and drops the oldest kept state
+// once there are more than maxRetainedStates of them
+func (b *Builder) retainState(root types.Hash, pendingState state.State) {
+	if _, ok := b.states[root]; !ok {
+		b.stateRoots = append(b.stateRoots, root)
+	}
+
+	b.states[root] = pendingState
+
+	if len(b.stateRoots) > maxRetainedStates {
+		delete(b.states, b.stateRoots[0])
+		b.stateRoots = b.stateRoots[1:]
+	}
+}
+
+// Build executes the pending transactions on top of the current head
+// and returns the resulting block and its state. The state is kept in memory on top of
+// the head state, so the speculative trie nodes are never written to the state storage
+func (b *Builder) Build() (*types.Block, state.State, error) {
+	parent := b.blockchain.Header()
+	if parent == nil {
+		return nil, nil, fmt.Errorf("head not found")
+	}
+
+	gasLimit, err := b.blockchain.CalculateGasLimit(parent.Number + 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	timestamp := uint64(time.Now().UTC().Unix())
+	if timestamp < parent.Timestamp {
+		timestamp = parent.Timestamp
+	}
+
+	header := &types.Header{
+		ParentHash: parent.Hash,
+		Number:     parent.Number + 1,
+		Miner:      parent.Miner,
+		Difficulty: parent.Difficulty,
+		GasLimit:   gasLimit,
+		BaseFee:    b.blockchain.CalculateBaseFee(parent),
+		Timestamp:  timestamp,
+	}
+
+	pendingState := itrie.NewState(itrie.NewOverlayStorage(b.stateStorage))
+
+	transition, err := b.executor.WithState(pendingState).BeginTxn(
+		parent.StateRoot, header, types.BytesToAddress(parent.Miner))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txs := make([]*types.Transaction, 0)
+	pendingTxs := b.txPool.Pending()
+
+	for tx := pendingTxs.Peek(); tx != nil; tx = pendingTxs.Peek() {
+		if tx.Gas > gasLimit {
+			pendingTxs.Drop(tx)
+
+			continue
+		}
+
+		if err := transition.Write(tx); err != nil {
+			if _, ok := err.(*state.GasLimitReachedTransitionApplicationError); ok { //nolint:errorlint
+				break
+			}
+
+			// the rest of the account transactions can't be executed either
+			pendingTxs.Drop(tx)
+
+			continue
+		}
+
+		pendingTxs.Pop(tx)
+
+		txs = append(txs, tx)
+	}
+
+	_, stateRoot, err := transition.Commit()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to commit the state changes: %w", err)
+	}
+
+	header.StateRoot = stateRoot
+	header.GasUsed = transition.TotalGas()
+	header.LogsBloom = types.CreateBloom(transition.Receipts())
+
+	return consensus.BuildBlock(consensus.BuildBlockParams{
+		Header:   header,
+		Txns:     txs,
+		Receipts: transition.Receipts(),
+	}), pendingState, nil
+}
@@ -0,0 +1,254 @@
+package pending
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/txpool"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	testChainID  = 100
+	testGasLimit = 10_000_000
+)
+
+func TestBuilder_Build(t *testing.T) {
+	t.Parallel()
+
+	keys := make([]*ecdsa.PrivateKey, 2)
+	for i := range keys {
+		key, err := crypto.GenerateECDSAKey()
+		require.NoError(t, err)
+
+		keys[i] = key
+	}
+
+	executor, storage, head := newTestExecutor(t, keys...)
+	chainMock := &blockchainMock{header: head}
+	pool := newTestTxPool(t, head)
+
+	signer := crypto.NewSigner(chain.AllForksEnabled.At(0), testChainID)
+	pool.SetSigner(signer)
+
+	receiver := types.StringToAddress("0xff")
+	addTx := func(key *ecdsa.PrivateKey, nonce uint64) {
+		tx, err := signer.SignTx(&types.Transaction{
+			Value:    big.NewInt(1_000),
+			GasPrice: big.NewInt(1),
+			Gas:      21_000,
+			Nonce:    nonce,
+			To:       &receiver,
+		}, key)
+		require.NoError(t, err)
+
+		require.NoError(t, pool.AddTx(context.Background(), tx))
+	}
+
+	addTx(keys[0], 0)
+	addTx(keys[0], 1)
+	addTx(keys[1], 0)
+
+	require.Eventually(t, func() bool {
+		return pool.Length() == 3
+	}, time.Second, 10*time.Millisecond)
+
+	builder := NewBuilder(hclog.NewNullLogger(), chainMock, pool, executor, storage, 0)
+
+	block, pendingState, err := builder.Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, head.Number+1, block.Number())
+	assert.Equal(t, head.Hash, block.ParentHash())
+	assert.Len(t, block.Transactions, 3)
+	assert.Equal(t, uint64(3*21_000), block.Header.GasUsed)
+
+	// pending state has the transactions applied
+	snap, err := pendingState.NewSnapshotAt(block.Header.StateRoot)
+	require.NoError(t, err)
+
+	receiverAcc, err := snap.GetAccount(receiver)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(3_000), receiverAcc.Balance)
+
+	senderAcc, err := snap.GetAccount(crypto.PubKeyToAddress(&keys[0].PublicKey))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), senderAcc.Nonce)
+
+	// pool is not modified by building the pending block
+	assert.Equal(t, uint64(3), pool.Length())
+
+	// the pending state is not written to the state storage
+	_, err = executor.StateAt(block.Header.StateRoot)
+	assert.Error(t, err)
+}
+
+func TestBuilder_Block(t *testing.T) {
+	t.Parallel()
+
+	executor, storage, head := newTestExecutor(t)
+	chainMock := &blockchainMock{header: head}
+	pool := newTestTxPool(t, head)
+
+	builder := NewBuilder(hclog.NewNullLogger(), chainMock, pool, executor, storage, time.Hour)
+
+	// built on the first request
+	block := builder.Block()
+	require.NotNil(t, block)
+	assert.Equal(t, head.Number+1, block.Number())
+	assert.Empty(t, block.Transactions)
+	assert.Equal(t, head.StateRoot, block.Header.StateRoot)
+
+	// reused within the rebuild interval
+	assert.Same(t, block, builder.Block())
+
+	pendingState, ok := builder.StateAt(block.Header.StateRoot)
+	require.True(t, ok)
+	assert.NotNil(t, pendingState)
+
+	// head moved, pending block is rebuilt on top of it
+	newHead := head.Copy()
+	newHead.Number++
+	newHead.ComputeHash()
+	chainMock.header = newHead
+
+	block = builder.Block()
+	require.NotNil(t, block)
+	assert.Equal(t, newHead.Number+1, block.Number())
+	assert.Equal(t, newHead.Hash, block.ParentHash())
+}
+
+func TestBuilder_StateAt(t *testing.T) {
+	t.Parallel()
+
+	executor, storage, head := newTestExecutor(t)
+	builder := NewBuilder(hclog.NewNullLogger(), &blockchainMock{header: head}, nil, executor, storage, 0)
+
+	_, ok := builder.StateAt(head.StateRoot)
+	assert.False(t, ok)
+
+	roots := make([]types.Hash, maxRetainedStates+1)
+	for i := range roots {
+		roots[i] = types.BytesToHash(common.EncodeUint64ToBytes(uint64(i + 1)))
+		builder.retainState(roots[i], itrie.NewState(itrie.NewMemoryStorage()))
+	}
+
+	// the oldest state is dropped, the recent ones are kept after the pending block is rebuilt
+	_, ok = builder.StateAt(roots[0])
+	assert.False(t, ok)
+
+	for _, root := range roots[1:] {
+		_, ok = builder.StateAt(root)
+		assert.True(t, ok)
+	}
+}
+
+// newTestExecutor creates an executor with the given accounts funded in genesis
+// and returns it along with its state storage and the genesis header
+func newTestExecutor(t *testing.T, keys ...*ecdsa.PrivateKey) (*state.Executor, itrie.Storage, *types.Header) {
+	t.Helper()
+
+	storage := itrie.NewMemoryStorage()
+	params := &chain.Params{ChainID: testChainID, Forks: chain.AllForksEnabled}
+	executor := state.NewExecutor(params, itrie.NewState(storage), hclog.NewNullLogger())
+	executor.GetHash = func(*types.Header) func(uint64) types.Hash {
+		return func(i uint64) types.Hash {
+			return types.BytesToHash(common.EncodeUint64ToBytes(i))
+		}
+	}
+
+	alloc := map[types.Address]*chain.GenesisAccount{}
+	for _, key := range keys {
+		alloc[crypto.PubKeyToAddress(&key.PublicKey)] = &chain.GenesisAccount{Balance: ethgo.Ether(1)}
+	}
+
+	root, err := executor.WriteGenesis(alloc, types.ZeroHash)
+	require.NoError(t, err)
+
+	head := &types.Header{
+		Number:    0,
+		StateRoot: root,
+		GasLimit:  testGasLimit,
+	}
+	head.ComputeHash()
+
+	return executor, storage, head
+}
+
+// newTestTxPool creates and starts a tx pool on top of the given head
+func newTestTxPool(t *testing.T, head *types.Header) *txpool.TxPool {
+	t.Helper()
+
+	pool, err := txpool.NewTxPool(
+		hclog.NewNullLogger(),
+		chain.AllForksEnabled,
+		&txPoolStoreMock{header: head},
+		nil,
+		nil,
+		&txpool.Config{
+			PriceLimit:         1,
+			MaxSlots:           4096,
+			MaxAccountEnqueued: 128,
+			ChainID:            big.NewInt(testChainID),
+		},
+	)
+	require.NoError(t, err)
+
+	pool.Start()
+	t.Cleanup(pool.Close)
+
+	return pool
+}
+
+type blockchainMock struct {
+	header *types.Header
+}
+
+func (b *blockchainMock) Header() *types.Header {
+	return b.header
+}
+
+func (b *blockchainMock) CalculateGasLimit(uint64) (uint64, error) {
+	return testGasLimit, nil
+}
+
+func (b *blockchainMock) CalculateBaseFee(*types.Header) uint64 {
+	return 0
+}
+
+type txPoolStoreMock struct {
+	header *types.Header
+}
+
+func (m *txPoolStoreMock) Header() *types.Header {
+	return m.header
+}
+
+func (m *txPoolStoreMock) GetNonce(types.Hash, types.Address) uint64 {
+	return 0
+}
+
+func (m *txPoolStoreMock) GetBalance(types.Hash, types.Address) (*big.Int, error) {
+	return ethgo.Ether(1), nil
+}
+
+func (m *txPoolStoreMock) GetBlockByHash(types.Hash, bool) (*types.Block, bool) {
+	return nil, false
+}
+
+func (m *txPoolStoreMock) CalculateBaseFee(*types.Header) uint64 {
+	return 0
+}
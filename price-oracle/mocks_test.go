@@ -101,6 +101,14 @@ func (m *MockPolybftBackend) GetValidators(
 	return accSet, args.Error(1)
 }
 
+func (m *MockPolybftBackend) ValidatorKey() *wallet.Key {
+	args := m.Called()
+
+	key, _ := args.Get(0).(*wallet.Key)
+
+	return key
+}
+
 // MockTxRelayer is a mock implementation of the TxRelayer interface
 type MockTxRelayer struct {
 	mock.Mock
@@ -165,9 +173,9 @@ type MockStateProvider struct {
 
 func (m *MockStateProvider) GetPriceOracleState(
 	header *types.Header,
-	validatorAccount *wallet.Account,
+	validatorKey *wallet.Key,
 ) (PriceOracleState, error) {
-	args := m.Called(header, validatorAccount)
+	args := m.Called(header, validatorKey)
 	state, ok := args.Get(0).(PriceOracleState)
 	if !ok {
 		panic("Expected PriceOracleState but got a different type")
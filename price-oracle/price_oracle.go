@@ -55,6 +55,8 @@ type blockchainBackend interface {
 type polybftBackend interface {
 	// GetValidators retrieves validator set for the given block
 	GetValidators(blockNumber uint64, parents []*types.Header) (validator.AccountSet, error)
+	// ValidatorKey returns the key the validator signs with (local or remote)
+	ValidatorKey() *wallet.Key
 }
 
 type PriceOracle struct {
@@ -64,8 +66,8 @@ type PriceOracle struct {
 	blockchain     blockchainBackend
 	polybftBackend polybftBackend
 	stateProvider  PriceOracleStateProvider
-	// key encapsulates ECDSA signing account
-	account   *wallet.Account
+	// key encapsulates the validator signer
+	key       *wallet.Key
 	priceFeed PriceFeed
 	txRelayer txrelayer.TxRelayer
 }
@@ -76,7 +78,6 @@ func NewPriceOracle(
 	executor *state.Executor,
 	consensus consensus.Consensus,
 	jsonRPC string,
-	secretsManager secrets.SecretsManager,
 	secretsManagerConfig *secrets.SecretsManagerConfig,
 ) (*PriceOracle, error) {
 	priceFeed, err := NewPriceFeed(secretsManagerConfig)
@@ -89,12 +90,6 @@ func NewPriceOracle(
 		return nil, fmt.Errorf("consensus must be hydragon")
 	}
 
-	// read account
-	account, err := wallet.NewAccountFromSecret(secretsManager)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read account data. Error: %w", err)
-	}
-
 	blockchainBackend := polybft.NewBlockchainBackend(executor, blockchain)
 
 	txRelayer, err := getVoteTxRelayer(jsonRPC)
@@ -109,7 +104,7 @@ func NewPriceOracle(
 		priceFeed:      priceFeed,
 		polybftBackend: polybftConsensus,
 		txRelayer:      txRelayer,
-		account:        account,
+		key:            polybftConsensus.ValidatorKey(),
 		closeCh:        make(chan struct{}),
 	}, nil
 }
@@ -193,7 +188,7 @@ func (p *PriceOracle) shouldExecuteVote(header *types.Header) (bool, error) {
 	}
 
 	// initialize the system state for the given header
-	state, err := p.stateProvider.GetPriceOracleState(header, p.account)
+	state, err := p.stateProvider.GetPriceOracleState(header, p.key)
 	if err != nil {
 		return false, fmt.Errorf("get system state: %w", err)
 	}
@@ -228,7 +223,7 @@ func (p *PriceOracle) isValidator(block *types.Header) (bool, error) {
 		)
 	}
 
-	return currentValidators.ContainsNodeID(p.account.Address().String()), nil
+	return currentValidators.ContainsNodeID(p.key.String()), nil
 }
 
 // 1. Skip checking older blocks to ensure bulk synchronization remains fast.
@@ -274,13 +269,13 @@ func (p *PriceOracle) vote(price *big.Int) error {
 	}
 
 	txn := &ethgo.Transaction{
-		From:  p.account.Ecdsa.Address(),
+		From:  p.key.Address(),
 		Input: input,
 		To:    (*ethgo.Address)(&contracts.PriceOracleContract),
 		Gas:   1000000,
 	}
 
-	receipt, err := p.txRelayer.SendTransaction(txn, p.account.Ecdsa)
+	receipt, err := p.txRelayer.SendTransaction(txn, wallet.NewEcdsaSigner(p.key))
 	if err != nil {
 		return err
 	}
@@ -80,7 +80,7 @@ func TestIsValidator(t *testing.T) {
 
 			priceOracle := &PriceOracle{
 				polybftBackend: mockPolybftBackend,
-				account:        tt.account,
+				key:            wallet.NewKey(tt.account),
 			}
 
 			isValidator, err := priceOracle.isValidator(tt.block)
@@ -312,12 +312,12 @@ func TestCalcDayNumber(t *testing.T) {
 func TestShouldExecuteVote(t *testing.T) {
 	mockState := new(MockState)
 	mockStateProvider := new(MockStateProvider)
-	mockAccount := &wallet.Account{}
+	mockKey := wallet.NewKey(&wallet.Account{})
 
 	txRelayer, _ := getVoteTxRelayer("0.0.0.0:8545")
 
 	priceOracle := &PriceOracle{
-		account:       mockAccount,
+		key:           mockKey,
 		txRelayer:     txRelayer,
 		logger:        hclog.NewNullLogger(),
 		stateProvider: mockStateProvider, // Inject the mock state provider
@@ -399,7 +399,7 @@ func TestShouldExecuteVote(t *testing.T) {
 
 			if tt.shouldMockState {
 				// Mock the GetPriceOracleState and shouldVote methods
-				mockStateProvider.On("GetPriceOracleState", tt.header, mockAccount).
+				mockStateProvider.On("GetPriceOracleState", tt.header, mockKey).
 					Return(mockState, nil).
 					Once()
 				mockState.On("shouldVote", dayNumber).
@@ -429,7 +429,7 @@ func TestVote(t *testing.T) {
 	mockTxRelayer := new(MockTxRelayer)
 	account := validator.NewTestValidator(t, "X", 1000).Account
 	priceOracle := &PriceOracle{
-		account:   account,
+		key:       wallet.NewKey(account),
 		txRelayer: mockTxRelayer,
 		logger:    hclog.NewNullLogger(),
 	}
@@ -458,7 +458,7 @@ func TestVote(t *testing.T) {
 			},
 		},
 	}
-	mockTxRelayer.On("SendTransaction", mock.Anything, account.Ecdsa).Return(receipt, nil)
+	mockTxRelayer.On("SendTransaction", mock.Anything, wallet.NewEcdsaSigner(priceOracle.key)).Return(receipt, nil)
 
 	// Call the vote function
 	err = priceOracle.vote(expectedPrice)
@@ -467,7 +467,7 @@ func TestVote(t *testing.T) {
 	require.NoError(t, err)
 
 	// Assert that the transaction was sent as expected
-	mockTxRelayer.AssertCalled(t, "SendTransaction", mock.Anything, account.Ecdsa)
+	mockTxRelayer.AssertCalled(t, "SendTransaction", mock.Anything, wallet.NewEcdsaSigner(priceOracle.key))
 
 	// Validate that the logs were parsed correctly (you might need to mock ParseLog if it's more complex)
 	// Ensure that log parsing logic works correctly
@@ -507,7 +507,7 @@ func TestVote_NegativeScenarios(t *testing.T) {
 	mockTxRelayer := new(MockTxRelayer)
 	account := validator.NewTestValidator(t, "X", 1000).Account
 	priceOracle := &PriceOracle{
-		account:   account,
+		key:       wallet.NewKey(account),
 		txRelayer: mockTxRelayer,
 	}
 
@@ -564,7 +564,7 @@ func TestVote_NegativeScenarios(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Mock the SendTransaction to return the mock receipt and error
-			mockTxRelayer.On("SendTransaction", mock.Anything, account.Ecdsa).
+			mockTxRelayer.On("SendTransaction", mock.Anything, wallet.NewEcdsaSigner(priceOracle.key)).
 				Return(tt.mockReceipt, tt.mockError).
 				Once()
 
@@ -576,7 +576,7 @@ func TestVote_NegativeScenarios(t *testing.T) {
 			require.Contains(t, err.Error(), tt.expectedError)
 
 			// Assert that the transaction was sent as expected
-			mockTxRelayer.AssertCalled(t, "SendTransaction", mock.Anything, account.Ecdsa)
+			mockTxRelayer.AssertCalled(t, "SendTransaction", mock.Anything, wallet.NewEcdsaSigner(priceOracle.key))
 
 			// Assert that the mocks were called as expected
 			mockTxRelayer.AssertExpectations(t)
@@ -589,7 +589,7 @@ func TestExecuteVote(t *testing.T) {
 	mockTxRelayer := new(MockTxRelayer)
 	account := validator.NewTestValidator(t, "X", 1000).Account
 	priceOracle := &PriceOracle{
-		account:   account,
+		key:       wallet.NewKey(account),
 		txRelayer: mockTxRelayer,
 		priceFeed: mockPriceFeed,
 		logger:    hclog.NewNullLogger(),
@@ -623,7 +623,7 @@ func TestExecuteVote(t *testing.T) {
 			},
 		},
 	}
-	mockTxRelayer.On("SendTransaction", mock.Anything, account.Ecdsa).Return(receipt, nil)
+	mockTxRelayer.On("SendTransaction", mock.Anything, wallet.NewEcdsaSigner(priceOracle.key)).Return(receipt, nil)
 
 	// Call the executeVote method
 	err = priceOracle.executeVote(header)
@@ -632,7 +632,7 @@ func TestExecuteVote(t *testing.T) {
 	require.NoError(t, err)
 
 	// Assert that the transaction was sent as expected
-	mockTxRelayer.AssertCalled(t, "SendTransaction", mock.Anything, account.Ecdsa)
+	mockTxRelayer.AssertCalled(t, "SendTransaction", mock.Anything, wallet.NewEcdsaSigner(priceOracle.key))
 
 	foundVoteLog := false
 	// Validate that the logs were parsed correctly (using the conversion)
@@ -677,7 +677,7 @@ func TestExecuteVote_PriceFeedError(t *testing.T) {
 	mockPriceFeed := new(MockPriceFeed)
 	account := validator.NewTestValidator(t, "X", 1000).Account
 	priceOracle := &PriceOracle{
-		account:   account,
+		key:       wallet.NewKey(account),
 		txRelayer: new(MockTxRelayer), // No need to mock TxRelayer for this test
 		priceFeed: mockPriceFeed,
 	}
@@ -704,7 +704,7 @@ func TestExecuteVote_VoteError(t *testing.T) {
 	mockTxRelayer := new(MockTxRelayer)
 	account := validator.NewTestValidator(t, "X", 1000).Account
 	priceOracle := &PriceOracle{
-		account:   account,
+		key:       wallet.NewKey(account),
 		txRelayer: mockTxRelayer,
 		priceFeed: mockPriceFeed,
 	}
@@ -716,7 +716,7 @@ func TestExecuteVote_VoteError(t *testing.T) {
 	mockPriceFeed.On("GetPrice", header).Return(expectedPrice, nil)
 
 	// Mock the SendTransaction to return an error
-	mockTxRelayer.On("SendTransaction", mock.Anything, account.Ecdsa).
+	mockTxRelayer.On("SendTransaction", mock.Anything, wallet.NewEcdsaSigner(priceOracle.key)).
 		Return((*ethgo.Receipt)(nil), errors.New("vote error"))
 
 	// Call the executeVote method
@@ -29,14 +29,14 @@ func newPriceOracleState(
 	systemState polybft.SystemState,
 	priceOracleAddr types.Address,
 	provider contract.Provider,
-	validatorAccount *wallet.Account,
+	validatorKey *wallet.Key,
 ) PriceOracleState {
 	s := &priceOracleState{systemState, nil}
 
 	s.priceOracleContract = contract.NewContract(
 		ethgo.Address(priceOracleAddr),
 		contractsapi.PriceOracle.Abi, contract.WithProvider(provider),
-		contract.WithSender(validatorAccount.Ecdsa),
+		contract.WithSender(wallet.NewEcdsaSigner(validatorKey)),
 	)
 
 	return s
@@ -70,7 +70,7 @@ func (p priceOracleState) shouldVote(
 type PriceOracleStateProvider interface {
 	GetPriceOracleState(
 		header *types.Header,
-		validatorAccount *wallet.Account,
+		validatorKey *wallet.Key,
 	) (PriceOracleState, error)
 }
 
@@ -84,12 +84,12 @@ func NewPriceOracleStateProvider(blockchain blockchainBackend) PriceOracleStateP
 
 func (p priceOracleStateProvider) GetPriceOracleState(
 	header *types.Header,
-	validatorAccount *wallet.Account,
+	validatorKey *wallet.Key,
 ) (PriceOracleState, error) {
 	provider, err := p.blockchain.GetStateProviderForBlock(header)
 	if err != nil {
 		return nil, err
 	}
 
-	return newPriceOracleState(p.blockchain.GetSystemState(provider), contracts.PriceOracleContract, provider, validatorAccount), nil
+	return newPriceOracleState(p.blockchain.GetSystemState(provider), contracts.PriceOracleContract, provider, validatorKey), nil
 }
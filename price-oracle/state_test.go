@@ -0,0 +1,55 @@
+package priceoracle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/contract"
+
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/contractsapi"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+	"github.com/0xPolygon/polygon-edge/contracts"
+)
+
+var _ contract.Provider = (*callProviderMock)(nil)
+
+// callProviderMock returns the given output for the calls and records their sender
+type callProviderMock struct {
+	output []byte
+	from   ethgo.Address
+}
+
+func (c *callProviderMock) Call(_ ethgo.Address, _ []byte, opts *contract.CallOpts) ([]byte, error) {
+	c.from = opts.From
+
+	return c.output, nil
+}
+
+func (c *callProviderMock) Txn(ethgo.Address, ethgo.Key, []byte) (contract.Txn, error) {
+	return nil, nil
+}
+
+func TestPriceOracleState_ShouldVote(t *testing.T) {
+	t.Parallel()
+
+	account, err := wallet.GenerateAccount()
+	require.NoError(t, err)
+
+	key := wallet.NewKey(account)
+
+	output, err := contractsapi.PriceOracle.Abi.GetMethod("shouldVote").Outputs.Encode(
+		map[string]interface{}{"0": false, "1": "ALREADY_VOTED"},
+	)
+	require.NoError(t, err)
+
+	provider := &callProviderMock{output: output}
+	state := newPriceOracleState(nil, contracts.PriceOracleContract, provider, key)
+
+	// the validator key is the sender of the calls, which don't sign anything
+	shouldVote, reason, err := state.shouldVote(1)
+	require.NoError(t, err)
+	require.False(t, shouldVote)
+	require.Equal(t, "ALREADY_VOTED", reason)
+	require.Equal(t, key.Address(), provider.from)
+}
@@ -0,0 +1,157 @@
+package encryptedlocal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+var (
+	ErrWrongPassword       = errors.New("the password does not decrypt the stored secrets")
+	errNoEncryptedSecrets  = errors.New("there are no encrypted secrets stored")
+	errBackupAlreadyExists = errors.New("the backup directory already exists")
+)
+
+// Unlock sets the password the secrets are decrypted with, once it is checked to decrypt all of them
+func (esm *EncryptedLocalSecretsManager) Unlock(password []byte) error {
+	for _, name := range esm.EncryptedSecrets() {
+		value, err := esm.LocalSecretsManager.GetSecret(name)
+		if err != nil {
+			return err
+		}
+
+		if _, err := esm.encryption.Decrypt(value, password); err != nil {
+			return fmt.Errorf("%w: %s", ErrWrongPassword, name)
+		}
+	}
+
+	esm.pwd = password
+
+	return nil
+}
+
+// ChangePassword re-encrypts all the stored secrets with the new password and returns their names.
+// The current files are copied to the backup directory first. The re-encrypted secrets are written
+// next to them and replace them only once all of them have been written, and the replaced files
+// are restored from the backup if any replacement fails
+func (esm *EncryptedLocalSecretsManager) ChangePassword(newPassword []byte, backupDir string) ([]string, error) {
+	if !verifyPassword(string(newPassword)) {
+		return nil, ErrInvalidPassword
+	}
+
+	names := esm.EncryptedSecrets()
+	if len(names) == 0 {
+		return nil, errNoEncryptedSecrets
+	}
+
+	if _, err := os.Stat(backupDir); err == nil {
+		return nil, fmt.Errorf("%w: %s", errBackupAlreadyExists, backupDir)
+	}
+
+	// decrypt all the secrets up front, so that nothing is written if the current password is wrong
+	plainValues := make([][]byte, len(names))
+
+	for i, name := range names {
+		value, err := esm.GetSecret(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt %s: %w", name, err)
+		}
+
+		plainValues[i] = value
+	}
+
+	paths := make([]string, len(names))
+	backups := make([][]byte, len(names))
+
+	for i, name := range names {
+		path, err := esm.SecretPath(name)
+		if err != nil {
+			return nil, err
+		}
+
+		if backups[i], err = os.ReadFile(path); err != nil {
+			return nil, err
+		}
+
+		// keep the directory layout of the data dir, e.g. <backup>/consensus/validator.key
+		backupPath := filepath.Join(backupDir, filepath.Base(filepath.Dir(path)), filepath.Base(path))
+		if err := os.MkdirAll(filepath.Dir(backupPath), 0700); err != nil {
+			return nil, err
+		}
+
+		if err := os.WriteFile(backupPath, backups[i], 0400); err != nil {
+			return nil, fmt.Errorf("failed to back up %s: %w", name, err)
+		}
+
+		paths[i] = path
+	}
+
+	for i, value := range plainValues {
+		encrypted, err := esm.encryption.Encrypt(value, newPassword)
+		if err != nil {
+			removeTempFiles(paths)
+
+			return nil, err
+		}
+
+		if err := os.WriteFile(tempPath(paths[i]), encrypted, 0440); err != nil {
+			removeTempFiles(paths)
+
+			return nil, err
+		}
+	}
+
+	for i, path := range paths {
+		if err := os.Rename(tempPath(path), path); err != nil {
+			removeTempFiles(paths)
+
+			if restoreErr := restoreFiles(paths[:i], backups[:i]); restoreErr != nil {
+				return nil, fmt.Errorf("%w (restoring the backup failed: %v)", err, restoreErr)
+			}
+
+			return nil, err
+		}
+	}
+
+	esm.pwd = newPassword
+
+	return names, nil
+}
+
+// VerifyBackup checks that the backup of the secret shown when the secret was created, its raw hex value
+// or its mnemonic, restores the key the stored secret decrypts to
+func (esm *EncryptedLocalSecretsManager) VerifyBackup(name string, backup string) (bool, error) {
+	value, err := esm.GetSecret(name)
+	if err != nil {
+		return false, err
+	}
+
+	return matchesBackup(name, value, backup), nil
+}
+
+func tempPath(path string) string {
+	return path + ".new"
+}
+
+func removeTempFiles(paths []string) {
+	for _, path := range paths {
+		_ = os.Remove(tempPath(path))
+	}
+}
+
+// restoreFiles writes the backed up content over the replaced files.
+// The secret files are read-only, so they are replaced rather than written to
+func restoreFiles(paths []string, backups [][]byte) error {
+	for i, path := range paths {
+		if err := os.WriteFile(tempPath(path), backups[i], 0440); err != nil {
+			return err
+		}
+
+		if err := os.Rename(tempPath(path), path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package encryptedlocal
 
 import (
 	"errors"
+	"sort"
 
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/secrets/local"
@@ -16,13 +17,24 @@ type EncryptedLocalSecretsManager struct {
 	*local.LocalSecretsManager
 	encryption Encryption
 	pwd        []byte
+	source     *passwordSource
 }
 
 // SecretsManagerFactory implements the factory method
 func SecretsManagerFactory(
-	_ *secrets.SecretsManagerConfig,
+	config *secrets.SecretsManagerConfig,
 	params *secrets.SecretsManagerParams,
 ) (secrets.SecretsManager, error) {
+	var configExtra map[string]interface{}
+	if config != nil {
+		configExtra = config.Extra
+	}
+
+	source, err := newPasswordSource(configExtra, params.Extra)
+	if err != nil {
+		return nil, err
+	}
+
 	baseSM, err := local.SecretsManagerFactory(
 		nil, // Local secrets manager doesn't require a config
 		params)
@@ -44,6 +56,7 @@ func SecretsManagerFactory(
 		localSM,
 		encryption,
 		nil,
+		source,
 	}
 
 	return esm, nil
@@ -86,9 +99,20 @@ func (esm *EncryptedLocalSecretsManager) GetSecret(name string) ([]byte, error)
 type AdditionalHandlerFunc func(esm *EncryptedLocalSecretsManager, name string, value []byte) ([]byte, error)
 
 var onSetHandlers = map[string]AdditionalHandlerFunc{
-	secrets.NetworkKey:      baseOnSetHandler,
-	secrets.ValidatorBLSKey: baseOnSetHandler,
-	secrets.ValidatorKey:    baseOnSetHandler,
+	secrets.NetworkKey:              baseOnSetHandler,
+	secrets.ValidatorBLSKey:         baseOnSetHandler,
+	secrets.ValidatorKey:            baseOnSetHandler,
+	secrets.ValidatorBLSKeyPrevious: encryptOnSetHandler,
+	secrets.ValidatorBLSKeyPending:  encryptOnSetHandler,
+	secrets.JSONRPCJWTSecret:        encryptOnSetHandler,
+	secrets.JSONRPCAPIKeys:          encryptOnSetHandler,
+}
+
+// backedUpSecrets are the secrets whose raw value is shown to be backed up when they are set (baseOnSetHandler)
+var backedUpSecrets = map[string]struct{}{
+	secrets.NetworkKey:      {},
+	secrets.ValidatorBLSKey: {},
+	secrets.ValidatorKey:    {},
 }
 
 func baseOnSetHandler(
@@ -101,15 +125,21 @@ func baseOnSetHandler(
 		name,
 		string(value),
 	)
+
+	// the mnemonic is an easier to write down backup of the same key
+	if mnemonic, err := secretMnemonic(name, value); err == nil {
+		esm.logger.Info("It can be backed up as a mnemonic as well.", "mnemonic", mnemonic)
+	}
+
 	confirmValue, err := esm.prompt.DefaultPrompt(
-		`Please re-type the secret key value (present above, after the "=") to confirm that you have backed it up in a safe location.`,
+		`Please re-type the secret key value (present above, after the "=") or its mnemonic to confirm that you have backed it up in a safe location.`,
 		"",
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	if confirmValue != string(value) {
+	if !matchesBackup(name, value, confirmValue) {
 		esm.logger.Error(
 			"The secret value you entered does not match the original value. Please try again.",
 		)
@@ -118,14 +148,12 @@ func baseOnSetHandler(
 		esm.logger.Info("The secret value you entered matches the original value. Continuing.")
 	}
 
-	if esm.pwd == nil || len(esm.pwd) == 0 {
-		esm.pwd, err = esm.prompt.GeneratePassword()
-		if err != nil {
-			return nil, err
-		}
+	pwd, err := esm.password(!esm.hasEncryptedSecrets())
+	if err != nil {
+		return nil, err
 	}
 
-	encryptedValue, err := esm.encryption.Encrypt(value, esm.pwd)
+	encryptedValue, err := esm.encryption.Encrypt(value, pwd)
 	if err != nil {
 		return nil, err
 	}
@@ -133,10 +161,28 @@ func baseOnSetHandler(
 	return encryptedValue, nil
 }
 
+// encryptOnSetHandler encrypts a secret which has already been backed up by the user
+func encryptOnSetHandler(
+	esm *EncryptedLocalSecretsManager,
+	name string,
+	value []byte,
+) ([]byte, error) {
+	pwd, err := esm.password(!esm.hasEncryptedSecrets())
+	if err != nil {
+		return nil, err
+	}
+
+	return esm.encryption.Encrypt(value, pwd)
+}
+
 var onGetHandlers = map[string]AdditionalHandlerFunc{
-	secrets.NetworkKey:      baseOnGetHandler,
-	secrets.ValidatorBLSKey: baseOnGetHandler,
-	secrets.ValidatorKey:    baseOnGetHandler,
+	secrets.NetworkKey:              baseOnGetHandler,
+	secrets.ValidatorBLSKey:         baseOnGetHandler,
+	secrets.ValidatorKey:            baseOnGetHandler,
+	secrets.ValidatorBLSKeyPrevious: baseOnGetHandler,
+	secrets.ValidatorBLSKeyPending:  baseOnGetHandler,
+	secrets.JSONRPCJWTSecret:        baseOnGetHandler,
+	secrets.JSONRPCAPIKeys:          baseOnGetHandler,
 }
 
 func baseOnGetHandler(
@@ -144,13 +190,75 @@ func baseOnGetHandler(
 	name string,
 	value []byte,
 ) ([]byte, error) {
-	if esm.pwd == nil || len(esm.pwd) == 0 {
-		var err error
-		esm.pwd, err = esm.prompt.InputPassword(false)
-		if err != nil {
-			return nil, err
+	pwd, err := esm.password(false)
+	if err != nil {
+		return nil, err
+	}
+
+	return esm.encryption.Decrypt(value, pwd)
+}
+
+// password returns the password the secrets are encrypted with. It is read from the configured
+// unlock source, or prompted if there is none. A new password has to meet the strength requirements
+func (esm *EncryptedLocalSecretsManager) password(isNew bool) ([]byte, error) {
+	if len(esm.pwd) > 0 {
+		return esm.pwd, nil
+	}
+
+	pwd, ok, err := esm.source.read()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case ok && isNew && !verifyPassword(string(pwd)):
+		return nil, ErrInvalidPassword
+	case ok:
+	case isNew:
+		pwd, err = esm.prompt.GeneratePassword()
+	default:
+		pwd, err = esm.prompt.InputPassword(false)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	esm.pwd = pwd
+
+	return pwd, nil
+}
+
+// hasEncryptedSecrets checks if any of the secrets encrypted with the password is stored
+func (esm *EncryptedLocalSecretsManager) hasEncryptedSecrets() bool {
+	return len(esm.EncryptedSecrets()) > 0
+}
+
+// EncryptedSecrets returns the names of the stored secrets which are encrypted with the password
+func (esm *EncryptedLocalSecretsManager) EncryptedSecrets() []string {
+	names := make([]string, 0, len(onGetHandlers))
+
+	for name := range onGetHandlers {
+		// the local secrets manager checks the presence of the file only
+		if esm.LocalSecretsManager.HasSecret(name) {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// BackedUpSecrets returns the names of the stored secrets whose values were shown to be backed up when they were set
+func (esm *EncryptedLocalSecretsManager) BackedUpSecrets() []string {
+	names := make([]string, 0, len(backedUpSecrets))
+
+	for _, name := range esm.EncryptedSecrets() {
+		if _, ok := backedUpSecrets[name]; ok {
+			names = append(names, name)
 		}
 	}
 
-	return esm.encryption.Decrypt(value, esm.pwd)
+	return names
 }
@@ -0,0 +1,252 @@
+package encryptedlocal
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	libp2pCrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/secrets"
+)
+
+const (
+	testPassword    = "Passw0rd!"
+	testNewPassword = "N3wPassw0rd!"
+)
+
+// testEncryption prefixes the data with the password, since the scrypt parameters
+// of the real encryption are too expensive for the unit tests
+type testEncryption struct{}
+
+func (testEncryption) Encrypt(data []byte, pwd []byte) ([]byte, error) {
+	return append(append(append([]byte{}, pwd...), ':'), data...), nil
+}
+
+func (testEncryption) Decrypt(data []byte, pwd []byte) ([]byte, error) {
+	prefix := append(append([]byte{}, pwd...), ':')
+	if !bytes.HasPrefix(data, prefix) {
+		return nil, errors.New("message authentication failed")
+	}
+
+	return data[len(prefix):], nil
+}
+
+func newTestSecretsManager(t *testing.T, dir string, extra map[string]interface{}) *EncryptedLocalSecretsManager {
+	t.Helper()
+
+	params := map[string]interface{}{secrets.Path: dir}
+	for k, v := range extra {
+		params[k] = v
+	}
+
+	sm, err := SecretsManagerFactory(nil, &secrets.SecretsManagerParams{
+		Logger: hclog.NewNullLogger(),
+		Extra:  params,
+	})
+	require.NoError(t, err)
+
+	esm, ok := sm.(*EncryptedLocalSecretsManager)
+	require.True(t, ok)
+
+	esm.encryption = testEncryption{}
+
+	return esm
+}
+
+// storeTestSecret stores the value encrypted with the password, bypassing the backup confirmation prompt
+func storeTestSecret(t *testing.T, esm *EncryptedLocalSecretsManager, name, value, password string) {
+	t.Helper()
+
+	encrypted, err := esm.encryption.Encrypt([]byte(value), []byte(password))
+	require.NoError(t, err)
+	require.NoError(t, esm.LocalSecretsManager.SetSecret(name, encrypted))
+}
+
+func TestEncryptedLocal_PasswordSources(t *testing.T) {
+	dir := t.TempDir()
+	storeTestSecret(t, newTestSecretsManager(t, dir, nil), secrets.ValidatorKey, "abcd", testPassword)
+
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, os.WriteFile(passwordFile, []byte(testPassword+"\n"), 0600))
+
+	t.Run("file", func(t *testing.T) {
+		esm := newTestSecretsManager(t, dir, map[string]interface{}{secrets.PasswordFile: passwordFile})
+
+		value, err := esm.GetSecret(secrets.ValidatorKey)
+		require.NoError(t, err)
+		require.Equal(t, "abcd", string(value))
+	})
+
+	t.Run("environment variable", func(t *testing.T) {
+		t.Setenv("TEST_SECRETS_PASSWORD", testPassword)
+
+		esm := newTestSecretsManager(t, dir, map[string]interface{}{secrets.PasswordEnv: "TEST_SECRETS_PASSWORD"})
+
+		value, err := esm.GetSecret(secrets.ValidatorKey)
+		require.NoError(t, err)
+		require.Equal(t, "abcd", string(value))
+	})
+
+	t.Run("default environment variable", func(t *testing.T) {
+		t.Setenv(DefaultPasswordEnv, testPassword)
+
+		value, err := newTestSecretsManager(t, dir, nil).GetSecret(secrets.ValidatorKey)
+		require.NoError(t, err)
+		require.Equal(t, "abcd", string(value))
+	})
+
+	t.Run("file descriptor", func(t *testing.T) {
+		r, w, err := os.Pipe()
+		require.NoError(t, err)
+
+		defer r.Close()
+
+		_, err = w.WriteString(testPassword)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		// the secrets manager closes the descriptor it reads from
+		fd, err := syscall.Dup(int(r.Fd()))
+		require.NoError(t, err)
+
+		esm := newTestSecretsManager(t, dir, map[string]interface{}{secrets.PasswordFD: strconv.Itoa(fd)})
+
+		value, err := esm.GetSecret(secrets.ValidatorKey)
+		require.NoError(t, err)
+		require.Equal(t, "abcd", string(value))
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		t.Setenv(DefaultPasswordEnv, "wrong")
+
+		_, err := newTestSecretsManager(t, dir, nil).GetSecret(secrets.ValidatorKey)
+		require.Error(t, err)
+	})
+
+	t.Run("invalid file descriptor", func(t *testing.T) {
+		_, err := SecretsManagerFactory(nil, &secrets.SecretsManagerParams{
+			Logger: hclog.NewNullLogger(),
+			Extra:  map[string]interface{}{secrets.Path: dir, secrets.PasswordFD: "stdin"},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestEncryptedLocal_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	esm := newTestSecretsManager(t, dir, nil)
+
+	storeTestSecret(t, esm, secrets.ValidatorKey, "ecdsa", testPassword)
+	storeTestSecret(t, esm, secrets.ValidatorBLSKey, "bls", testPassword)
+	require.ElementsMatch(t, []string{secrets.ValidatorKey, secrets.ValidatorBLSKey}, esm.EncryptedSecrets())
+
+	// the secrets stored after the init are not shown to be backed up
+	storeTestSecret(t, esm, secrets.ValidatorBLSKeyPrevious, "previous bls", testPassword)
+	require.ElementsMatch(t, []string{secrets.ValidatorKey, secrets.ValidatorBLSKey}, esm.BackedUpSecrets())
+
+	require.ErrorIs(t, esm.Unlock([]byte("wrong")), ErrWrongPassword)
+	require.NoError(t, esm.Unlock([]byte(testPassword)))
+
+	backupDir := filepath.Join(t.TempDir(), "backup")
+
+	_, err := esm.ChangePassword([]byte("weak"), backupDir)
+	require.ErrorIs(t, err, ErrInvalidPassword)
+
+	oldValidatorKey, err := os.ReadFile(filepath.Join(dir, secrets.ConsensusFolderLocal, secrets.ValidatorKeyLocal))
+	require.NoError(t, err)
+
+	changed, err := esm.ChangePassword([]byte(testNewPassword), backupDir)
+	require.NoError(t, err)
+	require.ElementsMatch(t,
+		[]string{secrets.ValidatorKey, secrets.ValidatorBLSKey, secrets.ValidatorBLSKeyPrevious}, changed)
+
+	// the backup holds the secrets encrypted with the old password
+	backup, err := os.ReadFile(filepath.Join(backupDir, secrets.ConsensusFolderLocal, secrets.ValidatorKeyLocal))
+	require.NoError(t, err)
+	require.Equal(t, oldValidatorKey, backup)
+
+	// the secrets are encrypted with the new password
+	reopened := newTestSecretsManager(t, dir, nil)
+	require.ErrorIs(t, reopened.Unlock([]byte(testPassword)), ErrWrongPassword)
+	require.NoError(t, reopened.Unlock([]byte(testNewPassword)))
+
+	ok, err := reopened.VerifyBackup(secrets.ValidatorKey, "ecdsa\n")
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = reopened.VerifyBackup(secrets.ValidatorBLSKey, "ecdsa")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// an existing backup is never overwritten
+	_, err = esm.ChangePassword([]byte(testPassword+"1"), backupDir)
+	require.ErrorIs(t, err, errBackupAlreadyExists)
+
+	matches, err := filepath.Glob(filepath.Join(dir, "*", "*.new"))
+	require.NoError(t, err)
+	require.Empty(t, matches)
+}
+
+func TestEncryptedLocal_VerifyBackupMnemonic(t *testing.T) {
+	t.Parallel()
+
+	networkKey, _, err := libp2pCrypto.GenerateKeyPair(libp2pCrypto.Secp256k1, 256)
+	require.NoError(t, err)
+
+	networkKeyRaw, err := libp2pCrypto.MarshalPrivateKey(networkKey)
+	require.NoError(t, err)
+
+	values := map[string]string{
+		secrets.ValidatorKey: "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d",
+		// the BLS key is encoded without its leading zeros
+		secrets.ValidatorBLSKey: "a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9",
+		secrets.NetworkKey:      hex.EncodeToString(networkKeyRaw),
+	}
+
+	esm := newTestSecretsManager(t, t.TempDir(), nil)
+
+	for name, value := range values {
+		storeTestSecret(t, esm, name, value, testPassword)
+	}
+
+	require.NoError(t, esm.Unlock([]byte(testPassword)))
+
+	for name, value := range values {
+		mnemonic, err := secretMnemonic(name, []byte(value))
+		require.NoError(t, err)
+		require.Len(t, strings.Fields(mnemonic), 24)
+
+		for _, backup := range []string{value, mnemonic, " " + strings.ToUpper(mnemonic) + "\n"} {
+			ok, err := esm.VerifyBackup(name, backup)
+			require.NoError(t, err)
+			require.True(t, ok, name)
+		}
+
+		// the words in another order fail the checksum or restore another key
+		words := strings.Fields(mnemonic)
+		words[0], words[1] = words[1], words[0]
+
+		ok, err := esm.VerifyBackup(name, strings.Join(words, " "))
+		require.NoError(t, err)
+		require.False(t, ok, name)
+	}
+
+	// the mnemonic of a key doesn't restore another one
+	mnemonic, err := secretMnemonic(secrets.ValidatorKey, []byte(values[secrets.ValidatorKey]))
+	require.NoError(t, err)
+
+	ok, err := esm.VerifyBackup(secrets.ValidatorBLSKey, mnemonic)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
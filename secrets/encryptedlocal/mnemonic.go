@@ -0,0 +1,100 @@
+package encryptedlocal
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	libp2pCrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/tyler-smith/go-bip39"
+
+	"github.com/0xPolygon/polygon-edge/secrets"
+)
+
+// privateKeyLength is the length of the private keys of the backed up secrets,
+// which are encoded to a 24 words mnemonic
+const privateKeyLength = 32
+
+var errNoMnemonic = errors.New("the secret has no mnemonic")
+
+// secretMnemonic returns the BIP-39 mnemonic of the private key stored in the secret.
+// It restores the same key as the raw hex value of the secret
+func secretMnemonic(name string, value []byte) (string, error) {
+	key, err := privateKey(name, value)
+	if err != nil {
+		return "", err
+	}
+
+	return bip39.NewMnemonic(key)
+}
+
+// privateKey returns the private key stored in the raw hex value of the secret
+func privateKey(name string, value []byte) ([]byte, error) {
+	var key []byte
+
+	switch name {
+	case secrets.ValidatorKey:
+		decoded, err := hex.DecodeString(string(value))
+		if err != nil {
+			return nil, err
+		}
+
+		key = decoded
+	case secrets.ValidatorBLSKey:
+		// the BLS key is the hex of a scalar, without its leading zeros
+		scalar, ok := new(big.Int).SetString(string(value), 16)
+		if !ok || scalar.BitLen() > 8*privateKeyLength {
+			return nil, fmt.Errorf("invalid BLS key")
+		}
+
+		key = scalar.FillBytes(make([]byte, privateKeyLength))
+	case secrets.NetworkKey:
+		// the network key is the hex of the libp2p encoding of a secp256k1 key
+		decoded, err := hex.DecodeString(string(value))
+		if err != nil {
+			return nil, err
+		}
+
+		libp2pKey, err := libp2pCrypto.UnmarshalPrivateKey(decoded)
+		if err != nil {
+			return nil, err
+		}
+
+		if key, err = libp2pKey.Raw(); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errNoMnemonic
+	}
+
+	if len(key) != privateKeyLength {
+		return nil, fmt.Errorf("invalid private key length %d", len(key))
+	}
+
+	return key, nil
+}
+
+// matchesBackup checks that the backup of the secret, its raw hex value or its mnemonic,
+// restores the stored value
+func matchesBackup(name string, value []byte, backup string) bool {
+	words := strings.Fields(strings.ToLower(backup))
+	if len(words) <= 1 {
+		return strings.TrimSpace(backup) == strings.TrimSpace(string(value))
+	}
+
+	key, err := privateKey(name, value)
+	if err != nil {
+		return false
+	}
+
+	// the checksum of the mnemonic is checked as well
+	restored, err := bip39.EntropyFromMnemonic(strings.Join(words, " "))
+	if err != nil {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare(restored, key) == 1
+}
@@ -0,0 +1,100 @@
+package encryptedlocal
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/0xPolygon/polygon-edge/secrets"
+)
+
+// DefaultPasswordEnv is the environment variable the password is read from
+// if no other unlock source is configured
+const DefaultPasswordEnv = "HYDRA_SECRETS_PASSWORD"
+
+var errEmptyPassword = errors.New("the password read from the unlock source is empty")
+
+// passwordSource reads the password without a terminal, so that a node using the encrypted
+// local secrets can be restarted unattended, e.g. by systemd or Kubernetes
+type passwordSource struct {
+	file string
+	env  string
+	fd   int
+}
+
+// newPasswordSource creates the password source from the secrets manager extra parameters.
+// The config file parameters take precedence over the runtime ones
+func newPasswordSource(extras ...map[string]interface{}) (*passwordSource, error) {
+	source := &passwordSource{env: DefaultPasswordEnv, fd: -1}
+
+	for i := len(extras) - 1; i >= 0; i-- {
+		extra := extras[i]
+
+		if file, ok := extra[secrets.PasswordFile]; ok {
+			source.file = fmt.Sprint(file)
+		}
+
+		if env, ok := extra[secrets.PasswordEnv]; ok {
+			source.env = fmt.Sprint(env)
+		}
+
+		if fd, ok := extra[secrets.PasswordFD]; ok {
+			parsed, err := strconv.Atoi(fmt.Sprint(fd))
+			if err != nil || parsed < 0 {
+				return nil, fmt.Errorf("invalid %s parameter: %v", secrets.PasswordFD, fd)
+			}
+
+			source.fd = parsed
+		}
+	}
+
+	return source, nil
+}
+
+// read returns the password from the first configured source: the file, the file descriptor
+// and the environment variable. It returns false if none of them provides a password
+func (s *passwordSource) read() ([]byte, bool, error) {
+	var (
+		raw []byte
+		err error
+	)
+
+	switch {
+	case s.file != "":
+		if raw, err = os.ReadFile(s.file); err != nil {
+			return nil, false, fmt.Errorf("failed to read the password file: %w", err)
+		}
+	case s.fd >= 0:
+		file := os.NewFile(uintptr(s.fd), "password-fd")
+		if file == nil {
+			return nil, false, fmt.Errorf("invalid password file descriptor %d", s.fd)
+		}
+
+		raw, err = io.ReadAll(file)
+		_ = file.Close()
+
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to read the password file descriptor: %w", err)
+		}
+
+		// the descriptor can be read only once
+		s.fd = -1
+	default:
+		value, ok := os.LookupEnv(s.env)
+		if !ok {
+			return nil, false, nil
+		}
+
+		raw = []byte(value)
+	}
+
+	password := strings.TrimRight(string(raw), "\r\n")
+	if password == "" {
+		return nil, false, errEmptyPassword
+	}
+
+	return []byte(password), true, nil
+}
@@ -319,6 +319,22 @@ func InitValidatorBLSSignature(
 	return sb, nil
 }
 
+// ReplaceSecret overwrites the secret if it is present. The local secrets managers refuse to
+// overwrite a secret and forget its path on removal, so the manager is set up again in between
+func ReplaceSecret(secretsManager secrets.SecretsManager, name string, value []byte) error {
+	if secretsManager.HasSecret(name) {
+		if err := secretsManager.RemoveSecret(name); err != nil {
+			return err
+		}
+
+		if err := secretsManager.Setup(); err != nil {
+			return err
+		}
+	}
+
+	return secretsManager.SetSecret(name, value)
+}
+
 // LoadBLSSignature loads BLS Signature from SecretsManager and returns it
 func LoadBLSSignature(secretsManager secrets.SecretsManager) (string, error) {
 	if !secretsManager.HasSecret(secrets.ValidatorBLSSignature) {
@@ -63,7 +63,7 @@ func (l *LocalSecretsManager) Setup() error {
 	l.secretPathMapLock.Lock()
 	defer l.secretPathMapLock.Unlock()
 
-	subDirectories := []string{secrets.ConsensusFolderLocal, secrets.NetworkFolderLocal}
+	subDirectories := []string{secrets.ConsensusFolderLocal, secrets.NetworkFolderLocal, secrets.JSONRPCFolderLocal}
 
 	// Set up the local directories
 	if err := common.SetupDataDir(l.path, subDirectories, 0770); err != nil {
@@ -84,6 +84,20 @@ func (l *LocalSecretsManager) Setup() error {
 		secrets.ValidatorBLSKeyLocal,
 	)
 
+	// baseDir/consensus/validator-bls-previous.key
+	l.secretPathMap[secrets.ValidatorBLSKeyPrevious] = filepath.Join(
+		l.path,
+		secrets.ConsensusFolderLocal,
+		secrets.ValidatorBLSKeyPreviousLocal,
+	)
+
+	// baseDir/consensus/validator-bls-pending.key
+	l.secretPathMap[secrets.ValidatorBLSKeyPending] = filepath.Join(
+		l.path,
+		secrets.ConsensusFolderLocal,
+		secrets.ValidatorBLSKeyPendingLocal,
+	)
+
 	// baseDir/consensus/validator.sig
 	l.secretPathMap[secrets.ValidatorBLSSignature] = filepath.Join(
 		l.path,
@@ -98,9 +112,36 @@ func (l *LocalSecretsManager) Setup() error {
 		secrets.NetworkKeyLocal,
 	)
 
+	// baseDir/jsonrpc/jwt.hex
+	l.secretPathMap[secrets.JSONRPCJWTSecret] = filepath.Join(
+		l.path,
+		secrets.JSONRPCFolderLocal,
+		secrets.JSONRPCJWTSecretLocal,
+	)
+
+	// baseDir/jsonrpc/api-keys.json
+	l.secretPathMap[secrets.JSONRPCAPIKeys] = filepath.Join(
+		l.path,
+		secrets.JSONRPCFolderLocal,
+		secrets.JSONRPCAPIKeysLocal,
+	)
+
 	return nil
 }
 
+// SecretPath returns the path of the file the secret is stored in
+func (l *LocalSecretsManager) SecretPath(name string) (string, error) {
+	l.secretPathMapLock.RLock()
+	defer l.secretPathMapLock.RUnlock()
+
+	secretPath, ok := l.secretPathMap[name]
+	if !ok {
+		return "", secrets.ErrSecretNotFound
+	}
+
+	return secretPath, nil
+}
+
 // GetSecret gets the local SecretsManager's secret from disk
 func (l *LocalSecretsManager) GetSecret(name string) ([]byte, error) {
 	l.secretPathMapLock.RLock()
@@ -19,6 +19,16 @@ const (
 
 	// Name is the name of the current node
 	Name = "name"
+
+	// PasswordFile is the path to the file holding the password of the encrypted local secrets
+	PasswordFile = "password-file"
+
+	// PasswordEnv is the name of the environment variable holding the password of the encrypted local secrets
+	PasswordEnv = "password-env"
+
+	// PasswordFD is the file descriptor the password of the encrypted local secrets is read from,
+	// opened by the process supervisor
+	PasswordFD = "password-fd"
 )
 
 // Define constant names for available secrets
@@ -29,6 +39,14 @@ const (
 	// ValidatorBLSKey is the bls secret key of the validator node
 	ValidatorBLSKey = "validator-bls-private-key"
 
+	// ValidatorBLSKeyPrevious is the bls secret key replaced by the last key rotation,
+	// kept until the rotated key takes effect
+	ValidatorBLSKeyPrevious = "validator-bls-private-key-previous"
+
+	// ValidatorBLSKeyPending is the bls secret key of a key rotation whose transaction
+	// is not confirmed yet
+	ValidatorBLSKeyPending = "validator-bls-private-key-pending"
+
 	// NetworkKey is the libp2p private key secret used for networking
 	NetworkKey = "network-private-key"
 
@@ -37,20 +55,31 @@ const (
 
 	// CoinGeckoAPIKey is the API key for the coingecko endpoints
 	CoinGeckoAPIKey = "coingecko-api-key"
+
+	// JSONRPCJWTSecret is the hex encoded secret the JWTs of the JSON-RPC clients are signed with
+	JSONRPCJWTSecret = "jsonrpc-jwt-secret"
+
+	// JSONRPCAPIKeys is the JSON object mapping the API keys of the JSON-RPC clients to their tiers
+	JSONRPCAPIKeys = "jsonrpc-api-keys"
 )
 
 // Define constant file names for the local StorageManager
 const (
-	ValidatorKeyLocal          = "validator.key"
-	ValidatorBLSKeyLocal       = "validator-bls.key"
-	NetworkKeyLocal            = "libp2p.key"
-	ValidatorBLSSignatureLocal = "validator.sig"
+	ValidatorKeyLocal            = "validator.key"
+	ValidatorBLSKeyLocal         = "validator-bls.key"
+	ValidatorBLSKeyPreviousLocal = "validator-bls-previous.key"
+	ValidatorBLSKeyPendingLocal  = "validator-bls-pending.key"
+	NetworkKeyLocal              = "libp2p.key"
+	ValidatorBLSSignatureLocal   = "validator.sig"
+	JSONRPCJWTSecretLocal        = "jwt.hex"
+	JSONRPCAPIKeysLocal          = "api-keys.json"
 )
 
 // Define constant folder names for the local StorageManager
 const (
 	ConsensusFolderLocal = "consensus"
 	NetworkFolderLocal   = "libp2p"
+	JSONRPCFolderLocal   = "jsonrpc"
 )
 
 var (
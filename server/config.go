@@ -6,7 +6,12 @@ import (
 
 	"github.com/hashicorp/go-hclog"
 
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/dbengine"
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet/remote"
+	"github.com/0xPolygon/polygon-edge/helper/profiling"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
+	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 )
@@ -18,8 +23,15 @@ const DefaultJSONRPCPort int = 8545
 type Config struct {
 	Chain *chain.Chain
 
-	JSONRPC    *JSONRPC
-	GRPCAddr   *net.TCPAddr
+	JSONRPC *JSONRPC
+
+	// IPCPath is the path of the unix socket which serves the JSON-RPC and the gRPC admin services
+	IPCPath string
+
+	// GRPCAddr is the optional tcp address of the gRPC admin services, which requires mutual TLS
+	GRPCAddr *net.TCPAddr
+	GRPCTLS  *GRPCTLS
+
 	LibP2PAddr *net.TCPAddr
 
 	PriceLimit         uint64
@@ -30,12 +42,16 @@ type Config struct {
 	Network   *network.Config
 
 	DataDir     string
+	DBEngine    dbengine.Engine
 	RestoreFile *string
 
 	Seal bool
 
 	SecretsManager *secrets.SecretsManagerConfig
 
+	// RemoteSigner is the configuration of the remote signing service holding the validator keys
+	RemoteSigner *remote.Config
+
 	LogLevel hclog.Level
 
 	JSONLogFormat bool
@@ -48,9 +64,25 @@ type Config struct {
 	MetricsInterval       time.Duration
 }
 
+// GRPCTLS holds the certificate of the tcp gRPC listener and the CA of its clients
+type GRPCTLS struct {
+	CertFile     string
+	KeyFile      string
+	ClientCAFile string
+}
+
 // Telemetry holds the config details for metric services
 type Telemetry struct {
 	PrometheusAddr *net.TCPAddr
+
+	// PprofAddr is the loopback address of the pprof endpoints, they aren't served if it's nil
+	PprofAddr *net.TCPAddr
+
+	// Profiling is the configuration of the automatic profile capture, the profiles are captured to the data dir
+	Profiling *profiling.Config
+
+	// Tracing is the configuration of the OpenTelemetry tracing, the spans aren't exported without an endpoint
+	Tracing *tracing.Config
 }
 
 // JSONRPC holds the config details for the JSON-RPC server
@@ -61,4 +93,24 @@ type JSONRPC struct {
 	BlockRangeLimit          uint64
 	ConcurrentRequestsDebug  uint64
 	WebSocketReadLimit       uint64
+
+	AccessPolicy        jsonrpc.AccessPolicy
+	PrivateAddr         *net.TCPAddr
+	PrivateAccessPolicy jsonrpc.AccessPolicy
+
+	// Auth enables the authentication of the clients,
+	// the JWT secret and the API keys are loaded from the secrets manager
+	Auth *jsonrpc.AuthConfig
+
+	// RateLimit limits the clients of JSONRPCAddr which aren't authenticated,
+	// MethodCosts overrides the costs of the methods
+	RateLimit   jsonrpc.RateLimit
+	MethodCosts map[string]uint64
+
+	// GraphQL enables the GraphQL endpoint, whose queries cost at most GraphQLMaxCost units
+	GraphQL        bool
+	GraphQLMaxCost uint64
+
+	// Health defines the thresholds of the /health and /ready endpoints
+	Health jsonrpc.HealthConfig
 }
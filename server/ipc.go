@@ -0,0 +1,153 @@
+package server
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	// IPCFileName is the name of the IPC socket in the data dir
+	IPCFileName = "hydra.ipc"
+
+	// ipcSniffTimeout is the time a client has to send the first byte after it connects
+	ipcSniffTimeout = 10 * time.Second
+
+	// grpcPrefaceByte is the first byte of the HTTP/2 connection preface ("PRI * HTTP/2.0..."),
+	// which can't start a JSON-RPC request
+	grpcPrefaceByte = 'P'
+)
+
+// ipcListener splits the connections of the IPC socket between the gRPC admin services
+// and the JSON-RPC server by the first byte the client sends
+type ipcListener struct {
+	logger hclog.Logger
+	lis    net.Listener
+
+	grpc    *connListener
+	jsonrpc *connListener
+}
+
+func newIPCListener(logger hclog.Logger, lis net.Listener) *ipcListener {
+	l := &ipcListener{
+		logger:  logger,
+		lis:     lis,
+		grpc:    newConnListener(lis.Addr()),
+		jsonrpc: newConnListener(lis.Addr()),
+	}
+
+	go l.run()
+
+	return l
+}
+
+// run accepts the connections until the listener is closed
+func (l *ipcListener) run() {
+	for {
+		conn, err := l.lis.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				l.logger.Error("closed ipc listener", "err", err)
+			}
+
+			l.grpc.Close()
+			l.jsonrpc.Close()
+
+			return
+		}
+
+		go l.route(conn)
+	}
+}
+
+// route hands the connection over to the gRPC server if it starts with the HTTP/2 preface,
+// to the JSON-RPC server otherwise
+func (l *ipcListener) route(conn net.Conn) {
+	reader := bufio.NewReader(conn)
+
+	_ = conn.SetReadDeadline(time.Now().Add(ipcSniffTimeout))
+
+	first, err := reader.Peek(1)
+	if err != nil {
+		l.logger.Debug("ipc client didn't send a request", "err", err)
+
+		_ = conn.Close()
+
+		return
+	}
+
+	_ = conn.SetReadDeadline(time.Time{})
+
+	target := l.jsonrpc
+	if first[0] == grpcPrefaceByte {
+		target = l.grpc
+	}
+
+	target.push(&peekedConn{Conn: conn, reader: reader})
+}
+
+// Close closes the socket, which removes its file
+func (l *ipcListener) Close() error {
+	return l.lis.Close()
+}
+
+// peekedConn reads the bytes peeked by the router before the rest of the connection
+type peekedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *peekedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
+
+// connListener is a net.Listener of the connections routed to one of the servers
+type connListener struct {
+	addr   net.Addr
+	connCh chan net.Conn
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+}
+
+func newConnListener(addr net.Addr) *connListener {
+	return &connListener{
+		addr:    addr,
+		connCh:  make(chan net.Conn),
+		closeCh: make(chan struct{}),
+	}
+}
+
+// push blocks until the connection is accepted, it closes the connection if the listener is closed
+func (l *connListener) push(conn net.Conn) {
+	select {
+	case l.connCh <- conn:
+	case <-l.closeCh:
+		_ = conn.Close()
+	}
+}
+
+func (l *connListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.connCh:
+		return conn, nil
+	case <-l.closeCh:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *connListener) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.closeCh)
+	})
+
+	return nil
+}
+
+func (l *connListener) Addr() net.Addr {
+	return l.addr
+}
@@ -260,6 +260,8 @@ func (m *ServerStatus) validate(all bool) error {
 
 	// no validation rules for P2PAddr
 
+	// no validation rules for JsonrpcAddr
+
 	if len(errors) > 0 {
 		return ServerStatusMultiError(errors)
 	}
@@ -470,6 +472,8 @@ func (m *PeersAddRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Static
+
 	if len(errors) > 0 {
 		return PeersAddRequestMultiError(errors)
 	}
@@ -1320,6 +1324,513 @@ var _ interface {
 	ErrorName() string
 } = ExportEventValidationError{}
 
+// Validate checks the field values on ProfileCapture with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProfileCapture) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProfileCapture with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProfileCaptureMultiError,
+// or nil if none found.
+func (m *ProfileCapture) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProfileCapture) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Reason
+
+	// no validation rules for Height
+
+	// no validation rules for Time
+
+	for idx, item := range m.GetFiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProfileCaptureValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProfileCaptureValidationError{
+						field:  fmt.Sprintf("Files[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProfileCaptureValidationError{
+					field:  fmt.Sprintf("Files[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ProfileCaptureMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProfileCaptureMultiError is an error wrapping multiple validation errors
+// returned by ProfileCapture.ValidateAll() if the designated constraints
+// aren't met.
+type ProfileCaptureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProfileCaptureMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProfileCaptureMultiError) AllErrors() []error { return m }
+
+// ProfileCaptureValidationError is the validation error returned by
+// ProfileCapture.Validate if the designated constraints aren't met.
+type ProfileCaptureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProfileCaptureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProfileCaptureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProfileCaptureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProfileCaptureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProfileCaptureValidationError) ErrorName() string { return "ProfileCaptureValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProfileCaptureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProfileCapture.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProfileCaptureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProfileCaptureValidationError{}
+
+// Validate checks the field values on ProfilesListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProfilesListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProfilesListResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProfilesListResponseMultiError, or nil if none found.
+func (m *ProfilesListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProfilesListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCaptures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProfilesListResponseValidationError{
+						field:  fmt.Sprintf("Captures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProfilesListResponseValidationError{
+						field:  fmt.Sprintf("Captures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProfilesListResponseValidationError{
+					field:  fmt.Sprintf("Captures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ProfilesListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProfilesListResponseMultiError is an error wrapping multiple validation
+// errors returned by ProfilesListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ProfilesListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProfilesListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProfilesListResponseMultiError) AllErrors() []error { return m }
+
+// ProfilesListResponseValidationError is the validation error returned by
+// ProfilesListResponse.Validate if the designated constraints aren't met.
+type ProfilesListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProfilesListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProfilesListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProfilesListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProfilesListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProfilesListResponseValidationError) ErrorName() string {
+	return "ProfilesListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProfilesListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProfilesListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProfilesListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProfilesListResponseValidationError{}
+
+// Validate checks the field values on ProfilesGetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProfilesGetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProfilesGetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProfilesGetRequestMultiError, or nil if none found.
+func (m *ProfilesGetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProfilesGetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ProfilesGetRequest_Capture_Pattern.MatchString(m.GetCapture()) {
+		err := ProfilesGetRequestValidationError{
+			field:  "Capture",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ProfilesGetRequest_File_Pattern.MatchString(m.GetFile()) {
+		err := ProfilesGetRequestValidationError{
+			field:  "File",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9_-]+\\\\.pprof$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ProfilesGetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProfilesGetRequestMultiError is an error wrapping multiple validation errors
+// returned by ProfilesGetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ProfilesGetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProfilesGetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProfilesGetRequestMultiError) AllErrors() []error { return m }
+
+// ProfilesGetRequestValidationError is the validation error returned by
+// ProfilesGetRequest.Validate if the designated constraints aren't met.
+type ProfilesGetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProfilesGetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProfilesGetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProfilesGetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProfilesGetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProfilesGetRequestValidationError) ErrorName() string {
+	return "ProfilesGetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProfilesGetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProfilesGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProfilesGetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProfilesGetRequestValidationError{}
+
+var _ProfilesGetRequest_Capture_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+$")
+
+var _ProfilesGetRequest_File_Pattern = regexp.MustCompile("^[A-Za-z0-9_-]+\\.pprof$")
+
+// Validate checks the field values on ProfileChunk with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProfileChunk) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProfileChunk with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProfileChunkMultiError, or
+// nil if none found.
+func (m *ProfileChunk) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProfileChunk) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return ProfileChunkMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProfileChunkMultiError is an error wrapping multiple validation errors
+// returned by ProfileChunk.ValidateAll() if the designated constraints aren't met.
+type ProfileChunkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProfileChunkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProfileChunkMultiError) AllErrors() []error { return m }
+
+// ProfileChunkValidationError is the validation error returned by
+// ProfileChunk.Validate if the designated constraints aren't met.
+type ProfileChunkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProfileChunkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProfileChunkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProfileChunkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProfileChunkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProfileChunkValidationError) ErrorName() string { return "ProfileChunkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProfileChunkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProfileChunk.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProfileChunkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProfileChunkValidationError{}
+
 // Validate checks the field values on BlockchainEvent_Header with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1531,3 +2042,109 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ServerStatus_BlockValidationError{}
+
+// Validate checks the field values on ProfileCapture_File with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProfileCapture_File) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProfileCapture_File with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProfileCapture_FileMultiError, or nil if none found.
+func (m *ProfileCapture_File) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProfileCapture_File) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return ProfileCapture_FileMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProfileCapture_FileMultiError is an error wrapping multiple validation
+// errors returned by ProfileCapture_File.ValidateAll() if the designated
+// constraints aren't met.
+type ProfileCapture_FileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProfileCapture_FileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProfileCapture_FileMultiError) AllErrors() []error { return m }
+
+// ProfileCapture_FileValidationError is the validation error returned by
+// ProfileCapture_File.Validate if the designated constraints aren't met.
+type ProfileCapture_FileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProfileCapture_FileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProfileCapture_FileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProfileCapture_FileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProfileCapture_FileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProfileCapture_FileValidationError) ErrorName() string {
+	return "ProfileCapture_FileValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProfileCapture_FileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProfileCapture_File.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProfileCapture_FileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProfileCapture_FileValidationError{}
@@ -25,7 +25,7 @@ const _ = grpc.SupportPackageIsVersion7
 type SystemClient interface {
 	// GetInfo returns info about the client
 	GetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServerStatus, error)
-	// PeersAdd adds a new peer
+	// PeersAdd adds a new peer, optionally as a static peer
 	PeersAdd(ctx context.Context, in *PeersAddRequest, opts ...grpc.CallOption) (*PeersAddResponse, error)
 	// PeersList returns the list of peers
 	PeersList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PeersListResponse, error)
@@ -37,6 +37,10 @@ type SystemClient interface {
 	BlockByNumber(ctx context.Context, in *BlockByNumberRequest, opts ...grpc.CallOption) (*BlockResponse, error)
 	// Export returns blockchain data
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (System_ExportClient, error)
+	// ProfilesList returns the profiles captured by the node
+	ProfilesList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProfilesListResponse, error)
+	// ProfilesGet returns a captured profile
+	ProfilesGet(ctx context.Context, in *ProfilesGetRequest, opts ...grpc.CallOption) (System_ProfilesGetClient, error)
 }
 
 type systemClient struct {
@@ -156,13 +160,54 @@ func (x *systemExportClient) Recv() (*ExportEvent, error) {
 	return m, nil
 }
 
+func (c *systemClient) ProfilesList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProfilesListResponse, error) {
+	out := new(ProfilesListResponse)
+	err := c.cc.Invoke(ctx, "/v1.System/ProfilesList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemClient) ProfilesGet(ctx context.Context, in *ProfilesGetRequest, opts ...grpc.CallOption) (System_ProfilesGetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &System_ServiceDesc.Streams[2], "/v1.System/ProfilesGet", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &systemProfilesGetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type System_ProfilesGetClient interface {
+	Recv() (*ProfileChunk, error)
+	grpc.ClientStream
+}
+
+type systemProfilesGetClient struct {
+	grpc.ClientStream
+}
+
+func (x *systemProfilesGetClient) Recv() (*ProfileChunk, error) {
+	m := new(ProfileChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // SystemServer is the server API for System service.
 // All implementations must embed UnimplementedSystemServer
 // for forward compatibility
 type SystemServer interface {
 	// GetInfo returns info about the client
 	GetStatus(context.Context, *emptypb.Empty) (*ServerStatus, error)
-	// PeersAdd adds a new peer
+	// PeersAdd adds a new peer, optionally as a static peer
 	PeersAdd(context.Context, *PeersAddRequest) (*PeersAddResponse, error)
 	// PeersList returns the list of peers
 	PeersList(context.Context, *emptypb.Empty) (*PeersListResponse, error)
@@ -174,6 +219,10 @@ type SystemServer interface {
 	BlockByNumber(context.Context, *BlockByNumberRequest) (*BlockResponse, error)
 	// Export returns blockchain data
 	Export(*ExportRequest, System_ExportServer) error
+	// ProfilesList returns the profiles captured by the node
+	ProfilesList(context.Context, *emptypb.Empty) (*ProfilesListResponse, error)
+	// ProfilesGet returns a captured profile
+	ProfilesGet(*ProfilesGetRequest, System_ProfilesGetServer) error
 	mustEmbedUnimplementedSystemServer()
 }
 
@@ -202,6 +251,12 @@ func (UnimplementedSystemServer) BlockByNumber(context.Context, *BlockByNumberRe
 func (UnimplementedSystemServer) Export(*ExportRequest, System_ExportServer) error {
 	return status.Errorf(codes.Unimplemented, "method Export not implemented")
 }
+func (UnimplementedSystemServer) ProfilesList(context.Context, *emptypb.Empty) (*ProfilesListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfilesList not implemented")
+}
+func (UnimplementedSystemServer) ProfilesGet(*ProfilesGetRequest, System_ProfilesGetServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProfilesGet not implemented")
+}
 func (UnimplementedSystemServer) mustEmbedUnimplementedSystemServer() {}
 
 // UnsafeSystemServer may be embedded to opt out of forward compatibility for this service.
@@ -347,6 +402,45 @@ func (x *systemExportServer) Send(m *ExportEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _System_ProfilesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemServer).ProfilesList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.System/ProfilesList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemServer).ProfilesList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _System_ProfilesGet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProfilesGetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SystemServer).ProfilesGet(m, &systemProfilesGetServer{stream})
+}
+
+type System_ProfilesGetServer interface {
+	Send(*ProfileChunk) error
+	grpc.ServerStream
+}
+
+type systemProfilesGetServer struct {
+	grpc.ServerStream
+}
+
+func (x *systemProfilesGetServer) Send(m *ProfileChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // System_ServiceDesc is the grpc.ServiceDesc for System service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -374,6 +468,10 @@ var System_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BlockByNumber",
 			Handler:    _System_BlockByNumber_Handler,
 		},
+		{
+			MethodName: "ProfilesList",
+			Handler:    _System_ProfilesList_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -386,6 +484,11 @@ var System_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _System_Export_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ProfilesGet",
+			Handler:       _System_ProfilesGet_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "server/proto/system.proto",
 }
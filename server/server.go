@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,10 +11,12 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain/storage"
-	"github.com/0xPolygon/polygon-edge/blockchain/storage/leveldb"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/dbengine"
 	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
 	consensusPolyBFT "github.com/0xPolygon/polygon-edge/consensus/polybft"
 	"github.com/0xPolygon/polygon-edge/forkmanager"
@@ -27,9 +30,13 @@ import (
 	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/helper/ipc"
+	"github.com/0xPolygon/polygon-edge/helper/profiling"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/jsonrpc"
 	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/pending"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server/proto"
 	"github.com/0xPolygon/polygon-edge/state"
@@ -49,6 +56,7 @@ import (
 var (
 	errBlockTimeMissing = errors.New("block time configuration is missing")
 	errBlockTimeInvalid = errors.New("block time configuration is invalid")
+	errGRPCTLSMissing   = errors.New("the grpc tcp listener requires mutual TLS")
 )
 
 // Server is the central manager of the blockchain client
@@ -70,17 +78,36 @@ type Server struct {
 	// jsonrpc stack
 	jsonrpcServer *jsonrpc.JSONRPC
 
+	// jsonrpcAddr is the bound address of the jsonrpc server,
+	// which the status service reads while the server starts
+	jsonrpcAddr atomic.Value
+
 	// system grpc server
 	grpcServer *grpc.Server
 
+	// ipcListener serves the grpc server and the jsonrpc server on the unix socket
+	ipcListener *ipcListener
+
 	// libp2p network
 	network *network.Server
 
 	// transaction pool
 	txpool *txpool.TxPool
 
+	// pendingBlockBuilder keeps the speculative pending block
+	pendingBlockBuilder *pending.Builder
+
 	prometheusServer *http.Server
 
+	// pprofServer serves the pprof endpoints on the loopback interface
+	pprofServer *http.Server
+
+	// profileCapturer captures the profiles when the block production is slow
+	profileCapturer *profiling.Capturer
+
+	// shutdownTracing flushes the spans and stops the exporter
+	shutdownTracing func(context.Context) error
+
 	// secrets manager
 	secretsManager secrets.SecretsManager
 
@@ -154,7 +181,11 @@ func NewServer(config *Config) (*Server, error) {
 		m.logger.Info(common.IBFTImportantNotice)
 	}
 
-	m.logger.Info("Data dir", "path", config.DataDir)
+	if m.config.DBEngine == "" {
+		m.config.DBEngine = dbengine.Default
+	}
+
+	m.logger.Info("Data dir", "path", config.DataDir, "db engine", m.config.DBEngine)
 
 	var dirPaths = []string{
 		"blockchain",
@@ -175,11 +206,19 @@ func NewServer(config *Config) (*Server, error) {
 		m.prometheusServer = m.startPrometheusServer(config.Telemetry.PrometheusAddr)
 	}
 
+	if config.Telemetry.PprofAddr != nil {
+		m.pprofServer = m.startPprofServer(config.Telemetry.PprofAddr)
+	}
+
 	// Set up datadog profiler
 	if ddErr := m.enableDataDogProfiler(); ddErr != nil {
 		m.logger.Error("DataDog profiler setup failed", "err", ddErr.Error())
 	}
 
+	if err := m.setupTracing(); err != nil {
+		return nil, fmt.Errorf("failed to set up the tracing: %w", err)
+	}
+
 	// Set up the secrets manager
 	if err := m.setupSecretsManager(); err != nil {
 		return nil, fmt.Errorf("failed to set up the secrets manager: %w", err)
@@ -200,7 +239,7 @@ func NewServer(config *Config) (*Server, error) {
 	}
 
 	// start blockchain object
-	stateStorage, err := itrie.NewLevelDBStorage(filepath.Join(m.config.DataDir, "trie"), logger)
+	stateStorage, err := dbengine.OpenStateStorage(m.config.DBEngine, filepath.Join(m.config.DataDir, "trie"), logger)
 	if err != nil {
 		return nil, err
 	}
@@ -309,7 +348,8 @@ func NewServer(config *Config) (*Server, error) {
 				return nil, err
 			}
 		} else {
-			db, err = leveldb.NewLevelDBStorage(
+			db, err = dbengine.OpenBlockchainStorage(
+				m.config.DBEngine,
 				filepath.Join(m.config.DataDir, "blockchain"),
 				m.logger,
 			)
@@ -358,6 +398,7 @@ func NewServer(config *Config) (*Server, error) {
 				PriceLimit:         m.config.PriceLimit,
 				MaxAccountEnqueued: m.config.MaxAccountEnqueued,
 				ChainID:            big.NewInt(m.config.Chain.Params.ChainID),
+				PriorityAddresses:  m.config.Chain.Params.TxPriorityAddresses,
 			},
 		)
 		if err != nil {
@@ -365,6 +406,15 @@ func NewServer(config *Config) (*Server, error) {
 		}
 
 		m.txpool.SetSigner(signer)
+
+		m.pendingBlockBuilder = pending.NewBuilder(
+			logger,
+			m.blockchain,
+			m.txpool,
+			m.executor,
+			m.stateStorage,
+			pending.DefaultRebuildInterval,
+		)
 	}
 
 	{
@@ -387,6 +437,11 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, err
 	}
 
+	// listen on the unix socket shared by the grpc and the jsonrpc servers
+	if err := m.setupIPC(); err != nil {
+		return nil, err
+	}
+
 	// setup and start grpc server
 	if err := m.setupGRPC(); err != nil {
 		return nil, err
@@ -406,18 +461,19 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, err
 	}
 
-	// create price oracle instance
-	m.priceOracle, err = priceoracle.NewPriceOracle(
-		m.logger,
-		m.blockchain,
-		m.executor,
-		m.consensus,
-		m.config.JSONRPC.JSONRPCAddr.String(),
-		m.secretsManager,
-		m.config.SecretsManager,
-	)
-	if err != nil {
-		return nil, err
+	// create price oracle instance, the prices are voted by the hydragon validators only
+	if ConsensusType(config.Chain.Params.GetEngine()) == PolyBFTConsensus {
+		m.priceOracle, err = priceoracle.NewPriceOracle(
+			m.logger,
+			m.blockchain,
+			m.executor,
+			m.consensus,
+			m.jsonrpcServer.Addr().String(),
+			m.config.SecretsManager,
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// start consensus
@@ -428,9 +484,16 @@ func NewServer(config *Config) (*Server, error) {
 	m.txpool.SetBaseFee(m.blockchain.Header())
 	m.txpool.Start()
 
+	// the capture checks the rounds of the consensus, so it starts after it
+	if err := m.setupProfiling(); err != nil {
+		return nil, fmt.Errorf("failed to set up the profile capture: %w", err)
+	}
+
 	// start price oracle
-	if err := m.priceOracle.Start(); err != nil {
-		return nil, err
+	if m.priceOracle != nil {
+		if err := m.priceOracle.Start(); err != nil {
+			return nil, err
+		}
 	}
 
 	return m, nil
@@ -599,6 +662,7 @@ func (s *Server) setupConsensus() error {
 			Grpc:                  s.grpcServer,
 			Logger:                s.logger,
 			SecretsManager:        s.secretsManager,
+			RemoteSigner:          s.config.RemoteSigner,
 			BlockTime:             uint64(blockTime.Seconds()),
 			NumBlockConfirmations: s.config.NumBlockConfirmations,
 			MetricsInterval:       s.config.MetricsInterval,
@@ -643,6 +707,7 @@ func extractBlockTime(engineConfig map[string]interface{}) (common.Duration, err
 type jsonRPCHub struct {
 	state              state.State
 	restoreProgression *progress.ProgressionWrapper
+	pendingBuilder     *pending.Builder
 
 	*blockchain.Blockchain
 	*txpool.TxPool
@@ -653,12 +718,40 @@ type jsonRPCHub struct {
 	gasprice.GasStore
 }
 
+// PendingBlock returns the speculative pending block, nil if it is not available
+func (j *jsonRPCHub) PendingBlock() *types.Block {
+	return j.pendingBuilder.Block()
+}
+
+// stateAt returns the state which holds the given root,
+// the in-memory state of a recent pending block for the root of that block
+func (j *jsonRPCHub) stateAt(root types.Hash) state.State {
+	if pendingState, ok := j.pendingBuilder.StateAt(root); ok {
+		return pendingState
+	}
+
+	return j.state
+}
+
+// SetHead rewinds the canonical chain to the block with the given number
+// and puts the transactions of the removed blocks back into the transaction pool
+func (j *jsonRPCHub) SetHead(number uint64) error {
+	droppedTxs, err := j.Blockchain.SetHead(number)
+	if err != nil {
+		return err
+	}
+
+	j.TxPool.ResetWithDroppedTxs(droppedTxs)
+
+	return nil
+}
+
 func (j *jsonRPCHub) GetPeers() int {
 	return len(j.Server.Peers())
 }
 
 func (j *jsonRPCHub) GetAccount(root types.Hash, addr types.Address) (*jsonrpc.Account, error) {
-	acct, err := getAccountImpl(j.state, root, addr)
+	acct, err := getAccountImpl(j.stateAt(root), root, addr)
 	if err != nil {
 		return nil, err
 	}
@@ -677,12 +770,14 @@ func (j *jsonRPCHub) GetForksInTime(blockNumber uint64) chain.ForksInTime {
 }
 
 func (j *jsonRPCHub) GetStorage(stateRoot types.Hash, addr types.Address, slot types.Hash) ([]byte, error) {
-	account, err := getAccountImpl(j.state, stateRoot, addr)
+	st := j.stateAt(stateRoot)
+
+	account, err := getAccountImpl(st, stateRoot, addr)
 	if err != nil {
 		return nil, err
 	}
 
-	snap, err := j.state.NewSnapshotAt(stateRoot)
+	snap, err := st.NewSnapshotAt(stateRoot)
 	if err != nil {
 		return nil, err
 	}
@@ -693,12 +788,14 @@ func (j *jsonRPCHub) GetStorage(stateRoot types.Hash, addr types.Address, slot t
 }
 
 func (j *jsonRPCHub) GetCode(root types.Hash, addr types.Address) ([]byte, error) {
-	account, err := getAccountImpl(j.state, root, addr)
+	st := j.stateAt(root)
+
+	account, err := getAccountImpl(st, root, addr)
 	if err != nil {
 		return nil, err
 	}
 
-	code, ok := j.state.GetCode(types.BytesToHash(account.CodeHash))
+	code, ok := st.GetCode(types.BytesToHash(account.CodeHash))
 	if !ok {
 		return nil, fmt.Errorf("unable to fetch code")
 	}
@@ -717,7 +814,7 @@ func (j *jsonRPCHub) ApplyTxn(
 		return nil, err
 	}
 
-	transition, err := j.BeginTxn(header.StateRoot, header, blockCreator)
+	transition, err := j.WithState(j.stateAt(header.StateRoot)).BeginTxn(header.StateRoot, header, blockCreator)
 	if err != nil {
 		return
 	}
@@ -876,6 +973,7 @@ func (s *Server) setupJSONRPC() error {
 	hub := &jsonRPCHub{
 		state:              s.state,
 		restoreProgression: s.restoreProgression,
+		pendingBuilder:     s.pendingBlockBuilder,
 		Blockchain:         s.blockchain,
 		TxPool:             s.txpool,
 		Executor:           s.executor,
@@ -896,6 +994,34 @@ func (s *Server) setupJSONRPC() error {
 		BlockRangeLimit:          s.config.JSONRPC.BlockRangeLimit,
 		ConcurrentRequestsDebug:  s.config.JSONRPC.ConcurrentRequestsDebug,
 		WebSocketReadLimit:       s.config.JSONRPC.WebSocketReadLimit,
+		AccessPolicy:             s.config.JSONRPC.AccessPolicy,
+		PrivateAddr:              s.config.JSONRPC.PrivateAddr,
+		PrivateAccessPolicy:      s.config.JSONRPC.PrivateAccessPolicy,
+		RateLimit:                s.config.JSONRPC.RateLimit,
+		MethodCosts:              s.config.JSONRPC.MethodCosts,
+		GraphQL:                  s.config.JSONRPC.GraphQL,
+		GraphQLMaxCost:           s.config.JSONRPC.GraphQLMaxCost,
+		Health:                   s.config.JSONRPC.Health,
+		IPCListener:              s.ipcListener.jsonrpc,
+	}
+
+	// the dev consensus enables the evm and hardhat endpoints
+	if devStore, ok := s.consensus.(jsonrpc.DevStore); ok {
+		conf.DevStore = devStore
+	}
+
+	// the health endpoints report the status of the validator
+	if validatorStore, ok := s.consensus.(jsonrpc.ValidatorStore); ok {
+		conf.Health.ValidatorStore = validatorStore
+	}
+
+	if s.config.JSONRPC.Auth != nil {
+		auth, err := s.loadJSONRPCAuth(s.config.JSONRPC.Auth)
+		if err != nil {
+			return fmt.Errorf("json-rpc auth: %w", err)
+		}
+
+		conf.Auth = auth
 	}
 
 	srv, err := jsonrpc.NewJSONRPC(s.logger, conf)
@@ -904,29 +1030,100 @@ func (s *Server) setupJSONRPC() error {
 	}
 
 	s.jsonrpcServer = srv
+	s.jsonrpcAddr.Store(srv.Addr().String())
 
 	return nil
 }
 
-// setupGRPC sets up the grpc server and listens on tcp
+// loadJSONRPCAuth returns the JSON-RPC authentication with the JWT secret and the API keys
+// loaded from the secrets manager. The JWT secret is required only if the JWT tier is set
+func (s *Server) loadJSONRPCAuth(config *jsonrpc.AuthConfig) (*jsonrpc.AuthConfig, error) {
+	auth := *config
+
+	if auth.JWTTier != "" {
+		encodedSecret, err := s.secretsManager.GetSecret(secrets.JSONRPCJWTSecret)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the jwt secret: %w", err)
+		}
+
+		if auth.JWTSecret, err = hex.DecodeHex(strings.TrimSpace(string(encodedSecret))); err != nil {
+			return nil, fmt.Errorf("invalid jwt secret: %w", err)
+		}
+	}
+
+	if s.secretsManager.HasSecret(secrets.JSONRPCAPIKeys) {
+		rawKeys, err := s.secretsManager.GetSecret(secrets.JSONRPCAPIKeys)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load the api keys: %w", err)
+		}
+
+		if err := json.Unmarshal(rawKeys, &auth.APIKeys); err != nil {
+			return nil, fmt.Errorf("invalid api keys: %w", err)
+		}
+	}
+
+	s.logger.Info("json-rpc authentication enabled",
+		"jwt", len(auth.JWTSecret) > 0, "api_keys", len(auth.APIKeys), "anonymous_tier", auth.AnonymousTier)
+
+	return &auth, nil
+}
+
+// setupIPC listens on the unix socket, which is accessible only by the user running the node
+func (s *Server) setupIPC() error {
+	lis, err := ipc.Listen(s.config.IPCPath)
+	if err != nil {
+		return fmt.Errorf("failed to listen on the ipc socket %s: %w", s.config.IPCPath, err)
+	}
+
+	s.ipcListener = newIPCListener(s.logger.Named("ipc"), lis)
+
+	return nil
+}
+
+// setupGRPC sets up the grpc server, which listens on the unix socket
+// and, if the address is set, on tcp with mutual TLS
 func (s *Server) setupGRPC() error {
 	proto.RegisterSystemServer(s.grpcServer, &systemService{server: s})
 
+	s.serveGRPC(s.ipcListener.grpc)
+
+	s.logger.Info("GRPC server running", "ipc", s.config.IPCPath)
+
+	if s.config.GRPCAddr == nil {
+		return nil
+	}
+
+	if s.config.GRPCTLS == nil {
+		return errGRPCTLSMissing
+	}
+
+	tlsConfig, err := common.ServerMTLSConfig(
+		s.config.GRPCTLS.ClientCAFile, s.config.GRPCTLS.CertFile, s.config.GRPCTLS.KeyFile)
+	if err != nil {
+		return fmt.Errorf("grpc tls: %w", err)
+	}
+
+	// the grpc clients negotiate HTTP/2 by ALPN
+	tlsConfig.NextProtos = []string{"h2"}
+
 	lis, err := net.Listen("tcp", s.config.GRPCAddr.String())
 	if err != nil {
 		return err
 	}
 
-	// Start server with infinite retries
+	s.serveGRPC(tls.NewListener(lis, tlsConfig))
+
+	s.logger.Info("GRPC server running", "addr", s.config.GRPCAddr.String())
+
+	return nil
+}
+
+func (s *Server) serveGRPC(lis net.Listener) {
 	go func() {
 		if err := s.grpcServer.Serve(lis); err != nil {
 			s.logger.Error(err.Error())
 		}
 	}()
-
-	s.logger.Info("GRPC server running", "addr", s.config.GRPCAddr.String())
-
-	return nil
 }
 
 // Chain returns the chain object of the client
@@ -939,8 +1136,27 @@ func (s *Server) JoinPeer(rawPeerMultiaddr string) error {
 	return s.network.JoinPeer(rawPeerMultiaddr)
 }
 
+// AddStaticPeer adds a new static peer to the networking server
+func (s *Server) AddStaticPeer(rawPeerMultiaddr string) error {
+	return s.network.AddStaticPeer(rawPeerMultiaddr)
+}
+
 // Close closes the Minimal server (blockchain, networking, consensus)
 func (s *Server) Close() {
+	// Stop the grpc server and remove the unix socket
+	s.grpcServer.Stop()
+
+	if s.ipcListener != nil {
+		if err := s.ipcListener.Close(); err != nil {
+			s.logger.Error("failed to close ipc listener", "err", err.Error())
+		}
+	}
+
+	// Stop capturing the profiles, it reads the head of the blockchain
+	if s.profileCapturer != nil {
+		s.profileCapturer.Close()
+	}
+
 	// Close the blockchain layer
 	if err := s.blockchain.Close(); err != nil {
 		s.logger.Error("failed to close blockchain", "err", err.Error())
@@ -967,12 +1183,23 @@ func (s *Server) Close() {
 		}
 	}
 
+	if s.pprofServer != nil {
+		if err := s.pprofServer.Shutdown(context.Background()); err != nil {
+			s.logger.Error("pprof server shutdown error", "err", err)
+		}
+	}
+
 	// Close the price oracle
-	s.priceOracle.Close()
+	if s.priceOracle != nil {
+		s.priceOracle.Close()
+	}
 
 	// Close the txpool's main loop
 	s.txpool.Close()
 
+	// Export the remaining spans
+	s.closeTracing()
+
 	// Close DataDog profiler
 	s.closeDataDogProfiler()
 }
@@ -1008,6 +1235,27 @@ func (s *Server) startPrometheusServer(listenAddr *net.TCPAddr) *http.Server {
 	return srv
 }
 
+// startPprofServer serves the pprof endpoints, the command only accepts a loopback address
+func (s *Server) startPprofServer(listenAddr *net.TCPAddr) *http.Server {
+	srv := &http.Server{
+		Addr:              listenAddr.String(),
+		Handler:           profiling.Handler(),
+		ReadHeaderTimeout: 60 * time.Second,
+	}
+
+	s.logger.Info("pprof server started", "addr", listenAddr.String())
+
+	go func() {
+		if err := srv.ListenAndServe(); err != nil {
+			if !errors.Is(err, http.ErrServerClosed) {
+				s.logger.Error("pprof HTTP server ListenAndServe", "err", err)
+			}
+		}
+	}()
+
+	return srv
+}
+
 func initForkManager(engineName string, config *chain.Chain) error {
 	var initialParams *forkmanager.ForkParams
 
@@ -1029,10 +1277,16 @@ func initForkManager(engineName string, config *chain.Chain) error {
 	// Register forks
 	for name, f := range *config.Params.Forks {
 		// check if fork is not supported by current edge version
-		if _, found := (*chain.AllForksEnabled)[name]; !found {
+		if !chain.IsForkAvailable(name) {
 			return fmt.Errorf("fork is not available: %s", name)
 		}
 
+		if f.Params != nil && f.Params.TxOrderingPolicy != nil {
+			if err := txpool.ValidateTxOrderingPolicy(*f.Params.TxOrderingPolicy); err != nil {
+				return fmt.Errorf("invalid params of fork %s: %w", name, err)
+			}
+		}
+
 		fm.RegisterFork(name, f.Params)
 	}
 
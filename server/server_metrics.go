@@ -1,16 +1,24 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/armon/go-metrics"
 	"github.com/armon/go-metrics/prometheus"
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
 	"gopkg.in/DataDog/dd-trace-go.v1/profiler"
+
+	"github.com/0xPolygon/polygon-edge/helper/profiling"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 )
 
+// tracingShutdownTimeout is the time the spans left in the queue have to be exported when the server stops
+const tracingShutdownTimeout = 5 * time.Second
+
 func (s *Server) setupTelemetry() error {
 	inm := metrics.NewInmemSink(10*time.Second, time.Minute)
 	metrics.DefaultInmemSignal(inm)
@@ -32,6 +40,79 @@ func (s *Server) setupTelemetry() error {
 	return err
 }
 
+// setupTracing sets up the OpenTelemetry tracing, the spans are exported to the OTLP endpoint if one is configured
+func (s *Server) setupTracing() error {
+	config := s.config.Telemetry.Tracing
+	if config == nil {
+		config = &tracing.Config{}
+	}
+
+	shutdown, err := tracing.Setup(context.Background(), config)
+	if err != nil {
+		return err
+	}
+
+	if config.Endpoint != "" {
+		s.logger.Info("tracing enabled", "endpoint", config.Endpoint, "sample ratio", config.SampleRatio)
+	}
+
+	s.shutdownTracing = shutdown
+
+	return nil
+}
+
+// closeTracing exports the spans left in the queue
+func (s *Server) closeTracing() {
+	if s.shutdownTracing == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	defer cancel()
+
+	if err := s.shutdownTracing(ctx); err != nil {
+		s.logger.Error("failed to export the remaining spans", "err", err)
+	}
+}
+
+// profilesDir returns the directory of the data dir the profiles are captured to
+func (s *Server) profilesDir() string {
+	return filepath.Join(s.config.DataDir, profiling.DirName)
+}
+
+// setupProfiling starts the automatic capture of the profiles, if any of its thresholds is set
+func (s *Server) setupProfiling() error {
+	config := s.config.Telemetry.Profiling
+	if config == nil || !config.Enabled() {
+		return nil
+	}
+
+	if s.config.DataDir == "" {
+		s.logger.Warn("the profiles aren't captured without a data dir")
+
+		return nil
+	}
+
+	config.Dir = s.profilesDir()
+
+	// the rounds are checked with the consensus which reports them
+	rounds, _ := s.consensus.(profiling.RoundStore)
+
+	capturer, err := profiling.NewCapturer(s.logger, config, s.blockchain, rounds)
+	if err != nil {
+		return err
+	}
+
+	capturer.Start()
+
+	s.logger.Info("profile capture enabled", "dir", config.Dir,
+		"block latency", config.BlockLatency, "round", config.Round)
+
+	s.profileCapturer = capturer
+
+	return nil
+}
+
 // enableDataDogProfiler enables DataDog profiler. Enable it by setting DD_ENABLE env var.
 // Additional parameters can be set with env vars (DD_) - https://docs.datadoghq.com/profiler/enabling/go/
 func (s *Server) enableDataDogProfiler() error {
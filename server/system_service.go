@@ -5,11 +5,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/helper/profiling"
 	"github.com/0xPolygon/polygon-edge/network/common"
 	"github.com/0xPolygon/polygon-edge/server/proto"
 	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/0xPolygon/polygon-edge/validate"
 	"github.com/libp2p/go-libp2p/core/peer"
 	empty "google.golang.org/protobuf/types/known/emptypb"
 )
@@ -44,6 +47,11 @@ func (s *systemService) GetStatus(ctx context.Context, req *empty.Empty) (*proto
 		P2PAddr: addr,
 	}
 
+	// the address is stored once the jsonrpc server listens
+	if addr, ok := s.server.jsonrpcAddr.Load().(string); ok {
+		status.JsonrpcAddr = addr
+	}
+
 	return status, nil
 }
 
@@ -90,6 +98,18 @@ func (s *systemService) Subscribe(req *empty.Empty, stream proto.System_Subscrib
 
 // PeersAdd implements the 'peers add' operator service
 func (s *systemService) PeersAdd(_ context.Context, req *proto.PeersAddRequest) (*proto.PeersAddResponse, error) {
+	if req.Static {
+		if addErr := s.server.AddStaticPeer(req.Id); addErr != nil {
+			return &proto.PeersAddResponse{
+				Message: "Unable to successfully add static peer",
+			}, addErr
+		}
+
+		return &proto.PeersAddResponse{
+			Message: "Static peer address marked ready for dialing",
+		}, nil
+	}
+
 	if joinErr := s.server.JoinPeer(req.Id); joinErr != nil {
 		return &proto.PeersAddResponse{
 			Message: "Unable to successfully add peer",
@@ -227,6 +247,70 @@ func (s *systemService) Export(req *proto.ExportRequest, stream proto.System_Exp
 	return nil
 }
 
+// ProfilesList implements the 'debug profiles' operator service
+func (s *systemService) ProfilesList(
+	ctx context.Context,
+	req *empty.Empty,
+) (*proto.ProfilesListResponse, error) {
+	captures, err := profiling.List(s.server.profilesDir())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.ProfilesListResponse{
+		Captures: make([]*proto.ProfileCapture, 0, len(captures)),
+	}
+
+	for _, capture := range captures {
+		files := make([]*proto.ProfileCapture_File, len(capture.Files))
+		for i, file := range capture.Files {
+			files[i] = &proto.ProfileCapture_File{Name: file.Name, Size: file.Size}
+		}
+
+		resp.Captures = append(resp.Captures, &proto.ProfileCapture{
+			Name:   capture.Name,
+			Reason: capture.Reason,
+			Height: capture.Height,
+			Time:   capture.Time.Unix(),
+			Files:  files,
+		})
+	}
+
+	return resp, nil
+}
+
+// ProfilesGet streams a captured profile in chunks
+func (s *systemService) ProfilesGet(req *proto.ProfilesGetRequest, stream proto.System_ProfilesGetServer) error {
+	// the interceptor only validates the unary requests
+	if err := validate.ValidateRequest(req); err != nil {
+		return err
+	}
+
+	file, err := profiling.Open(s.server.profilesDir(), req.Capture, req.File)
+	if err != nil {
+		return fmt.Errorf("failed to open the profile: %w", err)
+	}
+
+	defer file.Close()
+
+	buf := make([]byte, defaultMaxGRPCPayloadSize)
+
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&proto.ProfileChunk{Data: buf[:n]}); err != nil {
+				return err
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to read the profile: %w", err)
+		}
+	}
+}
+
 const (
 	defaultMaxGRPCPayloadSize uint64 = 512 * 1024 // 4MB
 
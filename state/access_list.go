@@ -0,0 +1,91 @@
+package state
+
+import (
+	iradix "github.com/hashicorp/go-immutable-radix"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// accessListIndex is the index of the access list (EIP-2929) in the trie.
+// The access list holds the addresses and the storage slots accessed in the current transaction.
+// It is kept as an immutable radix tree (keyed by address and address+slot) inside the transaction radix tree,
+// so it is journalled along with the rest of the state and restored on RevertToSnapshot
+var accessListIndex = types.BytesToHash([]byte{4}).Bytes()
+
+func (txn *Txn) getAccessList() *iradix.Tree {
+	val, exists := txn.txn.Get(accessListIndex)
+	if !exists {
+		return iradix.New()
+	}
+
+	return val.(*iradix.Tree) //nolint:forcetypeassert
+}
+
+func accessListSlotKey(addr types.Address, slot types.Hash) []byte {
+	return append(addr.Bytes(), slot.Bytes()...)
+}
+
+// AddressInAccessList returns true if the address was already accessed in the current transaction
+func (txn *Txn) AddressInAccessList(addr types.Address) bool {
+	_, ok := txn.getAccessList().Get(addr.Bytes())
+
+	return ok
+}
+
+// SlotInAccessList returns whether the address and the storage slot of the address
+// were already accessed in the current transaction
+func (txn *Txn) SlotInAccessList(addr types.Address, slot types.Hash) (addressOk bool, slotOk bool) {
+	accessList := txn.getAccessList()
+
+	_, addressOk = accessList.Get(addr.Bytes())
+	_, slotOk = accessList.Get(accessListSlotKey(addr, slot))
+
+	return addressOk, slotOk
+}
+
+// AddAddressToAccessList marks the address as accessed in the current transaction
+func (txn *Txn) AddAddressToAccessList(addr types.Address) {
+	if txn.AddressInAccessList(addr) {
+		return
+	}
+
+	accessList, _, _ := txn.getAccessList().Insert(addr.Bytes(), struct{}{})
+	txn.txn.Insert(accessListIndex, accessList)
+}
+
+// AddSlotToAccessList marks the storage slot of the address (and the address itself)
+// as accessed in the current transaction
+func (txn *Txn) AddSlotToAccessList(addr types.Address, slot types.Hash) {
+	addressOk, slotOk := txn.SlotInAccessList(addr, slot)
+	if addressOk && slotOk {
+		return
+	}
+
+	accessList := txn.getAccessList()
+
+	if !addressOk {
+		accessList, _, _ = accessList.Insert(addr.Bytes(), struct{}{})
+	}
+
+	if !slotOk {
+		accessList, _, _ = accessList.Insert(accessListSlotKey(addr, slot), struct{}{})
+	}
+
+	txn.txn.Insert(accessListIndex, accessList)
+}
+
+// PrepareAccessList resets the access list at the beginning of a transaction
+// and adds the sender, the recipient (if any) and the given precompiles to it
+func (txn *Txn) PrepareAccessList(from types.Address, to *types.Address, precompiles []types.Address) {
+	txn.txn.Delete(accessListIndex)
+
+	txn.AddAddressToAccessList(from)
+
+	if to != nil {
+		txn.AddAddressToAccessList(*to)
+	}
+
+	for _, addr := range precompiles {
+		txn.AddAddressToAccessList(addr)
+	}
+}
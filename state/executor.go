@@ -165,6 +165,14 @@ func (e *Executor) StateAt(root types.Hash) (Snapshot, error) {
 	return e.state.NewSnapshotAt(root)
 }
 
+// WithState returns a copy of the executor which executes the transitions on the given state
+func (e *Executor) WithState(s State) *Executor {
+	executor := *e
+	executor.state = s
+
+	return &executor
+}
+
 // GetForksInTime returns the active forks at the given block height
 func (e *Executor) GetForksInTime(blockNumber uint64) chain.ForksInTime {
 	return e.config.Forks.At(blockNumber)
@@ -601,6 +609,11 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	t.ctx.GasPrice = types.BytesToHash(gasPrice.Bytes())
 	t.ctx.Origin = msg.From
 
+	// EIP-2929: the sender, the recipient and the precompiles are warm from the start of the transaction
+	if t.config.Berlin {
+		t.state.PrepareAccessList(msg.From, msg.To, t.precompiles.Addresses(&t.config))
+	}
+
 	var result *runtime.ExecutionResult
 	if msg.IsContractCreation() {
 		result = t.Create2(msg.From, msg.Input, value, gasLeft)
@@ -828,6 +841,11 @@ func (t *Transition) applyCreate(c *runtime.Contract, host runtime.Host) *runtim
 		return &runtime.ExecutionResult{Err: err}
 	}
 
+	// EIP-2929: the created address is warm, even if the creation fails
+	if t.config.Berlin {
+		t.state.AddAddressToAccessList(c.Address)
+	}
+
 	// Check if there is a collision and the address already exists
 	if t.hasCodeOrNonce(c.Address) {
 		return &runtime.ExecutionResult{
@@ -1109,6 +1127,22 @@ func (t *Transition) GetRefund() uint64 {
 	return t.state.GetRefund()
 }
 
+func (t *Transition) AddressInAccessList(addr types.Address) bool {
+	return t.state.AddressInAccessList(addr)
+}
+
+func (t *Transition) SlotInAccessList(addr types.Address, slot types.Hash) (bool, bool) {
+	return t.state.SlotInAccessList(addr, slot)
+}
+
+func (t *Transition) AddAddressToAccessList(addr types.Address) {
+	t.state.AddAddressToAccessList(addr)
+}
+
+func (t *Transition) AddSlotToAccessList(addr types.Address, slot types.Hash) {
+	t.state.AddSlotToAccessList(addr, slot)
+}
+
 func TransactionGasCost(msg *types.Transaction, isHomestead, isIstanbul bool) (uint64, error) {
 	cost := uint64(0)
 
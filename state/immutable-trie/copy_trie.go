@@ -41,35 +41,37 @@ func getCustomNode(hash []byte, storage Storage) (Node, []byte, error) {
 	return n, data, err
 }
 
-func CopyTrie(nodeHash []byte, storage Storage, newStorage Storage, agg []byte, isStorage bool) error {
-	batchWriter := newStorage.Batch()
-
-	if err := copyTrieHash(nodeHash, storage, batchWriter, agg, isStorage); err != nil {
-		return err
-	}
+// trieVisitor is called by walkTrie for the nodes and the contract codes of a trie
+type trieVisitor struct {
+	// node is called with the hash of a stored node and its encoding, nil if it's missing
+	node func(hash, data []byte) error
 
-	return batchWriter.Write()
+	// code is called with the code of a contract account
+	code func(hash types.Hash, code []byte) error
 }
 
-func copyTrieHash(nodeHash []byte, storage Storage, batchWriter Batch, agg []byte, isStorage bool) error {
+// walkTrie walks the trie with the given root hash, including the storage tries of the accounts
+// if it is a state trie, and calls the visitor for its nodes and contract codes
+func walkTrie(nodeHash []byte, storage Storage, agg []byte, isStorage bool, visit trieVisitor) error {
 	node, data, err := getCustomNode(nodeHash, storage)
 	if err != nil {
 		return err
 	}
 
-	//copy whole bytes of nodes
-	batchWriter.Put(nodeHash, data)
+	if err := visit.node(nodeHash, data); err != nil {
+		return err
+	}
 
-	return copyTrieNode(node, storage, batchWriter, agg, isStorage)
+	return walkTrieNode(node, storage, agg, isStorage, visit)
 }
 
-func copyTrieNode(node Node, storage Storage, batchWriter Batch, agg []byte, isStorage bool) error {
+func walkTrieNode(node Node, storage Storage, agg []byte, isStorage bool, visit trieVisitor) error {
 	switch n := node.(type) {
 	case nil:
 		return nil
 	case *FullNode:
 		if len(n.hash) > 0 {
-			return copyTrieHash(n.hash, storage, batchWriter, agg, isStorage)
+			return walkTrie(n.hash, storage, agg, isStorage, visit)
 		}
 
 		for i := range n.children {
@@ -77,16 +79,18 @@ func copyTrieNode(node Node, storage Storage, batchWriter Batch, agg []byte, isS
 				continue
 			}
 
-			err := copyTrieNode(n.children[i], storage, batchWriter, append(agg, uint8(i)), isStorage)
+			err := walkTrieNode(n.children[i], storage, append(agg, uint8(i)), isStorage, visit)
 			if err != nil {
 				return err
 			}
 		}
 
+		return walkTrieNode(n.value, storage, agg, isStorage, visit)
+
 	case *ValueNode:
-		//if node represens stored value, then we need to copy it
+		//if node represens stored value, then we need to walk it
 		if n.hash {
-			return copyTrieHash(n.buf, storage, batchWriter, agg, isStorage)
+			return walkTrie(n.buf, storage, agg, isStorage, visit)
 		}
 
 		if !isStorage {
@@ -98,30 +102,55 @@ func copyTrieNode(node Node, storage Storage, batchWriter Batch, agg []byte, isS
 					hash := types.BytesToHash(account.CodeHash)
 
 					code, ok := storage.GetCode(hash)
-					if ok {
-						batchWriter.Put(GetCodeKey(hash), code)
-					} else {
+					if !ok {
 						return fmt.Errorf("can't find code %s", hex.EncodeToString(account.CodeHash))
 					}
+
+					if err := visit.code(hash, code); err != nil {
+						return err
+					}
 				}
 
-				if account.Root != types.EmptyRootHash {
-					return copyTrieHash(account.Root[:], storage, batchWriter, nil, true)
+				if account.Root != types.EmptyRootHash && account.Root != types.ZeroHash {
+					return walkTrie(account.Root[:], storage, nil, true, visit)
 				}
 			}
 		}
 
 	case *ShortNode:
 		if len(n.hash) > 0 {
-			return copyTrieHash(n.hash, storage, batchWriter, agg, isStorage)
+			return walkTrie(n.hash, storage, agg, isStorage, visit)
 		}
 
-		return copyTrieNode(n.child, storage, batchWriter, append(agg, n.key...), isStorage)
+		return walkTrieNode(n.child, storage, append(agg, n.key...), isStorage, visit)
 	}
 
 	return nil
 }
 
+func CopyTrie(nodeHash []byte, storage Storage, newStorage Storage, agg []byte, isStorage bool) error {
+	batchWriter := newStorage.Batch()
+
+	err := walkTrie(nodeHash, storage, agg, isStorage, trieVisitor{
+		node: func(hash, data []byte) error {
+			//copy whole bytes of nodes
+			batchWriter.Put(hash, data)
+
+			return nil
+		},
+		code: func(hash types.Hash, code []byte) error {
+			batchWriter.Put(GetCodeKey(hash), code)
+
+			return nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return batchWriter.Write()
+}
+
 func HashChecker(stateRoot []byte, storage Storage) (types.Hash, error) {
 	node, _, err := GetNode(stateRoot, storage)
 	if err != nil {
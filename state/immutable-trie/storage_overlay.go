@@ -0,0 +1,53 @@
+package itrie
+
+import (
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// overlayStorage keeps the writes in memory on top of a read-only base storage
+type overlayStorage struct {
+	base   Storage
+	memory *memStorage
+}
+
+// NewOverlayStorage creates a trie storage which reads through to the base storage
+// and keeps its writes in memory, the base storage is never modified.
+// It is used to execute the state transitions which are thrown away (e.g. the pending block)
+func NewOverlayStorage(base Storage) Storage {
+	memory, _ := NewMemoryStorage().(*memStorage)
+
+	return &overlayStorage{base: base, memory: memory}
+}
+
+func (o *overlayStorage) Put(k, v []byte) error {
+	return o.memory.Put(k, v)
+}
+
+func (o *overlayStorage) Get(k []byte) ([]byte, bool, error) {
+	if v, ok, _ := o.memory.Get(k); ok {
+		return v, true, nil
+	}
+
+	return o.base.Get(k)
+}
+
+func (o *overlayStorage) Batch() Batch {
+	return &memBatch{db: &o.memory.db, l: o.memory.l}
+}
+
+func (o *overlayStorage) SetCode(hash types.Hash, code []byte) error {
+	return o.memory.SetCode(hash, code)
+}
+
+func (o *overlayStorage) GetCode(hash types.Hash) ([]byte, bool) {
+	if code, ok := o.memory.GetCode(hash); ok {
+		return code, true
+	}
+
+	return o.base.GetCode(hash)
+}
+
+// Close doesn't close the base storage
+func (o *overlayStorage) Close() error {
+	return nil
+}
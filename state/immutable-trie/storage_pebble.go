@@ -0,0 +1,80 @@
+package itrie
+
+import (
+	"errors"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/pebble"
+	"github.com/0xPolygon/polygon-edge/types"
+	pebbledb "github.com/cockroachdb/pebble"
+	"github.com/hashicorp/go-hclog"
+)
+
+// PebbleStorage is a k/v storage for the trie using pebble
+type PebbleStorage struct {
+	db *pebbledb.DB
+}
+
+// PebbleBatch is a batch write for pebble
+type PebbleBatch struct {
+	batch *pebbledb.Batch
+}
+
+func (b *PebbleBatch) Put(k, v []byte) {
+	_ = b.batch.Set(k, v, nil)
+}
+
+func (b *PebbleBatch) Write() error {
+	return b.batch.Commit(pebbledb.NoSync)
+}
+
+func (kv *PebbleStorage) SetCode(hash types.Hash, code []byte) error {
+	return kv.Put(GetCodeKey(hash), code)
+}
+
+func (kv *PebbleStorage) GetCode(hash types.Hash) ([]byte, bool) {
+	res, ok, err := kv.Get(GetCodeKey(hash))
+	if err != nil {
+		return nil, false
+	}
+
+	return res, ok
+}
+
+func (kv *PebbleStorage) Batch() Batch {
+	return &PebbleBatch{batch: kv.db.NewBatch()}
+}
+
+func (kv *PebbleStorage) Put(k, v []byte) error {
+	return kv.db.Set(k, v, pebbledb.NoSync)
+}
+
+func (kv *PebbleStorage) Get(k []byte) ([]byte, bool, error) {
+	data, closer, err := kv.db.Get(k)
+	if err != nil {
+		if errors.Is(err, pebbledb.ErrNotFound) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	defer closer.Close()
+
+	res := make([]byte, len(data))
+	copy(res, data)
+
+	return res, true, nil
+}
+
+func (kv *PebbleStorage) Close() error {
+	return kv.db.Close()
+}
+
+func NewPebbleStorage(path string, logger hclog.Logger) (Storage, error) {
+	db, err := pebble.OpenDB(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PebbleStorage{db}, nil
+}
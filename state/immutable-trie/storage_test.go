@@ -0,0 +1,111 @@
+package itrie
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestStorage_Engines(t *testing.T) {
+	t.Parallel()
+
+	engines := map[string]func(path string) (Storage, error){
+		"leveldb": func(path string) (Storage, error) {
+			return NewLevelDBStorage(path, hclog.NewNullLogger())
+		},
+		"pebble": func(path string) (Storage, error) {
+			return NewPebbleStorage(path, hclog.NewNullLogger())
+		},
+		"memory": func(string) (Storage, error) {
+			return NewMemoryStorage(), nil
+		},
+	}
+
+	for name, open := range engines {
+		open := open
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := open(t.TempDir())
+			require.NoError(t, err)
+
+			defer s.Close()
+
+			_, ok, err := s.Get([]byte("missing"))
+			require.NoError(t, err)
+			assert.False(t, ok)
+
+			require.NoError(t, s.Put([]byte("k1"), []byte("v1")))
+
+			batch := s.Batch()
+			batch.Put([]byte("k2"), []byte("v2"))
+			require.NoError(t, batch.Write())
+
+			for k, v := range map[string]string{"k1": "v1", "k2": "v2"} {
+				res, ok, err := s.Get([]byte(k))
+				require.NoError(t, err)
+				assert.True(t, ok)
+				assert.Equal(t, []byte(v), res)
+			}
+
+			hash := types.StringToHash("0x1")
+			require.NoError(t, s.SetCode(hash, []byte{0x1, 0x2}))
+
+			code, ok := s.GetCode(hash)
+			assert.True(t, ok)
+			assert.Equal(t, []byte{0x1, 0x2}, code)
+		})
+	}
+}
+
+func TestStorage_Overlay(t *testing.T) {
+	t.Parallel()
+
+	base := NewMemoryStorage()
+	require.NoError(t, base.Put([]byte("k1"), []byte("v1")))
+
+	s := NewOverlayStorage(base)
+
+	// the base is read through
+	res, ok, err := s.Get([]byte("k1"))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("v1"), res)
+
+	require.NoError(t, s.Put([]byte("k1"), []byte("v2")))
+
+	batch := s.Batch()
+	batch.Put([]byte("k2"), []byte("v2"))
+	require.NoError(t, batch.Write())
+
+	hash := types.StringToHash("0x1")
+	require.NoError(t, s.SetCode(hash, []byte{0x1}))
+
+	for k, v := range map[string]string{"k1": "v2", "k2": "v2"} {
+		res, ok, err := s.Get([]byte(k))
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, []byte(v), res)
+	}
+
+	code, ok := s.GetCode(hash)
+	assert.True(t, ok)
+	assert.Equal(t, []byte{0x1}, code)
+
+	// the writes are not applied to the base
+	res, _, err = base.Get([]byte("k1"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("v1"), res)
+
+	_, ok, err = base.Get([]byte("k2"))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, ok = base.GetCode(hash)
+	assert.False(t, ok)
+}
@@ -0,0 +1,39 @@
+package itrie
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// VerifyTrie walks the whole state trie with the given root, including the accounts storage tries,
+// and checks that every node and contract code is present in the storage and matches its hash
+func VerifyTrie(stateRoot types.Hash, storage Storage) error {
+	if stateRoot == types.EmptyRootHash || stateRoot == types.ZeroHash {
+		return nil
+	}
+
+	return walkTrie(stateRoot.Bytes(), storage, nil, false, trieVisitor{
+		node: func(hash, data []byte) error {
+			if data == nil {
+				return fmt.Errorf("missing trie node %s", hex.EncodeToString(hash))
+			}
+
+			if !bytes.Equal(crypto.Keccak256(data), hash) {
+				return fmt.Errorf("trie node %s does not match its hash", hex.EncodeToString(hash))
+			}
+
+			return nil
+		},
+		code: func(hash types.Hash, code []byte) error {
+			if crypto.Keccak256Hash(code) != hash {
+				return fmt.Errorf("code %s does not match its hash", hash)
+			}
+
+			return nil
+		},
+	})
+}
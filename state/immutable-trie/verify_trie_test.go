@@ -0,0 +1,49 @@
+package itrie
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestVerifyTrie(t *testing.T) {
+	t.Parallel()
+
+	storage := NewMemoryStorage()
+	executor := state.NewExecutor(&chain.Params{Forks: chain.AllForksEnabled}, NewState(storage), hclog.NewNullLogger())
+
+	contract := types.StringToAddress("0x1")
+	stateRoot, err := executor.WriteGenesis(map[types.Address]*chain.GenesisAccount{
+		types.StringToAddress("0x2"): {Balance: big.NewInt(100)},
+		contract: {
+			Balance: big.NewInt(1),
+			Code:    []byte{0x60, 0x00},
+			Storage: map[types.Hash]types.Hash{
+				types.StringToHash("0x1"): types.StringToHash("0x2"),
+			},
+		},
+	}, types.ZeroHash)
+	require.NoError(t, err)
+
+	require.NoError(t, VerifyTrie(stateRoot, storage))
+	require.NoError(t, VerifyTrie(types.EmptyRootHash, storage))
+
+	// unknown root
+	require.ErrorContains(t, VerifyTrie(types.StringToHash("0xff"), storage), "missing trie node")
+
+	// missing contract code
+	mem, ok := storage.(*memStorage)
+	require.True(t, ok)
+
+	delete(mem.db, hex.EncodeToHex(GetCodeKey(types.BytesToHash(crypto.Keccak256([]byte{0x60, 0x00})))))
+
+	require.ErrorContains(t, VerifyTrie(stateRoot, storage), "can't find code")
+}
@@ -461,11 +461,48 @@ func opMStore8(c *state) {
 
 // --- storage ---
 
+// access costs introduced by eip-2929
+const (
+	warmStorageReadCost   uint64 = 100
+	coldAccountAccessCost uint64 = 2600
+	coldSloadCost         uint64 = 2100
+)
+
+// accountAccessCost returns the eip-2929 cost of accessing the account
+// and marks the account as accessed in the current transaction
+func (c *state) accountAccessCost(addr types.Address) uint64 {
+	if c.host.AddressInAccessList(addr) {
+		return warmStorageReadCost
+	}
+
+	c.host.AddAddressToAccessList(addr)
+
+	return coldAccountAccessCost
+}
+
+// slotAccessCost returns the eip-2929 surcharge of accessing a cold storage slot
+// and marks the slot as accessed in the current transaction
+func (c *state) slotAccessCost(slot types.Hash) uint64 {
+	if _, slotOk := c.host.SlotInAccessList(c.msg.Address, slot); slotOk {
+		return 0
+	}
+
+	c.host.AddSlotToAccessList(c.msg.Address, slot)
+
+	return coldSloadCost
+}
+
 func opSload(c *state) {
 	loc := c.top()
 
 	var gas uint64
-	if c.config.Istanbul {
+	if c.config.Berlin {
+		// eip-2929
+		gas = warmStorageReadCost
+		if coldCost := c.slotAccessCost(bigToHash(loc)); coldCost != 0 {
+			gas = coldCost
+		}
+	} else if c.config.Istanbul {
 		// eip-1884
 		gas = 800
 	} else if c.config.EIP150 {
@@ -500,12 +537,19 @@ func opSStore(c *state) {
 
 	legacyGasMetering := !c.config.Istanbul && (c.config.Petersburg || !c.config.Constantinople)
 
-	status := c.host.SetStorage(c.msg.Address, key, val, c.config)
 	cost := uint64(0)
+	if c.config.Berlin {
+		// eip-2929
+		cost = c.slotAccessCost(key)
+	}
+
+	status := c.host.SetStorage(c.msg.Address, key, val, c.config)
 
 	switch status {
 	case runtime.StorageUnchanged:
-		if c.config.Istanbul {
+		if c.config.Berlin {
+			cost += warmStorageReadCost
+		} else if c.config.Istanbul {
 			// eip-2200
 			cost = 800
 		} else if legacyGasMetering {
@@ -514,11 +558,17 @@ func opSStore(c *state) {
 			cost = 200
 		}
 
-	case runtime.StorageModified:
-		cost = 5000
+	case runtime.StorageModified, runtime.StorageDeleted:
+		if c.config.Berlin {
+			cost += 5000 - coldSloadCost
+		} else {
+			cost = 5000
+		}
 
 	case runtime.StorageModifiedAgain:
-		if c.config.Istanbul {
+		if c.config.Berlin {
+			cost += warmStorageReadCost
+		} else if c.config.Istanbul {
 			// eip-2200
 			cost = 800
 		} else if legacyGasMetering {
@@ -528,10 +578,7 @@ func opSStore(c *state) {
 		}
 
 	case runtime.StorageAdded:
-		cost = 20000
-
-	case runtime.StorageDeleted:
-		cost = 5000
+		cost += 20000
 	}
 
 	if !c.consumeGas(cost) {
@@ -575,7 +622,10 @@ func opBalance(c *state) {
 	addr, _ := c.popAddr()
 
 	var gas uint64
-	if c.config.Istanbul {
+	if c.config.Berlin {
+		// eip-2929
+		gas = c.accountAccessCost(addr)
+	} else if c.config.Istanbul {
 		// eip-1884
 		gas = 700
 	} else if c.config.EIP150 {
@@ -658,7 +708,10 @@ func opExtCodeSize(c *state) {
 	addr, _ := c.popAddr()
 
 	var gas uint64
-	if c.config.EIP150 {
+	if c.config.Berlin {
+		// eip-2929
+		gas = c.accountAccessCost(addr)
+	} else if c.config.EIP150 {
 		gas = 700
 	} else {
 		gas = 20
@@ -693,7 +746,10 @@ func opExtCodeHash(c *state) {
 	address, _ := c.popAddr()
 
 	var gas uint64
-	if c.config.Istanbul {
+	if c.config.Berlin {
+		// eip-2929
+		gas = c.accountAccessCost(address)
+	} else if c.config.Istanbul {
 		gas = 700
 	} else {
 		gas = 400
@@ -767,7 +823,10 @@ func opExtCodeCopy(c *state) {
 	}
 
 	var gas uint64
-	if c.config.EIP150 {
+	if c.config.Berlin {
+		// eip-2929
+		gas = c.accountAccessCost(address)
+	} else if c.config.EIP150 {
 		gas = 700
 	} else {
 		gas = 20
@@ -943,6 +1002,13 @@ func opSelfDestruct(c *state) {
 		}
 	}
 
+	// eip-2929: accessing a cold beneficiary is charged on top
+	if c.config.Berlin && !c.host.AddressInAccessList(address) {
+		c.host.AddAddressToAccessList(address)
+
+		gas += coldAccountAccessCost
+	}
+
 	if !c.consumeGas(gas) {
 		return
 	}
@@ -1226,7 +1292,10 @@ func (c *state) buildCallContract(op OpCode) (*runtime.Contract, uint64, uint64,
 	}
 
 	var gasCost uint64
-	if c.config.EIP150 {
+	if c.config.Berlin {
+		// eip-2929
+		gasCost = c.accountAccessCost(addr)
+	} else if c.config.EIP150 {
 		gasCost = 700
 	} else {
 		gasCost = 40
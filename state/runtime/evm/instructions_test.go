@@ -16,6 +16,14 @@ var (
 	two = big.NewInt(2)
 
 	allEnabledForks = chain.AllForksEnabled.At(0)
+
+	// berlinForks enables the opt-in Berlin fork as well
+	berlinForks = func() chain.ForksInTime {
+		forks := allEnabledForks
+		forks.Berlin = true
+
+		return forks
+	}()
 )
 
 type cases2To1 []struct {
@@ -107,6 +115,35 @@ type mockHostForInstructions struct {
 	nonce       uint64
 	code        []byte
 	callxResult *runtime.ExecutionResult
+	accessList  map[types.Address]map[types.Hash]struct{}
+}
+
+func (m *mockHostForInstructions) AddressInAccessList(addr types.Address) bool {
+	_, ok := m.accessList[addr]
+
+	return ok
+}
+
+func (m *mockHostForInstructions) SlotInAccessList(addr types.Address, slot types.Hash) (bool, bool) {
+	slots, addressOk := m.accessList[addr]
+	_, slotOk := slots[slot]
+
+	return addressOk, slotOk
+}
+
+func (m *mockHostForInstructions) AddAddressToAccessList(addr types.Address) {
+	if m.accessList == nil {
+		m.accessList = map[types.Address]map[types.Hash]struct{}{}
+	}
+
+	if _, ok := m.accessList[addr]; !ok {
+		m.accessList[addr] = map[types.Hash]struct{}{}
+	}
+}
+
+func (m *mockHostForInstructions) AddSlotToAccessList(addr types.Address, slot types.Hash) {
+	m.AddAddressToAccessList(addr)
+	m.accessList[addr][slot] = struct{}{}
 }
 
 func (m *mockHostForInstructions) GetNonce(types.Address) uint64 {
@@ -705,6 +742,38 @@ func Test_opCall(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "should charge the cold account access (Berlin fork enabled)",
+			op:   STATICCALL,
+			contract: &runtime.Contract{
+				Static: true,
+			},
+			config: berlinForks,
+			initState: &state{
+				gas: 2900,
+				sp:  6,
+				stack: []*big.Int{
+					big.NewInt(0x00), // outSize
+					big.NewInt(0x02), // outOffset
+					big.NewInt(0x00), // inSize
+					big.NewInt(0x00), // inOffset
+					big.NewInt(0x00), // address
+					big.NewInt(0x00), // initialGas
+				},
+				memory: []byte{0x01},
+			},
+			resultState: &state{
+				memory: []byte{0x01},
+				stop:   false,
+				err:    nil,
+				gas:    300,
+			},
+			mockHost: &mockHostForInstructions{
+				callxResult: &runtime.ExecutionResult{
+					ReturnValue: []byte{0x03},
+				},
+			},
+		},
 		{
 			name: "call cost overflow (EIP150 fork disabled)",
 			op:   CALLCODE,
@@ -797,3 +866,26 @@ func Test_opCall(t *testing.T) {
 		})
 	}
 }
+
+func TestAccessCosts(t *testing.T) {
+	t.Parallel()
+
+	s, closeFn := getState()
+	defer closeFn()
+
+	s.msg = &runtime.Contract{Address: addr1}
+	s.host = &mockHostForInstructions{}
+
+	addr2 := types.StringToAddress("2")
+	slot := types.StringToHash("1")
+
+	// the first access is cold, the following ones are warm
+	assert.Equal(t, coldAccountAccessCost, s.accountAccessCost(addr2))
+	assert.Equal(t, warmStorageReadCost, s.accountAccessCost(addr2))
+
+	assert.Equal(t, coldSloadCost, s.slotAccessCost(slot))
+	assert.Equal(t, uint64(0), s.slotAccessCost(slot))
+
+	// accessing a slot warms up the contract address too
+	assert.Equal(t, warmStorageReadCost, s.accountAccessCost(addr1))
+}
@@ -16,12 +16,15 @@ type modExp struct {
 
 var (
 	big1      = big.NewInt(1)
+	big3      = big.NewInt(3)
 	big4      = big.NewInt(4)
+	big7      = big.NewInt(7)
 	big8      = big.NewInt(8)
 	big16     = big.NewInt(16)
 	big32     = big.NewInt(32)
 	big64     = big.NewInt(64)
 	big96     = big.NewInt(96)
+	big200    = big.NewInt(200)
 	big480    = big.NewInt(480)
 	big1024   = big.NewInt(1024)
 	big3072   = big.NewInt(3072)
@@ -80,6 +83,14 @@ func multComplexity(x *big.Int) *big.Int {
 	return x
 }
 
+// multComplexityBerlin is the eip-2565 multiplication complexity: ceil(x / 8) ** 2
+func multComplexityBerlin(x *big.Int) *big.Int {
+	x.Add(x, big7)
+	x.Div(x, big8)
+
+	return x.Mul(x, x)
+}
+
 func (m *modExp) gas(input []byte, config *chain.ForksInTime) uint64 {
 	var val, tail []byte
 
@@ -118,7 +129,12 @@ func (m *modExp) gas(input []byte, config *chain.ForksInTime) uint64 {
 		gasCost.Set(baseLen)
 	}
 
-	gasCost = multComplexity(gasCost)
+	if config.Berlin {
+		// eip-2565
+		gasCost = multComplexityBerlin(gasCost)
+	} else {
+		gasCost = multComplexity(gasCost)
+	}
 
 	// a = a * max(ADJUSTED_EXPONENT_LENGTH, 1)
 	adjExpLen := adjustedExponentLength(expLen, expHead)
@@ -128,8 +144,17 @@ func (m *modExp) gas(input []byte, config *chain.ForksInTime) uint64 {
 		gasCost.Mul(gasCost, big1)
 	}
 
-	// a = a / div
-	gasCost.Div(gasCost, divisor)
+	if config.Berlin {
+		// a = max(200, a / 3)
+		gasCost.Div(gasCost, big3)
+
+		if gasCost.Cmp(big200) < 0 {
+			return 200
+		}
+	} else {
+		// a = a / div
+		gasCost.Div(gasCost, divisor)
+	}
 
 	// cap to the max uint64
 	if !gasCost.IsUint64() {
@@ -1,7 +1,11 @@
 package precompiled
 
 import (
+	"encoding/hex"
 	"testing"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/stretchr/testify/assert"
 )
 
 var modExpTests = []precompiledTest{
@@ -90,3 +94,49 @@ func TestModExp(t *testing.T) {
 	p := &Precompiled{}
 	testPrecompiled(t, &modExp{p}, modExpTests)
 }
+
+func TestModExpGas(t *testing.T) {
+	// expected gas costs before and after eip-2565 (Berlin)
+	expected := map[string]struct{ byzantium, berlin uint64 }{
+		"eip_example2":          {13056, 1360},
+		"nagydani-1-square":     {204, 200},
+		"nagydani-1-qube":       {204, 200},
+		"nagydani-1-pow0x10001": {3276, 341},
+		"nagydani-2-square":     {665, 200},
+		"nagydani-2-qube":       {665, 200},
+		"nagydani-2-pow0x10001": {10649, 1365},
+		"nagydani-3-square":     {1894, 341},
+		"nagydani-3-qube":       {1894, 341},
+		"nagydani-3-pow0x10001": {30310, 5461},
+		"nagydani-4-square":     {5580, 1365},
+		"nagydani-4-qube":       {5580, 1365},
+		"nagydani-4-pow0x10001": {89292, 21845},
+		"nagydani-5-square":     {17868, 5461},
+		"nagydani-5-qube":       {17868, 5461},
+		"nagydani-5-pow0x10001": {285900, 87381},
+	}
+
+	byzantium := chain.AllForksEnabled.At(0)
+
+	berlin := byzantium
+	berlin.Berlin = true
+
+	for _, c := range modExpTests {
+		c := c
+
+		t.Run(c.Name, func(t *testing.T) {
+			gas, ok := expected[c.Name]
+			if !ok {
+				t.Skip("no gas expectation")
+			}
+
+			input, err := hex.DecodeString(c.Input)
+			assert.NoError(t, err)
+
+			m := &modExp{&Precompiled{}}
+
+			assert.Equal(t, gas.byzantium, m.gas(input, &byzantium))
+			assert.Equal(t, gas.berlin, m.gas(input, &berlin))
+		})
+	}
+}
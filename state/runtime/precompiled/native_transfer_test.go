@@ -197,3 +197,15 @@ func (d dummyHost) GetTracer() runtime.VMTracer {
 func (d dummyHost) GetRefund() uint64 {
 	return 0
 }
+
+func (d dummyHost) AddressInAccessList(addr types.Address) bool {
+	return false
+}
+
+func (d dummyHost) SlotInAccessList(addr types.Address, slot types.Hash) (bool, bool) {
+	return false, false
+}
+
+func (d dummyHost) AddAddressToAccessList(addr types.Address) {}
+
+func (d dummyHost) AddSlotToAccessList(addr types.Address, slot types.Hash) {}
@@ -80,6 +80,10 @@ type Host interface {
 	Transfer(from types.Address, to types.Address, amount *big.Int) error
 	GetTracer() VMTracer
 	GetRefund() uint64
+	AddressInAccessList(addr types.Address) bool
+	SlotInAccessList(addr types.Address, slot types.Hash) (addressOk bool, slotOk bool)
+	AddAddressToAccessList(addr types.Address)
+	AddSlotToAccessList(addr types.Address, slot types.Hash)
 }
 
 type VMTracer interface {
@@ -272,13 +272,19 @@ func (txn *Txn) SetStorage(
 	if original == value {
 		if original == types.ZeroHash { // reset to original nonexistent slot (2.2.2.1)
 			// Storage was used as memory (allocation and deallocation occurred within the same contract)
-			if config.Istanbul {
+			if config.Berlin {
+				// eip-2929
+				txn.AddRefund(19900)
+			} else if config.Istanbul {
 				txn.AddRefund(19200)
 			} else {
 				txn.AddRefund(19800)
 			}
 		} else { // reset to original existing slot (2.2.2.2)
-			if config.Istanbul {
+			if config.Berlin {
+				// eip-2929
+				txn.AddRefund(2800)
+			} else if config.Istanbul {
 				txn.AddRefund(4200)
 			} else {
 				txn.AddRefund(4800)
@@ -578,8 +584,9 @@ func (txn *Txn) CleanDeleteObjects(deleteEmptyObjects bool) error {
 		txn.txn.Insert(k, obj2)
 	}
 
-	// delete refunds
+	// delete refunds and the access list
 	txn.txn.Delete(refundIndex)
+	txn.txn.Delete(accessListIndex)
 
 	return nil
 }
@@ -92,3 +92,38 @@ func TestIncrNonce(t *testing.T) {
 	require.NoError(t, txn.IncrNonce(address1))
 	require.Equal(t, nonMaxUint64NonceValue+1, txn.GetNonce(address1))
 }
+
+func TestAccessList(t *testing.T) {
+	t.Parallel()
+
+	txn := newTestTxn(defaultPreState)
+
+	txn.PrepareAccessList(addr1, nil, nil)
+	assert.True(t, txn.AddressInAccessList(addr1))
+	assert.False(t, txn.AddressInAccessList(addr2))
+
+	ss := txn.Snapshot()
+
+	txn.AddSlotToAccessList(addr2, hash1)
+
+	addressOk, slotOk := txn.SlotInAccessList(addr2, hash1)
+	assert.True(t, addressOk)
+	assert.True(t, slotOk)
+
+	addressOk, slotOk = txn.SlotInAccessList(addr2, hash2)
+	assert.True(t, addressOk)
+	assert.False(t, slotOk)
+
+	// the accesses are reverted along with the rest of the state
+	assert.NoError(t, txn.RevertToSnapshot(ss))
+	assert.True(t, txn.AddressInAccessList(addr1))
+	assert.False(t, txn.AddressInAccessList(addr2))
+
+	addressOk, slotOk = txn.SlotInAccessList(addr2, hash1)
+	assert.False(t, addressOk)
+	assert.False(t, slotOk)
+
+	// the access list does not survive the end of the transaction
+	txn.CleanDeleteObjects(true)
+	assert.False(t, txn.AddressInAccessList(addr1))
+}
@@ -0,0 +1,389 @@
+package tests
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/fastrlp"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	blockchainTests = "tests/BlockchainTests"
+
+	// maxExtraDataSize is the maximum size of the header extra data of the Ethereum mainnet
+	maxExtraDataSize = 32
+)
+
+var (
+	errInvalidTimestamp = errors.New("timestamp lower or equal than parent")
+	errExtraDataTooLong = errors.New("extra data too long")
+	errInvalidBaseFee   = errors.New("invalid base fee")
+
+	// block rewards of the Ethereum mainnet (in wei)
+	frontierBlockReward       = big.NewInt(5e18)
+	byzantiumBlockReward      = big.NewInt(3e18)
+	constantinopleBlockReward = big.NewInt(2e18)
+)
+
+type btCase struct {
+	Blocks        []btBlock                               `json:"blocks"`
+	Genesis       btHeader                                `json:"genesisBlockHeader"`
+	GenesisRLP    string                                  `json:"genesisRLP"`
+	LastBlockHash types.Hash                              `json:"lastblockhash"`
+	Network       string                                  `json:"network"`
+	Pre           map[types.Address]*chain.GenesisAccount `json:"pre"`
+	Post          map[types.Address]*chain.GenesisAccount `json:"postState"`
+	PostStateHash *types.Hash                             `json:"postStateHash"`
+}
+
+type btHeader struct {
+	Hash types.Hash `json:"hash"`
+}
+
+type btBlock struct {
+	RLP             string `json:"rlp"`
+	ExpectException string `json:"expectException"`
+}
+
+// btVerifier is a verification-only consensus which checks the header fields
+// that are not verified by the blockchain itself and pays the Ethereum mining rewards
+type btVerifier struct {
+	blockchain *blockchain.Blockchain
+	forks      *chain.Forks
+}
+
+func (v *btVerifier) VerifyHeader(header *types.Header) error {
+	parent, ok := v.blockchain.GetHeaderByHash(header.ParentHash)
+	if !ok {
+		return blockchain.ErrParentNotFound
+	}
+
+	if header.Timestamp <= parent.Timestamp {
+		return errInvalidTimestamp
+	}
+
+	if len(header.ExtraData) > maxExtraDataSize {
+		return errExtraDataTooLong
+	}
+
+	if expected := v.blockchain.CalculateBaseFee(parent); header.BaseFee != expected {
+		return fmt.Errorf("%w: expected %d but found %d", errInvalidBaseFee, expected, header.BaseFee)
+	}
+
+	return nil
+}
+
+func (v *btVerifier) ProcessHeaders(_ []*types.Header) error {
+	return nil
+}
+
+func (v *btVerifier) GetBlockCreator(header *types.Header) (types.Address, error) {
+	return types.BytesToAddress(header.Miner), nil
+}
+
+func (v *btVerifier) PreCommitState(block *types.Block, txn *state.Transition) error {
+	forks := v.forks.At(block.Number())
+
+	reward := frontierBlockReward
+	if forks.Constantinople {
+		reward = constantinopleBlockReward
+	} else if forks.Byzantium {
+		reward = byzantiumBlockReward
+	}
+
+	minerReward := new(big.Int).Set(reward)
+
+	for _, uncle := range block.Uncles {
+		// uncle reward = (uncle.Number + 8 - block.Number) * reward / 8
+		uncleReward := new(big.Int).SetUint64(uncle.Number + 8 - block.Number())
+		uncleReward.Mul(uncleReward, reward)
+		uncleReward.Div(uncleReward, big.NewInt(8))
+
+		txn.Txn().AddBalance(types.BytesToAddress(uncle.Miner), uncleReward)
+
+		// the miner gets 1/32 of the reward for each included uncle
+		minerReward.Add(minerReward, new(big.Int).Div(reward, big.NewInt(32)))
+	}
+
+	txn.Txn().AddBalance(types.BytesToAddress(block.Header.Miner), minerReward)
+
+	return nil
+}
+
+// newBlockchainFromFixture builds a blockchain with an in-memory storage out of the fixture genesis
+func newBlockchainFromFixture(c *btCase, forks *chain.Forks) (*blockchain.Blockchain, *state.Executor, error) {
+	genesisBlock := &types.Block{}
+	if err := genesisBlock.UnmarshalRLP(hex.MustDecodeHex(c.GenesisRLP)); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode the genesis block: %w", err)
+	}
+
+	params := &chain.Params{
+		Forks:   forks,
+		ChainID: 1,
+		BurnContract: map[uint64]types.Address{
+			0: types.ZeroAddress,
+		},
+	}
+
+	logger := hclog.NewNullLogger()
+	executor := state.NewExecutor(params, itrie.NewState(itrie.NewMemoryStorage()), logger)
+
+	stateRoot, err := executor.WriteGenesis(c.Pre, types.ZeroHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	header := genesisBlock.Header
+	if stateRoot != header.StateRoot {
+		return nil, nil, fmt.Errorf("genesis state root mismatch: expected %s but found %s", header.StateRoot, stateRoot)
+	}
+
+	genesis := &chain.Genesis{
+		Nonce:              header.Nonce,
+		Timestamp:          header.Timestamp,
+		ExtraData:          header.ExtraData,
+		GasLimit:           header.GasLimit,
+		Difficulty:         header.Difficulty,
+		Mixhash:            header.MixHash,
+		Coinbase:           types.BytesToAddress(header.Miner),
+		Alloc:              c.Pre,
+		BaseFee:            header.BaseFee,
+		BaseFeeEM:          chain.GenesisBaseFeeEM,
+		BaseFeeChangeDenom: chain.BaseFeeChangeDenom,
+		StateRoot:          stateRoot,
+		Number:             header.Number,
+		GasUsed:            header.GasUsed,
+		ParentHash:         header.ParentHash,
+	}
+
+	db, err := memory.NewMemoryStorage(logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	verifier := &btVerifier{forks: forks}
+
+	bc, err := blockchain.NewBlockchain(
+		logger,
+		db,
+		&chain.Chain{Genesis: genesis, Params: params},
+		verifier,
+		executor,
+		crypto.NewSigner(forks.At(0), uint64(params.ChainID)),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	verifier.blockchain = bc
+	executor.GetHash = bc.GetHashHelper
+
+	if err := bc.ComputeGenesis(); err != nil {
+		return nil, nil, err
+	}
+
+	return bc, executor, nil
+}
+
+// blockTxCount returns the number of the block transactions, without decoding them
+func blockTxCount(rlp string) (int, error) {
+	input, err := hex.DecodeHex(rlp)
+	if err != nil {
+		return 0, err
+	}
+
+	p := &fastrlp.Parser{}
+
+	v, err := p.Parse(input)
+	if err != nil {
+		return 0, err
+	}
+
+	if v.Elems() < 2 {
+		return 0, fmt.Errorf("incorrect number of block elements: %d", v.Elems())
+	}
+
+	return v.Get(1).Elems(), nil
+}
+
+// importBlock verifies the block against the local chain and writes it
+func importBlock(bc *blockchain.Blockchain, rlp string) error {
+	input, err := hex.DecodeHex(rlp)
+	if err != nil {
+		return err
+	}
+
+	block := &types.Block{}
+	if err := block.UnmarshalRLP(input); err != nil {
+		return err
+	}
+
+	fullBlock, err := bc.VerifyFinalizedBlock(block)
+	if err != nil {
+		return err
+	}
+
+	return bc.WriteFullBlock(fullBlock, "blockchain-test")
+}
+
+// checkPostState makes sure the state at the given root matches the expected accounts
+func checkPostState(executor *state.Executor, root types.Hash, post map[types.Address]*chain.GenesisAccount) error {
+	snap, err := executor.StateAt(root)
+	if err != nil {
+		return err
+	}
+
+	for addr, expected := range post {
+		account, err := snap.GetAccount(addr)
+		if err != nil {
+			return fmt.Errorf("account %s not found: %w", addr, err)
+		}
+
+		if account.Nonce != expected.Nonce {
+			return fmt.Errorf("nonce mismatch for %s: expected %d but found %d", addr, expected.Nonce, account.Nonce)
+		}
+
+		if account.Balance.Cmp(expected.Balance) != 0 {
+			return fmt.Errorf("balance mismatch for %s: expected %s but found %s", addr, expected.Balance, account.Balance)
+		}
+
+		if len(expected.Code) != 0 {
+			code, _ := snap.GetCode(types.BytesToHash(account.CodeHash))
+			if !strings.EqualFold(hex.EncodeToHex(code), hex.EncodeToHex(expected.Code)) {
+				return fmt.Errorf("code mismatch for %s", addr)
+			}
+		}
+
+		for key, value := range expected.Storage {
+			if found := snap.GetStorage(addr, account.Root, key); found != value {
+				return fmt.Errorf("storage mismatch for %s at %s: expected %s but found %s", addr, key, value, found)
+			}
+		}
+	}
+
+	return nil
+}
+
+func RunBlockchainTest(t *testing.T, file, name string, c *btCase) {
+	t.Helper()
+
+	config, ok := Forks[c.Network]
+	if !ok {
+		t.Skipf("%s fork is not supported", c.Network)
+
+		return
+	}
+
+	// the headers before London have no base fee, which the local header encoding always includes,
+	// so the hashes of their blocks differ from the fixtures
+	if !config.IsActive(chain.London, 0) {
+		t.Skipf("%s header encoding is not supported", c.Network)
+
+		return
+	}
+
+	// the transaction fees are distributed in a different way in Hydragon,
+	// so the post state of the blocks with transactions differs from the fixtures
+	for i, b := range c.Blocks {
+		if b.ExpectException != "" {
+			continue
+		}
+
+		count, err := blockTxCount(b.RLP)
+		require.NoError(t, err, "block %d (%s %s)", i, file, name)
+
+		if count > 0 {
+			t.Skip("Blocks with transactions are not supported")
+
+			return
+		}
+	}
+
+	bc, executor, err := newBlockchainFromFixture(c, config)
+	require.NoError(t, err, "%s %s", file, name)
+
+	if genesis := bc.Genesis(); genesis != c.Genesis.Hash {
+		t.Fatalf("genesis mismatch (%s %s): expected %s but found %s", file, name, c.Genesis.Hash, genesis)
+	}
+
+	for i, b := range c.Blocks {
+		err := importBlock(bc, b.RLP)
+
+		if b.ExpectException != "" {
+			if err == nil {
+				t.Fatalf("block %d (%s %s) expected to fail with %s", i, file, name, b.ExpectException)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("failed to import block %d (%s %s): %v", i, file, name, err)
+		}
+	}
+
+	head := bc.Header()
+	if head.Hash != c.LastBlockHash {
+		t.Fatalf("head mismatch (%s %s): expected %s but found %s", file, name, c.LastBlockHash, head.Hash)
+	}
+
+	if c.PostStateHash != nil && head.StateRoot != *c.PostStateHash {
+		t.Fatalf("state root mismatch (%s %s): expected %s but found %s", file, name, c.PostStateHash, head.StateRoot)
+	}
+
+	if err := checkPostState(executor, head.StateRoot, c.Post); err != nil {
+		t.Fatalf("post state mismatch (%s %s): %v", file, name, err)
+	}
+}
+
+func TestBlockchain(t *testing.T) {
+	t.Parallel()
+
+	long := []string{
+		"bcExploitTest",
+		"bcWalletTest",
+		"stQuadraticComplexityTest",
+		"stTimeConsuming",
+	}
+
+	skip := []string{
+		// side chains with a lower number than the current head are not imported by the blockchain
+		"bcForkStressTest",
+		"bcMultiChainTest",
+		"bcTotalDifficultyTest",
+	}
+
+	folders, err := listFolders(blockchainTests)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	runFixtures(t, folders, long, skip, func(t *testing.T, file string, data []byte) {
+		var testCases map[string]*btCase
+		if err := json.Unmarshal(data, &testCases); err != nil {
+			t.Fatalf("failed to unmarshal %s: %v", file, err)
+		}
+
+		for name, c := range testCases {
+			name, c := name, c
+			t.Run(name, func(t *testing.T) {
+				RunBlockchainTest(t, file, name, c)
+			})
+		}
+	})
+}
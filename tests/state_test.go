@@ -3,9 +3,8 @@ package tests
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"math/big"
-	"os"
-	"strings"
 	"testing"
 
 	"github.com/0xPolygon/polygon-edge/chain"
@@ -143,51 +142,21 @@ func TestState(t *testing.T) {
 	// so ethereum state tests are not valid anymore
 	folders := []string{}
 
-	for _, folder := range folders {
-		folder := folder
-		t.Run(folder, func(t *testing.T) {
-			t.Parallel()
-
-			files, err := listFiles(folder)
-			if err != nil {
-				t.Fatal(err)
-			}
-
-			for _, file := range files {
-				if !strings.HasSuffix(file, ".json") {
-					continue
-				}
-
-				if contains(long, file) && testing.Short() {
-					t.Skipf("Long tests are skipped in short mode")
-
-					continue
-				}
-
-				if contains(skip, file) {
-					t.Skip()
-
-					continue
-				}
-
-				data, err := os.ReadFile(file)
-				if err != nil {
-					t.Fatal(err)
-				}
-
-				var testCases map[string]testCase
-				if err = json.Unmarshal(data, &testCases); err != nil {
-					t.Fatalf("failed to unmarshal %s: %v", file, err)
-				}
+	runFixtures(t, folders, long, skip, func(t *testing.T, file string, data []byte) {
+		var testCases map[string]testCase
+		if err := json.Unmarshal(data, &testCases); err != nil {
+			t.Fatalf("failed to unmarshal %s: %v", file, err)
+		}
 
-				for name, i := range testCases {
-					for fork, f := range i.Post {
-						for indx, e := range f {
-							RunSpecificTest(t, file, i, name, fork, indx, e)
-						}
-					}
+		for name, i := range testCases {
+			for fork, f := range i.Post {
+				for indx, e := range f {
+					name, i, fork, indx, e := name, i, fork, indx, e
+					t.Run(fmt.Sprintf("%s/%s/%d", name, fork, indx), func(t *testing.T) {
+						RunSpecificTest(t, file, i, name, fork, indx, e)
+					})
 				}
 			}
-		})
-	}
+		}
+	})
 }
@@ -7,6 +7,7 @@ import (
 	"io/fs"
 	"math/big"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -436,7 +437,7 @@ var Forks = map[string]*chain.Forks{
 		chain.Byzantium:      chain.NewFork(0),
 		chain.Constantinople: chain.NewFork(0),
 	},
-	"Istchain.anbul": {
+	"Istanbul": {
 		chain.Homestead:      chain.NewFork(0),
 		chain.EIP150:         chain.NewFork(0),
 		chain.EIP155:         chain.NewFork(0),
@@ -446,6 +447,18 @@ var Forks = map[string]*chain.Forks{
 		chain.Petersburg:     chain.NewFork(0),
 		chain.Istanbul:       chain.NewFork(0),
 	},
+	"London": {
+		chain.Homestead:      chain.NewFork(0),
+		chain.EIP150:         chain.NewFork(0),
+		chain.EIP155:         chain.NewFork(0),
+		chain.EIP158:         chain.NewFork(0),
+		chain.Byzantium:      chain.NewFork(0),
+		chain.Constantinople: chain.NewFork(0),
+		chain.Petersburg:     chain.NewFork(0),
+		chain.Istanbul:       chain.NewFork(0),
+		chain.Berlin:         chain.NewFork(0),
+		chain.London:         chain.NewFork(0),
+	},
 	"FrontierToHomesteadAt5": {
 		chain.Homestead: chain.NewFork(5),
 	},
@@ -491,26 +504,22 @@ func contains(l []string, name string) bool {
 //go:embed tests
 var testsFS embed.FS
 
+// listFolders returns the top level folders of the given test suites.
+// The files of their nested folders are returned by listFiles
 func listFolders(tests ...string) ([]string, error) {
 	var folders []string
 
 	for _, t := range tests {
-		if err := fs.WalkDir(testsFS, t, func(path string, d fs.DirEntry, err error) error {
-			if err != nil {
-				return err
-			}
-
-			if d.IsDir() && t != "path" {
-				folders = append(folders, path)
-			}
-
-			return nil
-		}); err != nil {
+		entries, err := fs.ReadDir(testsFS, t)
+		if err != nil {
 			return nil, err
 		}
 
-		// Excluding root dir
-		folders = folders[1:]
+		for _, entry := range entries {
+			if entry.IsDir() {
+				folders = append(folders, path.Join(t, entry.Name()))
+			}
+		}
 	}
 
 	return folders, nil
@@ -534,6 +543,48 @@ func listFiles(folder string) ([]string, error) {
 	return files, err
 }
 
+// runFixtures runs each json file of the given folders in its own subtest,
+// so skipping a file doesn't skip the rest of its folder
+func runFixtures(t *testing.T, folders, long, skip []string, run func(t *testing.T, file string, data []byte)) {
+	t.Helper()
+
+	for _, folder := range folders {
+		folder := folder
+		t.Run(folder, func(t *testing.T) {
+			t.Parallel()
+
+			files, err := listFiles(folder)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, file := range files {
+				if !strings.HasSuffix(file, ".json") {
+					continue
+				}
+
+				file := file
+				t.Run(strings.TrimPrefix(file, folder+"/"), func(t *testing.T) {
+					if contains(long, file) && testing.Short() {
+						t.Skipf("Long tests are skipped in short mode")
+					}
+
+					if contains(skip, file) {
+						t.Skip()
+					}
+
+					data, err := os.ReadFile(file)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					run(t, file, data)
+				})
+			}
+		})
+	}
+}
+
 func rlpHashLogs(logs []*types.Log) (res types.Hash) {
 	r := &types.Receipt{
 		Logs: logs,
@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"math"
 	"sync"
 
 	"github.com/0xPolygon/polygon-edge/types"
@@ -10,6 +11,11 @@ import (
 type lookupMap struct {
 	sync.RWMutex
 	all map[types.Hash]*types.Transaction
+
+	// arrivals keeps the order in which transactions entered the pool
+	arrivals map[types.Hash]uint64
+	// nextArrival is the sequence number assigned to the next added transaction
+	nextArrival uint64
 }
 
 // add inserts the given transaction into the map. Returns false
@@ -23,6 +29,8 @@ func (m *lookupMap) add(tx *types.Transaction) bool {
 	}
 
 	m.all[tx.Hash] = tx
+	m.arrivals[tx.Hash] = m.nextArrival
+	m.nextArrival++
 
 	return true
 }
@@ -34,6 +42,7 @@ func (m *lookupMap) remove(txs ...*types.Transaction) {
 
 	for _, tx := range txs {
 		delete(m.all, tx.Hash)
+		delete(m.arrivals, tx.Hash)
 	}
 }
 
@@ -49,3 +58,17 @@ func (m *lookupMap) get(hash types.Hash) (*types.Transaction, bool) {
 
 	return tx, true
 }
+
+// arrival returns the sequence number in which the given transaction entered the pool.
+// Unknown transactions are placed after all the known ones. [thread-safe]
+func (m *lookupMap) arrival(tx *types.Transaction) uint64 {
+	m.RLock()
+	defer m.RUnlock()
+
+	seq, ok := m.arrivals[tx.Hash]
+	if !ok {
+		return math.MaxUint64
+	}
+
+	return seq
+}
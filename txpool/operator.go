@@ -38,7 +38,7 @@ func (p *TxPool) AddTxn(ctx context.Context, raw *proto.AddTxnReq) (*proto.AddTx
 		txn.From = from
 	}
 
-	if err := p.AddTx(txn); err != nil {
+	if err := p.AddTx(ctx, txn); err != nil {
 		return nil, err
 	}
 
@@ -97,3 +97,80 @@ func (p *TxPool) TxPoolSubscribe(request *proto.SubscribeRequest) (<-chan *proto
 
 	return subscription.subscriptionChannel, cancelSubscription, nil
 }
+
+// Content implements the operator endpoint. Returns the pending and queued transactions of an account
+func (p *TxPool) Content(ctx context.Context, req *proto.ContentReq) (*proto.ContentResp, error) {
+	addr := types.Address{}
+	if err := addr.UnmarshalText([]byte(req.Address)); err != nil {
+		return nil, err
+	}
+
+	promoted, enqueued := p.GetAccountTxs(addr)
+
+	return &proto.ContentResp{
+		Pending: toTxnSummaries(p.GetBaseFee(), promoted),
+		Queued:  toTxnSummaries(p.GetBaseFee(), enqueued),
+	}, nil
+}
+
+// RemoveTxn implements the operator endpoint. Removes a transaction from the pool
+func (p *TxPool) RemoveTxn(ctx context.Context, req *proto.RemoveTxnReq) (*proto.RemoveTxnResp, error) {
+	txHash := types.Hash{}
+	if err := txHash.UnmarshalText([]byte(req.TxHash)); err != nil {
+		return nil, err
+	}
+
+	removed, err := p.RemoveTx(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.RemoveTxnResp{
+		TxHashes: toHashStrings(removed),
+	}, nil
+}
+
+// DropAccount implements the operator endpoint. Drops all the transactions of an account from the pool
+func (p *TxPool) DropAccount(ctx context.Context, req *proto.DropAccountReq) (*proto.DropAccountResp, error) {
+	addr := types.Address{}
+	if err := addr.UnmarshalText([]byte(req.Address)); err != nil {
+		return nil, err
+	}
+
+	dropped, err := p.DropAccountTxs(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.DropAccountResp{
+		TxHashes: toHashStrings(dropped),
+	}, nil
+}
+
+// toTxnSummaries converts the transactions to their operator summaries
+func toTxnSummaries(baseFee uint64, txs []*types.Transaction) []*proto.TxnSummary {
+	summaries := make([]*proto.TxnSummary, len(txs))
+
+	for i, tx := range txs {
+		summaries[i] = &proto.TxnSummary{
+			Hash:     tx.Hash.String(),
+			Nonce:    tx.Nonce,
+			Value:    tx.Value.String(),
+			Gas:      tx.Gas,
+			GasPrice: tx.GetGasPrice(baseFee).String(),
+		}
+	}
+
+	return summaries
+}
+
+// toHashStrings converts the transactions to the list of their hashes
+func toHashStrings(txs []*types.Transaction) []string {
+	hashes := make([]string, len(txs))
+
+	for i, tx := range txs {
+		hashes[i] = tx.Hash.String()
+	}
+
+	return hashes
+}
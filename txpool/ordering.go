@@ -0,0 +1,106 @@
+package txpool
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/forkmanager"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// TxOrderingPolicy defines the order in which executable
+// transactions are handed over to the block builder
+type TxOrderingPolicy string
+
+const (
+	// PriceOrdering orders transactions by effective tip (default)
+	PriceOrdering TxOrderingPolicy = "price"
+
+	// FIFOOrdering orders transactions by the time they entered the pool
+	FIFOOrdering TxOrderingPolicy = "fifo"
+
+	// FairOrdering takes one transaction per sender in each round (round-robin),
+	// ordering the senders inside a round by price
+	FairOrdering TxOrderingPolicy = "fair"
+
+	// PriorityOrdering puts transactions of the configured priority addresses first,
+	// ordering the rest by price
+	PriorityOrdering TxOrderingPolicy = "priority"
+)
+
+// ValidateTxOrderingPolicy checks if the given tx ordering policy is supported
+func ValidateTxOrderingPolicy(policy string) error {
+	switch TxOrderingPolicy(policy) {
+	case PriceOrdering, FIFOOrdering, FairOrdering, PriorityOrdering:
+		return nil
+	default:
+		return fmt.Errorf("unknown tx ordering policy: %s", policy)
+	}
+}
+
+// executablesQueue is the queue of primaries the block builder consumes
+type executablesQueue interface {
+	push(tx *types.Transaction)
+	pop() *types.Transaction
+	remove(txs ...*types.Transaction)
+	length() int
+}
+
+// getTxOrderingPolicy returns the tx ordering policy active for the block
+// that is built on top of the current head
+func (p *TxPool) getTxOrderingPolicy() TxOrderingPolicy {
+	params := forkmanager.GetInstance().GetParams(p.store.Header().Number + 1)
+	if params == nil || params.TxOrderingPolicy == nil {
+		return PriceOrdering
+	}
+
+	return TxOrderingPolicy(*params.TxOrderingPolicy)
+}
+
+// newExecutablesQueue creates the executables queue for the given policy
+// with the initial transactions (primaries)
+func (p *TxPool) newExecutablesQueue(policy TxOrderingPolicy, primaries []*types.Transaction) executablesQueue {
+	baseFee := new(big.Int).SetUint64(p.GetBaseFee())
+
+	switch policy {
+	case FIFOOrdering:
+		return newOrderedQueue(p.index.arrival, false, primaries, func(a, b *orderedTx) bool {
+			return a.arrival < b.arrival
+		})
+	case FairOrdering:
+		return newOrderedQueue(p.index.arrival, true, primaries, func(a, b *orderedTx) bool {
+			if a.round != b.round {
+				return a.round < b.round
+			}
+
+			return lessByPrice(a, b, baseFee)
+		})
+	case PriorityOrdering:
+		return newOrderedQueue(p.index.arrival, false, primaries, func(a, b *orderedTx) bool {
+			_, aPriority := p.priorityAddresses[a.tx.From]
+			_, bPriority := p.priorityAddresses[b.tx.From]
+
+			if aPriority != bPriority {
+				return aPriority
+			}
+
+			return lessByPrice(a, b, baseFee)
+		})
+	case PriceOrdering:
+		return newPricesQueue(p.GetBaseFee(), primaries)
+	default:
+		p.logger.Error("unknown tx ordering policy, falling back to price", "policy", policy)
+
+		return newPricesQueue(p.GetBaseFee(), primaries)
+	}
+}
+
+// lessByPrice orders by price (descending), breaking ties by arrival
+// so the resulting order is deterministic
+func lessByPrice(a, b *orderedTx, baseFee *big.Int) bool {
+	if c := cmp(a.tx, b.tx, baseFee); c != 0 {
+		return c > 0
+	}
+
+	return a.arrival < b.arrival
+}
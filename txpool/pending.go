@@ -0,0 +1,68 @@
+package txpool
+
+import (
+	"sort"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// PendingTxs is a snapshot of the promoted transactions of the pool.
+// It hands out transactions in the order of the active tx ordering policy
+// (same as Peek does for the block builder), but consuming it
+// does not modify the pool itself
+type PendingTxs struct {
+	// queue of the primaries sorted by the tx ordering policy
+	queue executablesQueue
+
+	// remaining promoted transactions of each account, sorted by nonce
+	txs map[types.Address][]*types.Transaction
+}
+
+// Pending returns a snapshot of the promoted transactions
+// which can be used to build a speculative (pending) block
+func (p *TxPool) Pending() *PendingTxs {
+	allPromoted, _ := p.accounts.allTxs(false)
+
+	primaries := make([]*types.Transaction, 0, len(allPromoted))
+	remaining := make(map[types.Address][]*types.Transaction, len(allPromoted))
+
+	for addr, promoted := range allPromoted {
+		// promoted queue is a heap, so its underlying slice is not sorted
+		txs := make([]*types.Transaction, len(promoted))
+		copy(txs, promoted)
+
+		sort.Slice(txs, func(i, j int) bool {
+			return txs[i].Nonce < txs[j].Nonce
+		})
+
+		primaries = append(primaries, txs[0])
+		remaining[addr] = txs[1:]
+	}
+
+	return &PendingTxs{
+		queue: p.newExecutablesQueue(p.getTxOrderingPolicy(), primaries),
+		txs:   remaining,
+	}
+}
+
+// Peek returns the next transaction ready for execution, nil if there are none left
+func (pt *PendingTxs) Peek() *types.Transaction {
+	return pt.queue.pop()
+}
+
+// Pop marks the given transaction as executed
+// and queues the next transaction of the same account (if any)
+func (pt *PendingTxs) Pop(tx *types.Transaction) {
+	txs := pt.txs[tx.From]
+	if len(txs) == 0 {
+		return
+	}
+
+	pt.queue.push(txs[0])
+	pt.txs[tx.From] = txs[1:]
+}
+
+// Drop discards the remaining transactions of the account associated with the given transaction
+func (pt *PendingTxs) Drop(tx *types.Transaction) {
+	delete(pt.txs, tx.From)
+}
@@ -343,6 +343,375 @@ func (x *TxPoolEvent) GetTxHash() string {
 	return ""
 }
 
+type ContentReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *ContentReq) Reset() {
+	*x = ContentReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_operator_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentReq) ProtoMessage() {}
+
+func (x *ContentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_operator_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentReq.ProtoReflect.Descriptor instead.
+func (*ContentReq) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ContentReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type ContentResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pending []*TxnSummary `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
+	Queued  []*TxnSummary `protobuf:"bytes,2,rep,name=queued,proto3" json:"queued,omitempty"`
+}
+
+func (x *ContentResp) Reset() {
+	*x = ContentResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_operator_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentResp) ProtoMessage() {}
+
+func (x *ContentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_operator_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentResp.ProtoReflect.Descriptor instead.
+func (*ContentResp) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ContentResp) GetPending() []*TxnSummary {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
+}
+
+func (x *ContentResp) GetQueued() []*TxnSummary {
+	if x != nil {
+		return x.Queued
+	}
+	return nil
+}
+
+type TxnSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash     string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Nonce    uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Value    string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Gas      uint64 `protobuf:"varint,4,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasPrice string `protobuf:"bytes,5,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+}
+
+func (x *TxnSummary) Reset() {
+	*x = TxnSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_operator_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxnSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxnSummary) ProtoMessage() {}
+
+func (x *TxnSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_operator_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxnSummary.ProtoReflect.Descriptor instead.
+func (*TxnSummary) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TxnSummary) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *TxnSummary) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *TxnSummary) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *TxnSummary) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *TxnSummary) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+type RemoveTxnReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash string `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+}
+
+func (x *RemoveTxnReq) Reset() {
+	*x = RemoveTxnReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_operator_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTxnReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTxnReq) ProtoMessage() {}
+
+func (x *RemoveTxnReq) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_operator_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTxnReq.ProtoReflect.Descriptor instead.
+func (*RemoveTxnReq) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RemoveTxnReq) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type RemoveTxnResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHashes []string `protobuf:"bytes,1,rep,name=txHashes,proto3" json:"txHashes,omitempty"`
+}
+
+func (x *RemoveTxnResp) Reset() {
+	*x = RemoveTxnResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_operator_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTxnResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTxnResp) ProtoMessage() {}
+
+func (x *RemoveTxnResp) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_operator_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTxnResp.ProtoReflect.Descriptor instead.
+func (*RemoveTxnResp) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RemoveTxnResp) GetTxHashes() []string {
+	if x != nil {
+		return x.TxHashes
+	}
+	return nil
+}
+
+type DropAccountReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *DropAccountReq) Reset() {
+	*x = DropAccountReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_operator_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DropAccountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropAccountReq) ProtoMessage() {}
+
+func (x *DropAccountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_operator_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropAccountReq.ProtoReflect.Descriptor instead.
+func (*DropAccountReq) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DropAccountReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type DropAccountResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHashes []string `protobuf:"bytes,1,rep,name=txHashes,proto3" json:"txHashes,omitempty"`
+}
+
+func (x *DropAccountResp) Reset() {
+	*x = DropAccountResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_operator_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DropAccountResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropAccountResp) ProtoMessage() {}
+
+func (x *DropAccountResp) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_operator_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropAccountResp.ProtoReflect.Descriptor instead.
+func (*DropAccountResp) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DropAccountResp) GetTxHashes() []string {
+	if x != nil {
+		return x.TxHashes
+	}
+	return nil
+}
+
 var File_txpool_proto_operator_proto protoreflect.FileDescriptor
 
 var file_txpool_proto_operator_proto_rawDesc = []byte{
@@ -374,27 +743,69 @@ var file_txpool_proto_operator_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
 	0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
 	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x2a, 0x76, 0x0a,
-	0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44,
-	0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x4e, 0x51, 0x55, 0x45, 0x55, 0x45,
-	0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10,
-	0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b,
-	0x0a, 0x07, 0x44, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x50,
-	0x52, 0x55, 0x4e, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x05,
-	0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x55, 0x4e, 0x45, 0x44, 0x5f, 0x45, 0x4e, 0x51, 0x55, 0x45,
-	0x55, 0x45, 0x44, 0x10, 0x06, 0x32, 0xa9, 0x01, 0x0a, 0x0f, 0x54, 0x78, 0x6e, 0x50, 0x6f, 0x6f,
-	0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x78, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x27, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x54, 0x78, 0x6e, 0x12, 0x0d, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x09, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30,
-	0x01, 0x42, 0x0f, 0x5a, 0x0d, 0x2f, 0x74, 0x78, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x42, 0x0a,
+	0x0a, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x34, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42,
+	0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x30, 0x78, 0x5b, 0x61, 0x2d, 0x66, 0x41, 0x2d, 0x46, 0x30,
+	0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x30, 0x7d, 0x24, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x5f, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x28, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x78, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x06, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x78, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x06, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x64, 0x22, 0x7a, 0x0a, 0x0a, 0x54, 0x78, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67,
+	0x61, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x42,
+	0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x32,
+	0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a,
+	0xfa, 0x42, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x30, 0x78, 0x5b, 0x61, 0x2d, 0x66, 0x41, 0x2d,
+	0x46, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x34, 0x7d, 0x24, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61,
+	0x73, 0x68, 0x22, 0x2b, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x78, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22,
+	0x46, 0x0a, 0x0e, 0x44, 0x72, 0x6f, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x34, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1a, 0xfa, 0x42, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x30, 0x78, 0x5b, 0x61,
+	0x2d, 0x66, 0x41, 0x2d, 0x46, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x30, 0x7d, 0x24, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x2d, 0x0a, 0x0f, 0x44, 0x72, 0x6f, 0x70, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x78,
+	0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x78,
+	0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x2a, 0x76, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x45, 0x4e, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x52,
+	0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4d, 0x4f, 0x54,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x55, 0x4e, 0x45, 0x44, 0x5f, 0x50,
+	0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x55,
+	0x4e, 0x45, 0x44, 0x5f, 0x45, 0x4e, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44, 0x10, 0x06, 0x32, 0xbf,
+	0x02, 0x0a, 0x0f, 0x54, 0x78, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x78, 0x6e, 0x50, 0x6f, 0x6f,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x27, 0x0a, 0x06, 0x41,
+	0x64, 0x64, 0x54, 0x78, 0x6e, 0x12, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x78,
+	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x78, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x78, 0x50,
+	0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x2a, 0x0a, 0x07, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x09, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x54, 0x78, 0x6e, 0x12, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
+	0x78, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x0b, 0x44, 0x72, 0x6f, 0x70,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x6f,
+	0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x72, 0x6f, 0x70, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x42, 0x0f, 0x5a, 0x0d, 0x2f, 0x74, 0x78, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -410,7 +821,7 @@ func file_txpool_proto_operator_proto_rawDescGZIP() []byte {
 }
 
 var file_txpool_proto_operator_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_txpool_proto_operator_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_txpool_proto_operator_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_txpool_proto_operator_proto_goTypes = []interface{}{
 	(EventType)(0),            // 0: v1.EventType
 	(*AddTxnReq)(nil),         // 1: v1.AddTxnReq
@@ -418,24 +829,39 @@ var file_txpool_proto_operator_proto_goTypes = []interface{}{
 	(*TxnPoolStatusResp)(nil), // 3: v1.TxnPoolStatusResp
 	(*SubscribeRequest)(nil),  // 4: v1.SubscribeRequest
 	(*TxPoolEvent)(nil),       // 5: v1.TxPoolEvent
-	(*anypb.Any)(nil),         // 6: google.protobuf.Any
-	(*emptypb.Empty)(nil),     // 7: google.protobuf.Empty
+	(*ContentReq)(nil),        // 6: v1.ContentReq
+	(*ContentResp)(nil),       // 7: v1.ContentResp
+	(*TxnSummary)(nil),        // 8: v1.TxnSummary
+	(*RemoveTxnReq)(nil),      // 9: v1.RemoveTxnReq
+	(*RemoveTxnResp)(nil),     // 10: v1.RemoveTxnResp
+	(*DropAccountReq)(nil),    // 11: v1.DropAccountReq
+	(*DropAccountResp)(nil),   // 12: v1.DropAccountResp
+	(*anypb.Any)(nil),         // 13: google.protobuf.Any
+	(*emptypb.Empty)(nil),     // 14: google.protobuf.Empty
 }
 var file_txpool_proto_operator_proto_depIdxs = []int32{
-	6, // 0: v1.AddTxnReq.raw:type_name -> google.protobuf.Any
-	0, // 1: v1.SubscribeRequest.types:type_name -> v1.EventType
-	0, // 2: v1.TxPoolEvent.type:type_name -> v1.EventType
-	7, // 3: v1.TxnPoolOperator.Status:input_type -> google.protobuf.Empty
-	1, // 4: v1.TxnPoolOperator.AddTxn:input_type -> v1.AddTxnReq
-	4, // 5: v1.TxnPoolOperator.Subscribe:input_type -> v1.SubscribeRequest
-	3, // 6: v1.TxnPoolOperator.Status:output_type -> v1.TxnPoolStatusResp
-	2, // 7: v1.TxnPoolOperator.AddTxn:output_type -> v1.AddTxnResp
-	5, // 8: v1.TxnPoolOperator.Subscribe:output_type -> v1.TxPoolEvent
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	13, // 0: v1.AddTxnReq.raw:type_name -> google.protobuf.Any
+	0,  // 1: v1.SubscribeRequest.types:type_name -> v1.EventType
+	0,  // 2: v1.TxPoolEvent.type:type_name -> v1.EventType
+	8,  // 3: v1.ContentResp.pending:type_name -> v1.TxnSummary
+	8,  // 4: v1.ContentResp.queued:type_name -> v1.TxnSummary
+	14, // 5: v1.TxnPoolOperator.Status:input_type -> google.protobuf.Empty
+	1,  // 6: v1.TxnPoolOperator.AddTxn:input_type -> v1.AddTxnReq
+	4,  // 7: v1.TxnPoolOperator.Subscribe:input_type -> v1.SubscribeRequest
+	6,  // 8: v1.TxnPoolOperator.Content:input_type -> v1.ContentReq
+	9,  // 9: v1.TxnPoolOperator.RemoveTxn:input_type -> v1.RemoveTxnReq
+	11, // 10: v1.TxnPoolOperator.DropAccount:input_type -> v1.DropAccountReq
+	3,  // 11: v1.TxnPoolOperator.Status:output_type -> v1.TxnPoolStatusResp
+	2,  // 12: v1.TxnPoolOperator.AddTxn:output_type -> v1.AddTxnResp
+	5,  // 13: v1.TxnPoolOperator.Subscribe:output_type -> v1.TxPoolEvent
+	7,  // 14: v1.TxnPoolOperator.Content:output_type -> v1.ContentResp
+	10, // 15: v1.TxnPoolOperator.RemoveTxn:output_type -> v1.RemoveTxnResp
+	12, // 16: v1.TxnPoolOperator.DropAccount:output_type -> v1.DropAccountResp
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_txpool_proto_operator_proto_init() }
@@ -504,6 +930,90 @@ func file_txpool_proto_operator_proto_init() {
 				return nil
 			}
 		}
+		file_txpool_proto_operator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_operator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_operator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxnSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_operator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTxnReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_operator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTxnResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_operator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropAccountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_operator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DropAccountResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -511,7 +1021,7 @@ func file_txpool_proto_operator_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_txpool_proto_operator_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
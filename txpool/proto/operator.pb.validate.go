@@ -615,3 +615,816 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = TxPoolEventValidationError{}
+
+// Validate checks the field values on ContentReq with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ContentReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ContentReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ContentReqMultiError, or
+// nil if none found.
+func (m *ContentReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ContentReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ContentReq_Address_Pattern.MatchString(m.GetAddress()) {
+		err := ContentReqValidationError{
+			field:  "Address",
+			reason: "value does not match regex pattern \"^0x[a-fA-F0-9]{40}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ContentReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContentReqMultiError is an error wrapping multiple validation errors
+// returned by ContentReq.ValidateAll() if the designated constraints aren't met.
+type ContentReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContentReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContentReqMultiError) AllErrors() []error { return m }
+
+// ContentReqValidationError is the validation error returned by
+// ContentReq.Validate if the designated constraints aren't met.
+type ContentReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContentReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContentReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContentReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContentReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContentReqValidationError) ErrorName() string { return "ContentReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ContentReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContentReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContentReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContentReqValidationError{}
+
+var _ContentReq_Address_Pattern = regexp.MustCompile("^0x[a-fA-F0-9]{40}$")
+
+// Validate checks the field values on ContentResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ContentResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ContentResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ContentRespMultiError, or
+// nil if none found.
+func (m *ContentResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ContentResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPending() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ContentRespValidationError{
+						field:  fmt.Sprintf("Pending[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ContentRespValidationError{
+						field:  fmt.Sprintf("Pending[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ContentRespValidationError{
+					field:  fmt.Sprintf("Pending[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetQueued() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ContentRespValidationError{
+						field:  fmt.Sprintf("Queued[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ContentRespValidationError{
+						field:  fmt.Sprintf("Queued[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ContentRespValidationError{
+					field:  fmt.Sprintf("Queued[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ContentRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContentRespMultiError is an error wrapping multiple validation errors
+// returned by ContentResp.ValidateAll() if the designated constraints aren't met.
+type ContentRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContentRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContentRespMultiError) AllErrors() []error { return m }
+
+// ContentRespValidationError is the validation error returned by
+// ContentResp.Validate if the designated constraints aren't met.
+type ContentRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContentRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContentRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContentRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContentRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContentRespValidationError) ErrorName() string { return "ContentRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ContentRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sContentResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContentRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContentRespValidationError{}
+
+// Validate checks the field values on TxnSummary with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TxnSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxnSummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TxnSummaryMultiError, or
+// nil if none found.
+func (m *TxnSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TxnSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Hash
+
+	// no validation rules for Nonce
+
+	// no validation rules for Value
+
+	// no validation rules for Gas
+
+	// no validation rules for GasPrice
+
+	if len(errors) > 0 {
+		return TxnSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// TxnSummaryMultiError is an error wrapping multiple validation errors
+// returned by TxnSummary.ValidateAll() if the designated constraints aren't met.
+type TxnSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TxnSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TxnSummaryMultiError) AllErrors() []error { return m }
+
+// TxnSummaryValidationError is the validation error returned by
+// TxnSummary.Validate if the designated constraints aren't met.
+type TxnSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxnSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxnSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxnSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxnSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxnSummaryValidationError) ErrorName() string { return "TxnSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TxnSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxnSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxnSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxnSummaryValidationError{}
+
+// Validate checks the field values on RemoveTxnReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RemoveTxnReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveTxnReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RemoveTxnReqMultiError, or
+// nil if none found.
+func (m *RemoveTxnReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveTxnReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_RemoveTxnReq_TxHash_Pattern.MatchString(m.GetTxHash()) {
+		err := RemoveTxnReqValidationError{
+			field:  "TxHash",
+			reason: "value does not match regex pattern \"^0x[a-fA-F0-9]{64}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveTxnReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveTxnReqMultiError is an error wrapping multiple validation errors
+// returned by RemoveTxnReq.ValidateAll() if the designated constraints aren't met.
+type RemoveTxnReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveTxnReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveTxnReqMultiError) AllErrors() []error { return m }
+
+// RemoveTxnReqValidationError is the validation error returned by
+// RemoveTxnReq.Validate if the designated constraints aren't met.
+type RemoveTxnReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveTxnReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveTxnReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveTxnReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveTxnReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveTxnReqValidationError) ErrorName() string { return "RemoveTxnReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RemoveTxnReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveTxnReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveTxnReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveTxnReqValidationError{}
+
+var _RemoveTxnReq_TxHash_Pattern = regexp.MustCompile("^0x[a-fA-F0-9]{64}$")
+
+// Validate checks the field values on RemoveTxnResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RemoveTxnResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveTxnResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RemoveTxnRespMultiError, or
+// nil if none found.
+func (m *RemoveTxnResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveTxnResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveTxnRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveTxnRespMultiError is an error wrapping multiple validation errors
+// returned by RemoveTxnResp.ValidateAll() if the designated constraints
+// aren't met.
+type RemoveTxnRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveTxnRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveTxnRespMultiError) AllErrors() []error { return m }
+
+// RemoveTxnRespValidationError is the validation error returned by
+// RemoveTxnResp.Validate if the designated constraints aren't met.
+type RemoveTxnRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveTxnRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveTxnRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveTxnRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveTxnRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveTxnRespValidationError) ErrorName() string { return "RemoveTxnRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RemoveTxnRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveTxnResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveTxnRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveTxnRespValidationError{}
+
+// Validate checks the field values on DropAccountReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DropAccountReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DropAccountReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DropAccountReqMultiError,
+// or nil if none found.
+func (m *DropAccountReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DropAccountReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_DropAccountReq_Address_Pattern.MatchString(m.GetAddress()) {
+		err := DropAccountReqValidationError{
+			field:  "Address",
+			reason: "value does not match regex pattern \"^0x[a-fA-F0-9]{40}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DropAccountReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// DropAccountReqMultiError is an error wrapping multiple validation errors
+// returned by DropAccountReq.ValidateAll() if the designated constraints
+// aren't met.
+type DropAccountReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DropAccountReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DropAccountReqMultiError) AllErrors() []error { return m }
+
+// DropAccountReqValidationError is the validation error returned by
+// DropAccountReq.Validate if the designated constraints aren't met.
+type DropAccountReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DropAccountReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DropAccountReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DropAccountReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DropAccountReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DropAccountReqValidationError) ErrorName() string { return "DropAccountReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DropAccountReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDropAccountReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DropAccountReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DropAccountReqValidationError{}
+
+var _DropAccountReq_Address_Pattern = regexp.MustCompile("^0x[a-fA-F0-9]{40}$")
+
+// Validate checks the field values on DropAccountResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DropAccountResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DropAccountResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DropAccountRespMultiError, or nil if none found.
+func (m *DropAccountResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DropAccountResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DropAccountRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// DropAccountRespMultiError is an error wrapping multiple validation errors
+// returned by DropAccountResp.ValidateAll() if the designated constraints
+// aren't met.
+type DropAccountRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DropAccountRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DropAccountRespMultiError) AllErrors() []error { return m }
+
+// DropAccountRespValidationError is the validation error returned by
+// DropAccountResp.Validate if the designated constraints aren't met.
+type DropAccountRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DropAccountRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DropAccountRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DropAccountRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DropAccountRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DropAccountRespValidationError) ErrorName() string { return "DropAccountRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DropAccountRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDropAccountResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DropAccountRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DropAccountRespValidationError{}
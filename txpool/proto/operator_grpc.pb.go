@@ -29,6 +29,12 @@ type TxnPoolOperatorClient interface {
 	AddTxn(ctx context.Context, in *AddTxnReq, opts ...grpc.CallOption) (*AddTxnResp, error)
 	// Subscribe subscribes for new events in the txpool
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (TxnPoolOperator_SubscribeClient, error)
+	// Content returns the pending and queued transactions of an account
+	Content(ctx context.Context, in *ContentReq, opts ...grpc.CallOption) (*ContentResp, error)
+	// RemoveTxn removes a transaction from the pool
+	RemoveTxn(ctx context.Context, in *RemoveTxnReq, opts ...grpc.CallOption) (*RemoveTxnResp, error)
+	// DropAccount drops all the transactions of an account from the pool
+	DropAccount(ctx context.Context, in *DropAccountReq, opts ...grpc.CallOption) (*DropAccountResp, error)
 }
 
 type txnPoolOperatorClient struct {
@@ -89,6 +95,33 @@ func (x *txnPoolOperatorSubscribeClient) Recv() (*TxPoolEvent, error) {
 	return m, nil
 }
 
+func (c *txnPoolOperatorClient) Content(ctx context.Context, in *ContentReq, opts ...grpc.CallOption) (*ContentResp, error) {
+	out := new(ContentResp)
+	err := c.cc.Invoke(ctx, "/v1.TxnPoolOperator/Content", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txnPoolOperatorClient) RemoveTxn(ctx context.Context, in *RemoveTxnReq, opts ...grpc.CallOption) (*RemoveTxnResp, error) {
+	out := new(RemoveTxnResp)
+	err := c.cc.Invoke(ctx, "/v1.TxnPoolOperator/RemoveTxn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txnPoolOperatorClient) DropAccount(ctx context.Context, in *DropAccountReq, opts ...grpc.CallOption) (*DropAccountResp, error) {
+	out := new(DropAccountResp)
+	err := c.cc.Invoke(ctx, "/v1.TxnPoolOperator/DropAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TxnPoolOperatorServer is the server API for TxnPoolOperator service.
 // All implementations must embed UnimplementedTxnPoolOperatorServer
 // for forward compatibility
@@ -99,6 +132,12 @@ type TxnPoolOperatorServer interface {
 	AddTxn(context.Context, *AddTxnReq) (*AddTxnResp, error)
 	// Subscribe subscribes for new events in the txpool
 	Subscribe(*SubscribeRequest, TxnPoolOperator_SubscribeServer) error
+	// Content returns the pending and queued transactions of an account
+	Content(context.Context, *ContentReq) (*ContentResp, error)
+	// RemoveTxn removes a transaction from the pool
+	RemoveTxn(context.Context, *RemoveTxnReq) (*RemoveTxnResp, error)
+	// DropAccount drops all the transactions of an account from the pool
+	DropAccount(context.Context, *DropAccountReq) (*DropAccountResp, error)
 	mustEmbedUnimplementedTxnPoolOperatorServer()
 }
 
@@ -115,6 +154,15 @@ func (UnimplementedTxnPoolOperatorServer) AddTxn(context.Context, *AddTxnReq) (*
 func (UnimplementedTxnPoolOperatorServer) Subscribe(*SubscribeRequest, TxnPoolOperator_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
+func (UnimplementedTxnPoolOperatorServer) Content(context.Context, *ContentReq) (*ContentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Content not implemented")
+}
+func (UnimplementedTxnPoolOperatorServer) RemoveTxn(context.Context, *RemoveTxnReq) (*RemoveTxnResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTxn not implemented")
+}
+func (UnimplementedTxnPoolOperatorServer) DropAccount(context.Context, *DropAccountReq) (*DropAccountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropAccount not implemented")
+}
 func (UnimplementedTxnPoolOperatorServer) mustEmbedUnimplementedTxnPoolOperatorServer() {}
 
 // UnsafeTxnPoolOperatorServer may be embedded to opt out of forward compatibility for this service.
@@ -185,6 +233,60 @@ func (x *txnPoolOperatorSubscribeServer) Send(m *TxPoolEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TxnPoolOperator_Content_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnPoolOperatorServer).Content(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxnPoolOperator/Content",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnPoolOperatorServer).Content(ctx, req.(*ContentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxnPoolOperator_RemoveTxn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTxnReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnPoolOperatorServer).RemoveTxn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxnPoolOperator/RemoveTxn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnPoolOperatorServer).RemoveTxn(ctx, req.(*RemoveTxnReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TxnPoolOperator_DropAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnPoolOperatorServer).DropAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxnPoolOperator/DropAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnPoolOperatorServer).DropAccount(ctx, req.(*DropAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TxnPoolOperator_ServiceDesc is the grpc.ServiceDesc for TxnPoolOperator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -200,6 +302,18 @@ var TxnPoolOperator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddTxn",
 			Handler:    _TxnPoolOperator_AddTxn_Handler,
 		},
+		{
+			MethodName: "Content",
+			Handler:    _TxnPoolOperator_Content_Handler,
+		},
+		{
+			MethodName: "RemoveTxn",
+			Handler:    _TxnPoolOperator_RemoveTxn_Handler,
+		},
+		{
+			MethodName: "DropAccount",
+			Handler:    _TxnPoolOperator_DropAccount_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
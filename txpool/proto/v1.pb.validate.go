@@ -85,6 +85,8 @@ func (m *Txn) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for TraceContext
+
 	if len(errors) > 0 {
 		return TxnMultiError(errors)
 	}
@@ -49,6 +49,30 @@ func (p *TxPool) GetTxs(inclQueued bool) (
 	return p.accounts.allTxs(inclQueued)
 }
 
+// GetAccountTxs gets pending and queued transactions of the given account
+func (p *TxPool) GetAccountTxs(addr types.Address) (
+	promoted, enqueued []*types.Transaction,
+) {
+	account := p.accounts.get(addr)
+	if account == nil {
+		return nil, nil
+	}
+
+	account.promoted.lock(false)
+	defer account.promoted.unlock()
+
+	account.enqueued.lock(false)
+	defer account.enqueued.unlock()
+
+	promoted = make([]*types.Transaction, len(account.promoted.queue))
+	copy(promoted, account.promoted.queue)
+
+	enqueued = make([]*types.Transaction, len(account.enqueued.queue))
+	copy(enqueued, account.enqueued.queue)
+
+	return promoted, enqueued
+}
+
 // GetBaseFee returns current base fee
 func (p *TxPool) GetBaseFee() uint64 {
 	return atomic.LoadUint64(&p.baseFee)
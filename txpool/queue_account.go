@@ -71,6 +71,39 @@ func (q *accountQueue) clear() (removed []*types.Transaction) {
 	return
 }
 
+// removeFrom removes all transactions from the queue
+// with nonce equal to or higher than given.
+func (q *accountQueue) removeFrom(nonce uint64) (removed []*types.Transaction) {
+	kept := make(minNonceQueue, 0, q.queue.Len())
+
+	for _, tx := range q.queue {
+		if tx.Nonce >= nonce {
+			removed = append(removed, tx)
+		} else {
+			kept = append(kept, tx)
+		}
+	}
+
+	q.queue = kept
+	heap.Init(&q.queue)
+
+	return
+}
+
+// remove removes the given transaction from the queue.
+// Returns false if the transaction is not present in the queue
+func (q *accountQueue) remove(tx *types.Transaction) bool {
+	for i, queued := range q.queue {
+		if queued.Hash == tx.Hash {
+			heap.Remove(&q.queue, i)
+
+			return true
+		}
+	}
+
+	return false
+}
+
 // push pushes the given transactions onto the queue.
 func (q *accountQueue) push(tx *types.Transaction) {
 	heap.Push(&q.queue, tx)
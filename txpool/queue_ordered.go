@@ -0,0 +1,146 @@
+package txpool
+
+import (
+	"container/heap"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// orderedTx is an executable transaction with the metadata
+// the ordering policies sort by
+type orderedTx struct {
+	tx      *types.Transaction
+	arrival uint64 // sequence number in which the tx entered the pool
+	round   uint64 // round-robin round of the sender
+}
+
+// orderedQueue is an executables queue sorted by an arbitrary ordering policy
+type orderedQueue struct {
+	queue *orderedTxHeap
+
+	// arrivalFn returns the arrival sequence number of the transaction
+	arrivalFn func(*types.Transaction) uint64
+
+	// rounds keeps the round-robin round of each sender,
+	// nil if rounds are not tracked
+	rounds map[types.Address]uint64
+}
+
+// newOrderedQueue creates the ordered queue with initial transactions
+func newOrderedQueue(
+	arrivalFn func(*types.Transaction) uint64,
+	trackRounds bool,
+	initialTxs []*types.Transaction,
+	less func(a, b *orderedTx) bool,
+) *orderedQueue {
+	q := &orderedQueue{
+		queue: &orderedTxHeap{
+			txs:  make([]*orderedTx, 0, len(initialTxs)),
+			less: less,
+		},
+		arrivalFn: arrivalFn,
+	}
+
+	if trackRounds {
+		q.rounds = make(map[types.Address]uint64)
+	}
+
+	for _, tx := range initialTxs {
+		q.queue.txs = append(q.queue.txs, q.wrap(tx))
+	}
+
+	heap.Init(q.queue)
+
+	return q
+}
+
+// wrap attaches the ordering metadata to the transaction
+func (q *orderedQueue) wrap(tx *types.Transaction) *orderedTx {
+	return &orderedTx{
+		tx:      tx,
+		arrival: q.arrivalFn(tx),
+		round:   q.rounds[tx.From],
+	}
+}
+
+// push pushes the given transaction onto the queue
+func (q *orderedQueue) push(tx *types.Transaction) {
+	heap.Push(q.queue, q.wrap(tx))
+}
+
+// pop removes the first transaction from the queue
+// or nil if the queue is empty. The sender of the popped
+// transaction moves to the next round (if rounds are tracked)
+func (q *orderedQueue) pop() *types.Transaction {
+	if q.length() == 0 {
+		return nil
+	}
+
+	otx, ok := heap.Pop(q.queue).(*orderedTx)
+	if !ok {
+		return nil
+	}
+
+	if q.rounds != nil {
+		q.rounds[otx.tx.From]++
+	}
+
+	return otx.tx
+}
+
+// remove removes the given transactions from the queue, if they are queued
+func (q *orderedQueue) remove(txs ...*types.Transaction) {
+	for _, tx := range txs {
+		for i, queued := range q.queue.txs {
+			if queued.tx.Hash == tx.Hash {
+				heap.Remove(q.queue, i)
+
+				break
+			}
+		}
+	}
+}
+
+// length returns the number of transactions in the queue
+func (q *orderedQueue) length() int {
+	return q.queue.Len()
+}
+
+// orderedTxHeap implements the heap interface over ordered transactions
+type orderedTxHeap struct {
+	txs  []*orderedTx
+	less func(a, b *orderedTx) bool
+}
+
+/* Queue methods required by the heap interface */
+
+func (h *orderedTxHeap) Len() int {
+	return len(h.txs)
+}
+
+func (h *orderedTxHeap) Swap(i, j int) {
+	h.txs[i], h.txs[j] = h.txs[j], h.txs[i]
+}
+
+func (h *orderedTxHeap) Less(i, j int) bool {
+	return h.less(h.txs[i], h.txs[j])
+}
+
+func (h *orderedTxHeap) Push(x interface{}) {
+	otx, ok := x.(*orderedTx)
+	if !ok {
+		return
+	}
+
+	h.txs = append(h.txs, otx)
+}
+
+func (h *orderedTxHeap) Pop() interface{} {
+	old := h.txs
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	h.txs = old[0 : n-1]
+
+	return x
+}
@@ -45,6 +45,19 @@ func (q *pricedQueue) pop() *types.Transaction {
 	return transaction
 }
 
+// remove removes the given transactions from the queue, if they are queued
+func (q *pricedQueue) remove(txs ...*types.Transaction) {
+	for _, tx := range txs {
+		for i, queued := range q.queue.txs {
+			if queued.Hash == tx.Hash {
+				heap.Remove(q.queue, i)
+
+				break
+			}
+		}
+	}
+}
+
 // length returns the number of transactions in the queue.
 func (q *pricedQueue) length() int {
 	return q.queue.Len()
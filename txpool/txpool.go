@@ -1,9 +1,12 @@
 package txpool
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -11,11 +14,14 @@ import (
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/hashicorp/go-hclog"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/forkmanager"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
@@ -65,14 +71,19 @@ var (
 	ErrNonceExistsInPool       = errors.New("tx with the same nonce is already present")
 	ErrReplacementUnderpriced  = errors.New("replacement tx underpriced")
 	ErrDynamicTxNotAllowed     = errors.New("dynamic tx not allowed currently")
+	ErrAccountNotFound         = errors.New("account not found in the pool")
+	ErrTxNotFound              = errors.New("transaction not found in the pool")
 )
 
+var tracer = tracing.Tracer("txpool")
+
 // indicates origin of a transaction
 type txOrigin int
 
 const (
 	local  txOrigin = iota // json-RPC/gRPC endpoints
 	gossip                 // gossip protocol
+	reorg                  // blocks removed from the canonical chain
 )
 
 func (o txOrigin) String() (s string) {
@@ -81,6 +92,8 @@ func (o txOrigin) String() (s string) {
 		s = "local"
 	case gossip:
 		s = "gossip"
+	case reorg:
+		s = "reorg"
 	}
 
 	return
@@ -104,6 +117,7 @@ type Config struct {
 	MaxSlots           uint64
 	MaxAccountEnqueued uint64
 	ChainID            *big.Int
+	PriorityAddresses  []types.Address
 }
 
 /* All requests are passed to the main loop
@@ -152,8 +166,13 @@ type TxPool struct {
 	// map of all accounts registered by the pool
 	accounts accountsMap
 
-	// all the primaries sorted by max gas price
-	executables *pricedQueue
+	// all the primaries sorted by the active tx ordering policy,
+	// guarded by executablesLock since the transactions can be removed while a block is built
+	executables     executablesQueue
+	executablesLock sync.Mutex
+
+	// senders whose transactions go first under the priority ordering policy
+	priorityAddresses map[types.Address]struct{}
 
 	// lookup map keeping track of all
 	// transactions present in the pool
@@ -213,10 +232,14 @@ func NewTxPool(
 		store:       store,
 		executables: newPricesQueue(0, nil),
 		accounts:    accountsMap{maxEnqueuedLimit: config.MaxAccountEnqueued},
-		index:       lookupMap{all: make(map[types.Hash]*types.Transaction)},
-		gauge:       slotGauge{height: 0, max: config.MaxSlots},
-		priceLimit:  config.PriceLimit,
-		chainID:     config.ChainID,
+		index: lookupMap{
+			all:      make(map[types.Hash]*types.Transaction),
+			arrivals: make(map[types.Hash]uint64),
+		},
+		gauge:             slotGauge{height: 0, max: config.MaxSlots},
+		priceLimit:        config.PriceLimit,
+		chainID:           config.ChainID,
+		priorityAddresses: make(map[types.Address]struct{}, len(config.PriorityAddresses)),
 
 		//	main loop channels
 		promoteReqCh: make(chan promoteRequest),
@@ -224,6 +247,10 @@ func NewTxPool(
 		shutdownCh:   make(chan struct{}),
 	}
 
+	for _, addr := range config.PriorityAddresses {
+		pool.priorityAddresses[addr] = struct{}{}
+	}
+
 	// Attach the event manager
 	pool.eventManager = newEventManager(pool.logger)
 
@@ -308,42 +335,66 @@ func (p *TxPool) SetSealing(sealing bool) {
 
 // AddTx adds a new transaction to the pool (sent from json-RPC/gRPC endpoints)
 // and broadcasts it to the network (if enabled).
-func (p *TxPool) AddTx(tx *types.Transaction) error {
+// The transaction is traced as a child of the span of the context
+func (p *TxPool) AddTx(ctx context.Context, tx *types.Transaction) error {
+	ctx, span := tracer.Start(ctx, "TxPool.addTx")
+	defer span.End()
+
 	if err := p.addTx(local, tx); err != nil {
 		p.logger.Error("failed to add tx", "err", err)
+		tracing.RecordError(span, err)
 
 		return err
 	}
 
+	span.SetAttributes(tracing.TxHashKey.String(tx.Hash.String()))
+	tracing.SetTxContext(tx.Hash, span.SpanContext())
+
 	// broadcast the transaction only if a topic
 	// subscription is present
 	if p.topic != nil {
-		tx := &proto.Txn{
-			Raw: &any.Any{
-				Value: tx.MarshalRLP(),
-			},
-		}
-
-		if err := p.topic.Publish(tx); err != nil {
-			p.logger.Error("failed to topic tx", "err", err)
-		}
+		p.publishTx(ctx, tx)
 	}
 
 	return nil
 }
 
+// publishTx gossips the transaction along with the trace context of the span of the context
+func (p *TxPool) publishTx(ctx context.Context, tx *types.Transaction) {
+	ctx, span := tracer.Start(ctx, "TxPool.gossip", trace.WithSpanKind(trace.SpanKindProducer))
+	defer span.End()
+
+	msg := &proto.Txn{
+		Raw: &any.Any{
+			Value: tx.MarshalRLP(),
+		},
+		TraceContext: map[string]string{},
+	}
+
+	tracing.Inject(ctx, propagation.MapCarrier(msg.TraceContext))
+
+	if err := p.topic.Publish(msg); err != nil {
+		p.logger.Error("failed to topic tx", "err", err)
+		tracing.RecordError(span, err)
+	}
+}
+
 // Prepare generates all the transactions
 // ready for execution. (primaries)
 func (p *TxPool) Prepare() {
 	// fetch primary from each account
 	primaries := p.accounts.getPrimaries()
 
-	// create new executables queue with base fee and initial transactions (primaries)
-	p.executables = newPricesQueue(p.GetBaseFee(), primaries)
+	// create new executables queue ordered by the policy of the block being built
+	executables := p.newExecutablesQueue(p.getTxOrderingPolicy(), primaries)
+
+	p.executablesLock.Lock()
+	p.executables = executables
+	p.executablesLock.Unlock()
 }
 
-// Peek returns the best-price selected
-// transaction ready for execution.
+// Peek returns the next transaction ready for execution,
+// as selected by the active tx ordering policy.
 func (p *TxPool) Peek() *types.Transaction {
 	// Popping the executables queue
 	// does not remove the actual tx
@@ -351,6 +402,9 @@ func (p *TxPool) Peek() *types.Transaction {
 	// The executables queue just provides
 	// insight into which account has the
 	// highest priced tx (head of promoted queue)
+	p.executablesLock.Lock()
+	defer p.executablesLock.Unlock()
+
 	return p.executables.pop()
 }
 
@@ -370,6 +424,11 @@ func (p *TxPool) Pop(tx *types.Transaction) {
 		account.promoted.unlock()
 	}()
 
+	// the transaction was removed from the pool while it was executed
+	if promoted := account.promoted.peek(); promoted == nil || promoted.Hash != tx.Hash {
+		return
+	}
+
 	// pop the top most promoted tx
 	account.promoted.pop()
 
@@ -387,7 +446,9 @@ func (p *TxPool) Pop(tx *types.Transaction) {
 
 	// update executables
 	if tx := account.promoted.peek(); tx != nil {
+		p.executablesLock.Lock()
 		p.executables.push(tx)
+		p.executablesLock.Unlock()
 	}
 }
 
@@ -398,10 +459,113 @@ func (p *TxPool) Drop(tx *types.Transaction) {
 	p.dropAccount(account, tx.Nonce, tx)
 }
 
+// DropAccountTxs clears all promoted and enqueued transactions of the given account
+// and reverts its next (expected) nonce to the one from the world state.
+// Signals EventType_DROPPED for every dropped transaction and returns them
+func (p *TxPool) DropAccountTxs(addr types.Address) ([]*types.Transaction, error) {
+	account := p.accounts.get(addr)
+	if account == nil {
+		return nil, ErrAccountNotFound
+	}
+
+	firstTx := account.getLowestTx()
+	if firstTx == nil {
+		// account has no txs, nothing to drop
+		return nil, nil
+	}
+
+	stateNonce := p.store.GetNonce(p.store.Header().StateRoot, addr)
+	dropped := p.dropAccount(account, stateNonce, firstTx)
+
+	// dropAccount signals only the provided transaction, signal the rest of them
+	remaining := make([]*types.Transaction, 0, len(dropped))
+
+	for _, tx := range dropped {
+		if tx.Hash != firstTx.Hash {
+			remaining = append(remaining, tx)
+		}
+	}
+
+	if len(remaining) > 0 {
+		p.eventManager.signalEvent(proto.EventType_DROPPED, toHash(remaining...)...)
+	}
+
+	return dropped, nil
+}
+
+// RemoveTx removes the transaction with the given hash from the pool.
+// If the transaction is promoted, all promoted transactions of the same account
+// with a higher nonce are removed as well (they can no longer be executed),
+// and the account's next (expected) nonce is reverted to the nonce of the removed transaction.
+// Signals EventType_DROPPED for every removed transaction and returns them
+func (p *TxPool) RemoveTx(txHash types.Hash) ([]*types.Transaction, error) {
+	tx, ok := p.index.get(txHash)
+	if !ok {
+		return nil, ErrTxNotFound
+	}
+
+	account := p.accounts.get(tx.From)
+	if account == nil {
+		return nil, ErrAccountNotFound
+	}
+
+	account.promoted.lock(true)
+	account.enqueued.lock(true)
+	account.nonceToTx.lock()
+
+	defer func() {
+		account.nonceToTx.unlock()
+		account.enqueued.unlock()
+		account.promoted.unlock()
+	}()
+
+	var removed []*types.Transaction
+
+	if tx.Nonce < account.getNonce() {
+		// promoted transaction, remove it along with all the subsequent promoted ones
+		removed = account.promoted.removeFrom(tx.Nonce)
+		account.setNonce(tx.Nonce)
+
+		// the first removed transaction may be the primary of the account queued for the block builder
+		p.executablesLock.Lock()
+		p.executables.remove(removed...)
+		p.executablesLock.Unlock()
+
+		// update metrics
+		p.updatePending(-1 * int64(len(removed)))
+	} else if account.enqueued.remove(tx) {
+		removed = []*types.Transaction{tx}
+	}
+
+	if len(removed) == 0 {
+		return nil, ErrTxNotFound
+	}
+
+	account.nonceToTx.remove(removed...)
+	p.index.remove(removed...)
+	p.gauge.decrease(slotsRequired(removed...))
+
+	p.eventManager.signalEvent(proto.EventType_DROPPED, toHash(removed...)...)
+
+	if p.logger.IsDebug() {
+		p.logger.Debug("removed account txs",
+			"num", len(removed),
+			"next_nonce", account.getNonce(),
+			"address", tx.From.String(),
+		)
+	}
+
+	return removed, nil
+}
+
 // dropAccount clears all promoted and enqueued tx from the account
-// signals EventType_DROPPED for provided hash, clears all the slots and metrics
-// and sets nonce to provided nonce
-func (p *TxPool) dropAccount(account *account, nextNonce uint64, tx *types.Transaction) {
+// signals EventType_DROPPED for provided hash, clears all the slots and metrics,
+// sets nonce to provided nonce and returns all the dropped transactions
+func (p *TxPool) dropAccount(
+	account *account,
+	nextNonce uint64,
+	tx *types.Transaction,
+) (droppedTxs []*types.Transaction) {
 	account.promoted.lock(true)
 	account.enqueued.lock(true)
 	account.nonceToTx.lock()
@@ -422,6 +586,7 @@ func (p *TxPool) dropAccount(account *account, nextNonce uint64, tx *types.Trans
 
 		// increase counter
 		droppedCount += len(txs)
+		droppedTxs = append(droppedTxs, txs...)
 	}
 
 	// rollback nonce
@@ -450,6 +615,8 @@ func (p *TxPool) dropAccount(account *account, nextNonce uint64, tx *types.Trans
 			"address", tx.From.String(),
 		)
 	}
+
+	return droppedTxs
 }
 
 // Demote excludes an account from being further processed during block building
@@ -488,6 +655,107 @@ func (p *TxPool) ResetWithHeaders(headers ...*types.Header) {
 	})
 }
 
+// ResetWithDroppedTxs syncs the pool with the new head after the canonical chain was rewound.
+// Accounts which sent the dropped transactions get their next (expected) nonce reverted
+// to the one from the world state and the dropped transactions are added back to the pool,
+// along with the transactions of those accounts which were already in the pool
+func (p *TxPool) ResetWithDroppedTxs(dropped []*types.Transaction) {
+	p.resetWithRemovedTxs(dropped, true)
+}
+
+// ResetWithRevertedTxs syncs the pool with the new head after the canonical chain was reverted.
+// Unlike ResetWithDroppedTxs, the reverted transactions are discarded,
+// only the next nonces of their senders are reverted to the ones from the world state
+func (p *TxPool) ResetWithRevertedTxs(reverted []*types.Transaction) {
+	p.resetWithRemovedTxs(reverted, false)
+}
+
+// resetWithRemovedTxs resets the accounts which sent the transactions removed from the canonical chain
+// and adds back their pooled transactions, along with the removed ones if requested
+func (p *TxPool) resetWithRemovedTxs(removed []*types.Transaction, addRemoved bool) {
+	stateRoot := p.store.Header().StateRoot
+	txsByAccount := make(map[types.Address][]*types.Transaction)
+
+	for _, tx := range removed {
+		if tx.Type == types.StateTx {
+			continue
+		}
+
+		addr := tx.From
+		if addr == types.ZeroAddress {
+			var err error
+
+			// From field is not set, extract the signer
+			if addr, err = p.signer.Sender(tx); err != nil {
+				p.logger.Error(
+					fmt.Sprintf("unable to extract signer for transaction, %v", err),
+				)
+
+				continue
+			}
+
+			tx.From = addr
+		}
+
+		if addRemoved {
+			txsByAccount[addr] = append(txsByAccount[addr], tx)
+		} else if _, ok := txsByAccount[addr]; !ok {
+			txsByAccount[addr] = nil
+		}
+	}
+
+	for addr, txs := range txsByAccount {
+		stateNonce := p.store.GetNonce(stateRoot, addr)
+
+		if account := p.accounts.get(addr); account != nil {
+			txs = append(txs, p.clearAccount(account, stateNonce)...)
+		}
+
+		sort.Slice(txs, func(i, j int) bool {
+			return txs[i].Nonce < txs[j].Nonce
+		})
+
+		for _, tx := range txs {
+			if err := p.addTx(reorg, tx); err != nil {
+				p.logger.Debug("failed to re-add transaction", "hash", tx.Hash, "err", err)
+			}
+		}
+	}
+
+	// update base fee
+	p.SetBaseFee(p.store.Header())
+}
+
+// clearAccount removes all promoted and enqueued transactions of the account
+// without signaling them as dropped, sets its nonce to provided nonce and returns the removed transactions
+func (p *TxPool) clearAccount(account *account, nextNonce uint64) []*types.Transaction {
+	account.promoted.lock(true)
+	account.enqueued.lock(true)
+	account.nonceToTx.lock()
+
+	defer func() {
+		account.nonceToTx.unlock()
+		account.enqueued.unlock()
+		account.promoted.unlock()
+	}()
+
+	account.setNonce(nextNonce)
+	account.nonceToTx.reset()
+
+	promoted := account.promoted.clear()
+	enqueued := account.enqueued.clear()
+
+	// update metrics
+	p.updatePending(-1 * int64(len(promoted)))
+
+	removed := append(promoted, enqueued...)
+
+	p.index.remove(removed...)
+	p.gauge.decrease(slotsRequired(removed...))
+
+	return removed
+}
+
 // processEvent collects the latest nonces for each account contained
 // in the received event. Resets all known accounts with the new nonce.
 func (p *TxPool) processEvent(event *blockchain.Event) {
@@ -951,6 +1219,19 @@ func (p *TxPool) addGossipTx(obj interface{}, _ peer.ID) {
 		return
 	}
 
+	// the transaction is traced in a new trace, sampled by the node, which links to the span of the sender.
+	// The trace context of a peer is untrusted, it must not decide the sampling of the node
+	options := []trace.SpanStartOption{trace.WithNewRoot(), trace.WithSpanKind(trace.SpanKindConsumer)}
+
+	remoteCtx := trace.SpanContextFromContext(
+		tracing.Extract(context.Background(), propagation.MapCarrier(raw.TraceContext)))
+	if remoteCtx.IsValid() {
+		options = append(options, trace.WithLinks(trace.Link{SpanContext: remoteCtx}))
+	}
+
+	_, span := tracer.Start(context.Background(), "TxPool.addGossipTx", options...)
+	defer span.End()
+
 	// add tx
 	if err := p.addTx(gossip, tx); err != nil {
 		if errors.Is(err, ErrAlreadyKnown) {
@@ -962,7 +1243,13 @@ func (p *TxPool) addGossipTx(obj interface{}, _ peer.ID) {
 		}
 
 		p.logger.Error("failed to add broadcast tx", "err", err, "hash", tx.Hash.String())
+		tracing.RecordError(span, err)
+
+		return
 	}
+
+	span.SetAttributes(tracing.TxHashKey.String(tx.Hash.String()))
+	tracing.SetTxContext(tx.Hash, span.SpanContext())
 }
 
 // resetAccounts updates existing accounts with the new nonce and prunes stale transactions.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
@@ -19,7 +20,9 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/forkmanager"
 	"github.com/0xPolygon/polygon-edge/helper/tests"
+	"github.com/0xPolygon/polygon-edge/helper/tracing"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/txpool/proto"
@@ -637,6 +640,56 @@ func TestAddGossipTx(t *testing.T) {
 	})
 }
 
+func TestAddGossipTx_Tracing(t *testing.T) {
+	collector := tracing.NewTestCollector(t)
+
+	shutdown, err := tracing.Setup(context.Background(), &tracing.Config{
+		Endpoint:    collector.Endpoint,
+		Insecure:    true,
+		SampleRatio: 1,
+	})
+	require.NoError(t, err)
+
+	key, sender := tests.GenerateKeyAndAddr(t)
+	signer := crypto.NewEIP155Signer(100, true)
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+	pool.SetSigner(signer)
+	pool.SetSealing(true)
+
+	signedTx, err := signer.SignTx(newTx(types.ZeroAddress, 1, 1), key)
+	require.NoError(t, err)
+
+	// the sender doesn't sample its trace, the node samples the transaction on its own
+	pool.addGossipTx(&proto.Txn{
+		Raw: &any.Any{
+			Value: signedTx.MarshalRLP(),
+		},
+		TraceContext: map[string]string{
+			"traceparent": "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-00",
+		},
+	}, "")
+
+	links := tracing.TxLinks(pool.accounts.get(sender).enqueued.peek())
+	require.Len(t, links, 1)
+
+	// the shutdown flushes the spans
+	require.NoError(t, shutdown(context.Background()))
+
+	span := collector.Span("TxPool.addGossipTx")
+	require.NotNil(t, span)
+
+	// the span is the root of a new trace, which links to the span of the sender
+	assert.Empty(t, span.ParentSpanId)
+	assert.NotEqual(t, "0af7651916cd43dd8448eb211c80319c", hex.EncodeToString(span.TraceId))
+	assert.Equal(t, hex.EncodeToString(span.TraceId), links[0].SpanContext.TraceID().String())
+
+	require.Len(t, span.Links, 1)
+	assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", hex.EncodeToString(span.Links[0].TraceId))
+	assert.Equal(t, "b7ad6b7169203331", hex.EncodeToString(span.Links[0].SpanId))
+}
+
 func TestDropKnownGossipTx(t *testing.T) {
 	t.Parallel()
 
@@ -1873,6 +1926,281 @@ func TestDrop(t *testing.T) {
 	assert.Equal(t, (*types.Transaction)(nil), acc.nonceToTx.get(tx1.Nonce))
 }
 
+func TestDropAccountTxs(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	assert.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	_, err = pool.DropAccountTxs(addr1)
+	assert.ErrorIs(t, err, ErrAccountNotFound)
+
+	// send 2 txs and promote them, and 1 tx with a nonce gap
+	tx1 := newTx(addr1, 0, 1)
+	tx2 := newTx(addr1, 1, 1)
+	tx3 := newTx(addr1, 5, 1)
+
+	assert.NoError(t, pool.addTx(local, tx1))
+	pool.handlePromoteRequest(<-pool.promoteReqCh)
+	assert.NoError(t, pool.addTx(local, tx2))
+	pool.handlePromoteRequest(<-pool.promoteReqCh)
+	assert.NoError(t, pool.addTx(local, tx3))
+
+	assert.Equal(t, uint64(3), pool.gauge.read())
+	assert.Equal(t, uint64(2), pool.accounts.get(addr1).getNonce())
+
+	subscription := pool.eventManager.subscribe([]proto.EventType{proto.EventType_DROPPED})
+	defer pool.eventManager.cancelSubscription(subscription.subscriptionID)
+
+	dropped, err := pool.DropAccountTxs(addr1)
+	assert.NoError(t, err)
+	assert.Len(t, dropped, 3)
+
+	ctx, cancelFn := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancelFn()
+
+	assert.Len(t, waitForEvents(ctx, subscription, 3), 3)
+
+	acc := pool.accounts.get(addr1)
+
+	assert.Equal(t, uint64(0), pool.gauge.read())
+	assert.Equal(t, uint64(0), acc.getNonce())
+	assert.Equal(t, uint64(0), acc.promoted.length())
+	assert.Equal(t, uint64(0), acc.enqueued.length())
+	assert.Equal(t, int(0), len(acc.nonceToTx.mapping))
+
+	for _, tx := range []*types.Transaction{tx1, tx2, tx3} {
+		_, exists := pool.index.get(tx.Hash)
+		assert.False(t, exists)
+	}
+}
+
+func TestResetWithDroppedTxs(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	assert.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	// txs with nonces 0 and 1 were mined in the blocks which are about to be removed,
+	// the one with nonce 2 is still in the pool
+	dropped := []*types.Transaction{
+		newTx(addr1, 0, 1),
+		newTx(addr1, 1, 1),
+		{Type: types.StateTx, From: addr2},
+	}
+	pending := newTx(addr1, 2, 1)
+
+	pool.getOrCreateAccount(addr1).setNonce(2)
+	assert.NoError(t, pool.addTx(local, pending))
+	pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+	assert.Equal(t, uint64(3), pool.accounts.get(addr1).getNonce())
+
+	pool.ResetWithDroppedTxs(dropped)
+	pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+	acc := pool.accounts.get(addr1)
+
+	assert.Equal(t, uint64(3), pool.gauge.read())
+	assert.Equal(t, uint64(3), acc.getNonce())
+	assert.Equal(t, uint64(3), acc.promoted.length())
+	assert.Equal(t, uint64(0), acc.enqueued.length())
+	assert.Nil(t, pool.accounts.get(addr2))
+
+	for _, tx := range append(dropped[:2], pending) {
+		_, exists := pool.index.get(tx.Hash)
+		assert.True(t, exists)
+	}
+}
+
+func TestResetWithRevertedTxs(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	assert.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	// txs with nonces 0 and 1 were mined in the reverted blocks,
+	// the one with nonce 2 is still in the pool
+	reverted := []*types.Transaction{
+		newTx(addr1, 0, 1),
+		newTx(addr1, 1, 1),
+	}
+	pending := newTx(addr1, 2, 1)
+
+	pool.getOrCreateAccount(addr1).setNonce(2)
+	assert.NoError(t, pool.addTx(local, pending))
+	pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+	pool.ResetWithRevertedTxs(reverted)
+
+	acc := pool.accounts.get(addr1)
+
+	// the pending tx waits behind the nonce gap of the reverted txs
+	assert.Equal(t, uint64(1), pool.gauge.read())
+	assert.Equal(t, uint64(0), acc.getNonce())
+	assert.Equal(t, uint64(0), acc.promoted.length())
+	assert.Equal(t, uint64(1), acc.enqueued.length())
+
+	for _, tx := range reverted {
+		_, exists := pool.index.get(tx.Hash)
+		assert.False(t, exists)
+	}
+
+	_, exists := pool.index.get(pending.Hash)
+	assert.True(t, exists)
+}
+
+func TestRemoveTx(t *testing.T) {
+	t.Parallel()
+
+	setupPool := func(t *testing.T) (*TxPool, []*types.Transaction) {
+		t.Helper()
+
+		pool, err := newTestPool()
+		assert.NoError(t, err)
+		pool.SetSigner(&mockSigner{})
+
+		// 3 promoted txs and 2 enqueued txs behind a nonce gap
+		txs := []*types.Transaction{
+			newTx(addr1, 0, 1),
+			newTx(addr1, 1, 1),
+			newTx(addr1, 2, 1),
+			newTx(addr1, 5, 1),
+			newTx(addr1, 6, 1),
+		}
+
+		for _, tx := range txs[:3] {
+			assert.NoError(t, pool.addTx(local, tx))
+			pool.handlePromoteRequest(<-pool.promoteReqCh)
+		}
+
+		for _, tx := range txs[3:] {
+			assert.NoError(t, pool.addTx(local, tx))
+		}
+
+		assert.Equal(t, uint64(3), pool.accounts.get(addr1).promoted.length())
+		assert.Equal(t, uint64(2), pool.accounts.get(addr1).enqueued.length())
+
+		return pool, txs
+	}
+
+	t.Run("unknown tx", func(t *testing.T) {
+		t.Parallel()
+
+		pool, _ := setupPool(t)
+
+		_, err := pool.RemoveTx(types.StringToHash("0xff"))
+		assert.ErrorIs(t, err, ErrTxNotFound)
+	})
+
+	t.Run("promoted tx removes the subsequent promoted txs", func(t *testing.T) {
+		t.Parallel()
+
+		pool, txs := setupPool(t)
+
+		removed, err := pool.RemoveTx(txs[1].Hash)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []*types.Transaction{txs[1], txs[2]}, removed)
+
+		acc := pool.accounts.get(addr1)
+
+		assert.Equal(t, uint64(1), acc.getNonce())
+		assert.Equal(t, uint64(1), acc.promoted.length())
+		assert.Equal(t, uint64(2), acc.enqueued.length())
+		assert.Equal(t, uint64(3), pool.gauge.read())
+		assert.Nil(t, acc.nonceToTx.get(txs[1].Nonce))
+		assert.Nil(t, acc.nonceToTx.get(txs[2].Nonce))
+
+		_, exists := pool.index.get(txs[2].Hash)
+		assert.False(t, exists)
+		assert.Equal(t, int64(1), pool.pending)
+	})
+
+	t.Run("promoted tx is removed from the executables", func(t *testing.T) {
+		t.Parallel()
+
+		pool, txs := setupPool(t)
+
+		// the block builder queues the primary of every account
+		pool.Prepare()
+		assert.Equal(t, 1, pool.executables.length())
+
+		removed, err := pool.RemoveTx(txs[0].Hash)
+		assert.NoError(t, err)
+		assert.Len(t, removed, 3)
+
+		assert.Equal(t, 0, pool.executables.length())
+		assert.Nil(t, pool.Peek())
+		assert.Equal(t, int64(0), pool.pending)
+		assert.Equal(t, uint64(2), pool.gauge.read())
+	})
+
+	t.Run("removed tx executed by the block builder isn't popped", func(t *testing.T) {
+		t.Parallel()
+
+		pool, txs := setupPool(t)
+
+		pool.Prepare()
+		tx := pool.Peek()
+		assert.Equal(t, txs[0], tx)
+
+		// the tx is removed while the block builder executes it
+		_, err := pool.RemoveTx(txs[0].Hash)
+		assert.NoError(t, err)
+
+		pool.Pop(tx)
+		assert.Equal(t, uint64(0), pool.accounts.get(addr1).promoted.length())
+		assert.Equal(t, int64(0), pool.pending)
+		assert.Equal(t, uint64(2), pool.gauge.read())
+	})
+
+	t.Run("enqueued tx removes only that tx", func(t *testing.T) {
+		t.Parallel()
+
+		pool, txs := setupPool(t)
+
+		removed, err := pool.RemoveTx(txs[3].Hash)
+		assert.NoError(t, err)
+		assert.Equal(t, []*types.Transaction{txs[3]}, removed)
+
+		acc := pool.accounts.get(addr1)
+
+		assert.Equal(t, uint64(3), acc.getNonce())
+		assert.Equal(t, uint64(3), acc.promoted.length())
+		assert.Equal(t, uint64(1), acc.enqueued.length())
+		assert.Equal(t, txs[4], acc.enqueued.peek())
+		assert.Equal(t, uint64(4), pool.gauge.read())
+	})
+}
+
+func TestGetAccountTxs(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	assert.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	promoted, enqueued := pool.GetAccountTxs(addr1)
+	assert.Empty(t, promoted)
+	assert.Empty(t, enqueued)
+
+	tx1 := newTx(addr1, 0, 1)
+	tx2 := newTx(addr1, 3, 1)
+	tx3 := newTx(addr2, 0, 1)
+
+	assert.NoError(t, pool.addTx(local, tx1))
+	pool.handlePromoteRequest(<-pool.promoteReqCh)
+	assert.NoError(t, pool.addTx(local, tx2))
+	assert.NoError(t, pool.addTx(local, tx3))
+
+	promoted, enqueued = pool.GetAccountTxs(addr1)
+	assert.Equal(t, []*types.Transaction{tx1}, promoted)
+	assert.Equal(t, []*types.Transaction{tx2}, enqueued)
+}
+
 func TestDemote(t *testing.T) {
 	t.Parallel()
 
@@ -3799,3 +4127,158 @@ func BenchmarkAddTxTime(b *testing.B) {
 		}
 	})
 }
+
+func TestTxOrderingPolicies(t *testing.T) {
+	newPricedTx := func(addr types.Address, nonce, gasPrice uint64) *types.Transaction {
+		tx := newTx(addr, nonce, 1)
+		tx.GasPrice = new(big.Int).SetUint64(gasPrice)
+
+		return tx
+	}
+
+	// txs are added to the pool in this exact order (arrival)
+	txs := []*types.Transaction{
+		newPricedTx(addr1, 0, 10),
+		newPricedTx(addr1, 1, 10),
+		newPricedTx(addr2, 0, 30),
+		newPricedTx(addr2, 1, 30),
+		newPricedTx(addr3, 0, 20),
+	}
+
+	testCases := []struct {
+		name     string
+		policy   *string
+		expected []*types.Transaction
+	}{
+		{
+			name:     "no policy defaults to price",
+			policy:   nil,
+			expected: []*types.Transaction{txs[2], txs[3], txs[4], txs[0], txs[1]},
+		},
+		{
+			name:     "price",
+			policy:   stringPtr(string(PriceOrdering)),
+			expected: []*types.Transaction{txs[2], txs[3], txs[4], txs[0], txs[1]},
+		},
+		{
+			name:     "fifo",
+			policy:   stringPtr(string(FIFOOrdering)),
+			expected: []*types.Transaction{txs[0], txs[1], txs[2], txs[3], txs[4]},
+		},
+		{
+			name:     "fair",
+			policy:   stringPtr(string(FairOrdering)),
+			expected: []*types.Transaction{txs[2], txs[4], txs[0], txs[3], txs[1]},
+		},
+		{
+			name:     "priority",
+			policy:   stringPtr(string(PriorityOrdering)),
+			expected: []*types.Transaction{txs[0], txs[1], txs[2], txs[3], txs[4]},
+		},
+	}
+
+	fm := forkmanager.GetInstance()
+	t.Cleanup(fm.Clear)
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			fm.Clear()
+			fm.RegisterFork(forkmanager.InitialFork, &forkmanager.ForkParams{TxOrderingPolicy: tc.policy})
+			require.NoError(t, fm.ActivateFork(forkmanager.InitialFork, 0))
+
+			pool, err := newTestPool()
+			require.NoError(t, err)
+			pool.SetSigner(&mockSigner{})
+			pool.priorityAddresses[addr1] = struct{}{}
+
+			for _, tx := range txs {
+				require.NoError(t, pool.addTx(local, tx.Copy()))
+				pool.handlePromoteRequest(<-pool.promoteReqCh)
+			}
+
+			pool.Prepare()
+
+			for _, expected := range tc.expected {
+				tx := pool.Peek()
+				require.NotNil(t, tx)
+				assert.Equal(t, expected.From, tx.From)
+				assert.Equal(t, expected.Nonce, tx.Nonce)
+
+				pool.Pop(tx)
+			}
+
+			assert.Nil(t, pool.Peek())
+		})
+	}
+}
+
+func TestValidateTxOrderingPolicy(t *testing.T) {
+	t.Parallel()
+
+	for _, policy := range []TxOrderingPolicy{PriceOrdering, FIFOOrdering, FairOrdering, PriorityOrdering} {
+		assert.NoError(t, ValidateTxOrderingPolicy(string(policy)))
+	}
+
+	assert.Error(t, ValidateTxOrderingPolicy("lifo"))
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestPendingTxs(t *testing.T) {
+	t.Parallel()
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+	pool.SetSigner(&mockSigner{})
+
+	for _, tx := range []*types.Transaction{
+		newTx(addr1, 0, 1),
+		newTx(addr1, 1, 1),
+		newTx(addr1, 2, 1),
+		newTx(addr2, 0, 1),
+	} {
+		require.NoError(t, pool.addTx(local, tx))
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+	}
+
+	t.Run("all promoted txs are returned in nonce order", func(t *testing.T) {
+		t.Parallel()
+
+		pending := pool.Pending()
+		nonces := map[types.Address][]uint64{}
+
+		for tx := pending.Peek(); tx != nil; tx = pending.Peek() {
+			nonces[tx.From] = append(nonces[tx.From], tx.Nonce)
+			pending.Pop(tx)
+		}
+
+		assert.Equal(t, []uint64{0, 1, 2}, nonces[addr1])
+		assert.Equal(t, []uint64{0}, nonces[addr2])
+	})
+
+	t.Run("dropped account txs are skipped", func(t *testing.T) {
+		t.Parallel()
+
+		pending := pool.Pending()
+		count := 0
+
+		for tx := pending.Peek(); tx != nil; tx = pending.Peek() {
+			count++
+
+			if tx.From == addr1 {
+				pending.Drop(tx)
+			} else {
+				pending.Pop(tx)
+			}
+		}
+
+		assert.Equal(t, 2, count)
+	})
+
+	// the pool itself is not modified
+	assert.Equal(t, uint64(4), pool.Length())
+}
@@ -33,6 +33,12 @@ var (
 	dynamicFeeTxFallbackErrs = []error{types.ErrTxTypeNotSupported, errMethodNotFound}
 )
 
+// TxSigner is a key which signs the whole transactions instead of their hashes,
+// e.g. a key held by a signing service which has to know what it signs
+type TxSigner interface {
+	SignTx(txn *ethgo.Transaction, chainID uint64) (*ethgo.Transaction, error)
+}
+
 type TxRelayer interface {
 	// Call executes a message call immediately without creating a transaction on the blockchain
 	Call(from ethgo.Address, to ethgo.Address, input []byte) (string, error)
@@ -188,8 +194,8 @@ func (t *TxRelayerImpl) sendTransactionLocked(txn *ethgo.Transaction, key ethgo.
 		txn.Gas = gasLimit + (gasLimit * gasLimitIncreasePercentage / 100)
 	}
 
-	signer := wallet.NewEIP155Signer(chainID.Uint64())
-	if txn, err = signer.SignTx(txn, key); err != nil {
+	txn, err = signTransaction(txn, key, chainID.Uint64())
+	if err != nil {
 		return ethgo.ZeroHash, err
 	}
 
@@ -216,6 +222,16 @@ func (t *TxRelayerImpl) sendTransactionLocked(txn *ethgo.Transaction, key ethgo.
 	return t.client.Eth().SendRawTransaction(data)
 }
 
+// signTransaction signs the transaction with the given key.
+// The keys implementing TxSigner are given the whole transaction instead of its hash
+func signTransaction(txn *ethgo.Transaction, key ethgo.Key, chainID uint64) (*ethgo.Transaction, error) {
+	if txSigner, ok := key.(TxSigner); ok {
+		return txSigner.SignTx(txn, chainID)
+	}
+
+	return wallet.NewEIP155Signer(chainID).SignTx(txn, key)
+}
+
 // SendTransactionLocal sends non-signed transaction
 // (this function is meant only for testing purposes and is about to be removed at some point)
 func (t *TxRelayerImpl) SendTransactionLocal(txn *ethgo.Transaction) (*ethgo.Receipt, error) {
@@ -0,0 +1,54 @@
+package txrelayer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/umbracle/ethgo"
+	"github.com/umbracle/ethgo/wallet"
+
+	polywallet "github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
+)
+
+func TestSignTransaction(t *testing.T) {
+	t.Parallel()
+
+	const chainID = 100
+
+	account, err := polywallet.GenerateAccount()
+	require.NoError(t, err)
+
+	ecdsaKey, err := wallet.GenerateKey()
+	require.NoError(t, err)
+
+	keys := map[string]ethgo.Key{
+		// the validator keys sign the whole transactions, they refuse to sign their hashes
+		"validator key": polywallet.NewEcdsaSigner(polywallet.NewKey(account)),
+		"ecdsa key":     ecdsaKey,
+	}
+
+	for name, key := range keys {
+		key := key
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			to := ethgo.Address{0x1}
+			txn := &ethgo.Transaction{
+				From:     key.Address(),
+				To:       &to,
+				Value:    big.NewInt(1),
+				Gas:      21000,
+				GasPrice: 1,
+			}
+
+			signed, err := signTransaction(txn, key, chainID)
+			require.NoError(t, err)
+
+			sender, err := wallet.NewEIP155Signer(chainID).RecoverSender(signed)
+			require.NoError(t, err)
+			require.Equal(t, key.Address(), sender)
+		})
+	}
+}